@@ -24,6 +24,9 @@ var attachCommand = cli.Command{
 
 	 # If pod contains multiple containers, you must define container id
 	 eli attach --container some-id my-pod
+
+	 # Attach with stdin, detach with ctrl-p ctrl-q and attach again later
+	 eli attach -i my-pod
 `,
 	Flags: []cli.Flag{
 		cli.BoolFlag{
@@ -34,6 +37,12 @@ var attachCommand = cli.Command{
 			Name:  "container, c",
 			Usage: "Target container in the pod",
 		},
+		cli.StringFlag{
+			Name:   "detach-keys",
+			Usage:  "Key sequence to detach from the container when stdin is open",
+			EnvVar: "ELIOT_DETACH_KEYS",
+			Value:  term.DefaultDetachKeys,
+		},
 	},
 	Action: func(clicontext *cli.Context) error {
 		var (
@@ -61,21 +70,32 @@ var attachCommand = cli.Command{
 			return errors.Wrapf(err, "Failed to resolve containerID for pod [%s]", podName)
 		}
 
-		term := term.TTY{
+		terminal := term.TTY{
 			Out: stdout,
 		}
 
 		if clicontext.Bool("stdin") {
-			term.In = stdin
-			term.Raw = true
+			terminal.In = stdin
+			terminal.Raw = true
+			terminal.DetachKeys = clicontext.String("detach-keys")
+		}
+
+		attachIO, err := api.NewTTYAttachIO(&terminal, stderr)
+		if err != nil {
+			return err
 		}
 
 		// Stop updating ui lines, let the std piping take the terminal
 		ui.Stop()
 		defer ui.Start()
 
-		return term.Safe(func() error {
-			return client.Attach(containerID, api.NewAttachIO(term.In, term.Out, stderr))
+		err = terminal.Safe(func() error {
+			return client.Attach(containerID, attachIO)
 		})
+		if term.IsDetached(err) {
+			ui.NewLine().Infof("Detached from pod %s, attach again with 'eli attach -i %s'", podName, podName)
+			return nil
+		}
+		return err
 	},
 }
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/urfave/cli"
+)
+
+var cordonCommand = cli.Command{
+	Name:        "cordon",
+	HelpName:    "cordon",
+	Usage:       `Mark resource unschedulable`,
+	Description: "With this command you can stop the node accepting new pods",
+	ArgsUsage: `eli cordon RESOURCE [options]
+
+	 # Mark node 'my-node' unschedulable
+	 eli cordon node my-node`,
+	Subcommands: []cli.Command{
+		cordonNodeCommand,
+	},
+}
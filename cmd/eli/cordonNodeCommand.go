@@ -0,0 +1,37 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var cordonNodeCommand = cli.Command{
+	Name:    "node",
+	Aliases: []string{"nodes"},
+	Usage:   "Mark node unschedulable",
+	UsageText: `eli cordon node [options] <NAME>
+
+	 # Reject all new pods in node 'my-node'
+	 eli cordon node my-node`,
+	Action: func(clicontext *cli.Context) error {
+		name := clicontext.Args().First()
+		if name == "" {
+			return fmt.Errorf("You must give node name as first argument")
+		}
+
+		client, err := cmd.GetClientForNode(cmd.GetConfigProvider(clicontext), name)
+		if err != nil {
+			return err
+		}
+
+		uiline := ui.NewLine().Loadingf("Cordon node %s", name)
+		if err := client.Cordon(); err != nil {
+			uiline.Fatalf("Failed to cordon node %s: %s", name, err)
+		}
+		uiline.Donef("Cordoned node %s", name)
+		return nil
+	},
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/api"
+	"github.com/ernoaapa/eliot/pkg/archive"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var cpCommand = cli.Command{
+	Name:        "cp",
+	HelpName:    "cp",
+	Usage:       "Copy files and directories to and from container",
+	Description: "You can use this command to copy files to the running container or from it to your local filesystem",
+	UsageText: `eli cp [options] SOURCE DESTINATION
+
+	 # Copy local file to the container
+	 eli cp ./config.yml my-pod:/etc/app/config.yml
+
+	 # Copy directory from the container into local directory
+	 eli cp my-pod:/var/log ./logs
+
+	 # If pod contains multiple containers, you must define container name
+	 eli cp --container my-app ./config.yml my-pod:/etc/app/
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "container, c",
+			Usage: "Target container in the pod",
+		},
+	},
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() != 2 {
+			return fmt.Errorf("You must give source and destination, e.g. eli cp ./config.yml my-pod:/etc/app/")
+		}
+
+		var (
+			source      = clicontext.Args().Get(0)
+			destination = clicontext.Args().Get(1)
+		)
+		sourcePod, sourcePath := cmd.ParseCopyPath(source)
+		destinationPod, destinationPath := cmd.ParseCopyPath(destination)
+		if (sourcePod == "") == (destinationPod == "") {
+			return fmt.Errorf("You must give exactly one container path in format POD_NAME:PATH")
+		}
+
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		podName := cmd.First(sourcePod, destinationPod)
+		pod, err := client.GetPod(podName)
+		if err != nil {
+			return err
+		}
+
+		containerID, err := cmd.ResolveContainerID(pod.Status.ContainerStatuses, clicontext.String("container"))
+		if err != nil {
+			return errors.Wrapf(err, "Failed to resolve containerID for pod [%s]", podName)
+		}
+
+		uiline := ui.NewLine().Loadingf("Copy %s to %s", source, destination)
+		if destinationPod != "" {
+			err = copyToContainer(client, containerID, sourcePath, destinationPath, uiline)
+		} else {
+			err = copyFromContainer(client, containerID, sourcePath, destinationPath, uiline)
+		}
+		if err != nil {
+			uiline.Fatalf("Failed to copy %s to %s: %s", source, destination, err)
+		}
+		uiline.Donef("Copied %s to %s", source, destination)
+		return nil
+	},
+}
+
+func copyToContainer(client *api.Client, containerID, source, destination string, uiline ui.Line) error {
+	size, err := archive.Size(source)
+	if err != nil {
+		return err
+	}
+	progress := cmd.NewTransferProgress(uiline, size)
+
+	reader, writer := io.Pipe()
+	go func() {
+		writer.CloseWithError(archive.WritePath(source, writer))
+	}()
+	defer reader.Close()
+
+	return client.CopyToContainer(containerID, destination, progress.Reader(reader))
+}
+
+func copyFromContainer(client *api.Client, containerID, source, destination string, uiline ui.Line) error {
+	progress := cmd.NewTransferProgress(uiline, 0)
+
+	reader, writer := io.Pipe()
+	extracted := make(chan error, 1)
+	go func() {
+		err := archive.ExtractToPath(destination, reader)
+		// Read the rest of the stream, e.g. the tar end blocks, so the copy can complete
+		io.Copy(ioutil.Discard, reader)
+		reader.CloseWithError(err)
+		extracted <- err
+	}()
+
+	err := client.CopyFromContainer(containerID, source, progress.Writer(writer), progress.SetTotal)
+	writer.CloseWithError(err)
+	if extractErr := <-extracted; extractErr != nil {
+		return extractErr
+	}
+	return err
+}
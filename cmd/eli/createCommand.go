@@ -21,6 +21,9 @@ var createCommand = cli.Command{
 
 	 # Create pod based on pod.yml
 	 eli create -f ./pod.yml
+
+	 # With multiple nodes configured, the pod gets created to all nodes what match to the pod nodeSelector
+	 eli create -f ./camera.yml
 `,
 	Flags: []cli.Flag{
 		cli.StringSliceFlag{
@@ -31,6 +34,8 @@ var createCommand = cli.Command{
 	},
 	Subcommands: []cli.Command{
 		createPodCommand,
+		createSecretCommand,
+		createConfigMapCommand,
 	},
 	Action: func(clicontext *cli.Context) (err error) {
 		pods := []*pods.Pod{}
@@ -44,29 +49,30 @@ var createCommand = cli.Command{
 		}
 
 		config := cmd.GetConfigProvider(clicontext)
-		client := cmd.GetClient(config)
 
 		for _, pod := range pods {
-			progressc := make(chan []*progress.ImageFetch)
-			go cmd.ShowDownloadProgress(progressc)
+			for _, client := range cmd.GetClientsForNodeSelector(config, pod.GetSpec().GetNodeSelector()) {
+				progressc := make(chan []*progress.ImageFetch)
+				go cmd.ShowDownloadProgress(progressc)
 
-			err := client.CreatePod(progressc, pod)
-			close(progressc)
-			if err != nil {
-				return err
-			}
+				err := client.CreatePod(progressc, pod)
+				close(progressc)
+				if err != nil {
+					return err
+				}
 
-			result, err := client.StartPod(pod.Metadata.Name)
-			if err != nil {
-				return err
-			}
+				result, err := client.StartPod(pod.Metadata.Name)
+				if err != nil {
+					return err
+				}
 
-			writer := printers.GetNewTabWriter(os.Stdout)
-			defer writer.Flush()
-			printer := cmd.GetPrinter(clicontext)
+				writer := printers.GetNewTabWriter(os.Stdout)
+				defer writer.Flush()
+				printer := cmd.GetPrinter(clicontext)
 
-			if err := printer.PrintPod(result, writer); err != nil {
-				return err
+				if err := printer.PrintPod(result, writer); err != nil {
+					return err
+				}
 			}
 		}
 		return nil
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/api/core"
+	configs "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var createConfigMapCommand = cli.Command{
+	Name:        "configmap",
+	HelpName:    "configmap",
+	Usage:       "Create new configmap",
+	Description: "With create configmap command, you can create new configmap into the node",
+	UsageText: `eli create configmap [options] <NAME>
+
+	 # Create new configmap 'my-configmap' with key 'level'
+	 eli create configmap --from-literal level=debug my-configmap
+
+	 # Create new configmap with file content as value, the file name is the key
+	 eli create configmap --from-file ./config.yml my-configmap
+`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "from-literal",
+			Usage: "Key and value in format KEY=value. You can give as many keys you want",
+		},
+		cli.StringSliceFlag{
+			Name:  "from-file",
+			Usage: "Key and path to the file in format [KEY=]path. If KEY is not given, the file name is used as key",
+		},
+	},
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() == 0 || clicontext.Args().First() == "" {
+			return fmt.Errorf("You must give ConfigMap name as first argument")
+		}
+		name := clicontext.Args().First()
+
+		data, err := cmd.ParseConfigData(clicontext.StringSlice("from-literal"), clicontext.StringSlice("from-file"))
+		if err != nil {
+			return err
+		}
+
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		uiline := ui.NewLine().Loadingf("Creating configmap %s", name)
+		created, err := client.CreateConfigMap(&configs.ConfigMap{
+			Metadata: &core.ResourceMetadata{
+				Name:      name,
+				Namespace: config.GetNamespace(),
+			},
+			Data: mapDataToStrings(data),
+		})
+		if err != nil {
+			uiline.Fatalf("Failed to create configmap %s: %s", name, err)
+		}
+		uiline.Donef("Created configmap %s", created.Metadata.Name)
+		return nil
+	},
+}
+
+func mapDataToStrings(data map[string][]byte) map[string]string {
+	result := map[string]string{}
+	for key, value := range data {
+		result[key] = string(value)
+	}
+	return result
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/api/core"
+	configs "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var createSecretCommand = cli.Command{
+	Name:        "secret",
+	HelpName:    "secret",
+	Usage:       "Create new secret",
+	Description: "With create secret command, you can create new secret into the node",
+	UsageText: `eli create secret [options] <NAME>
+
+	 # Create new secret 'my-secret' with key 'level'
+	 eli create secret --from-literal level=debug my-secret
+
+	 # Create new secret with file content as value, the file name is the key
+	 eli create secret --from-file ./config.yml my-secret
+`,
+	Flags: []cli.Flag{
+		cli.StringSliceFlag{
+			Name:  "from-literal",
+			Usage: "Key and value in format KEY=value. You can give as many keys you want",
+		},
+		cli.StringSliceFlag{
+			Name:  "from-file",
+			Usage: "Key and path to the file in format [KEY=]path. If KEY is not given, the file name is used as key",
+		},
+	},
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() == 0 || clicontext.Args().First() == "" {
+			return fmt.Errorf("You must give Secret name as first argument")
+		}
+		name := clicontext.Args().First()
+
+		data, err := cmd.ParseConfigData(clicontext.StringSlice("from-literal"), clicontext.StringSlice("from-file"))
+		if err != nil {
+			return err
+		}
+
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		uiline := ui.NewLine().Loadingf("Creating secret %s", name)
+		created, err := client.CreateSecret(&configs.Secret{
+			Metadata: &core.ResourceMetadata{
+				Name:      name,
+				Namespace: config.GetNamespace(),
+			},
+			Data: data,
+		})
+		if err != nil {
+			uiline.Fatalf("Failed to create secret %s: %s", name, err)
+		}
+		uiline.Donef("Created secret %s", created.Metadata.Name)
+		return nil
+	},
+}
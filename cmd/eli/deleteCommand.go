@@ -15,8 +15,14 @@ var deleteCommand = cli.Command{
 	 eli delete pods
 
 	 # Delete all 'my-pod' pod
-	 eli delete pod my-pod`,
+	 eli delete pod my-pod
+
+	 # Delete 'my-data' volume
+	 eli delete volume my-data`,
 	Subcommands: []cli.Command{
 		deletePodCommand,
+		deleteVolumeCommand,
+		deleteSecretCommand,
+		deleteConfigMapCommand,
 	},
 }
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var deleteConfigMapCommand = cli.Command{
+	Name:    "configmap",
+	Aliases: []string{"configmaps"},
+	Usage:   "Delete ConfigMap resource",
+	UsageText: `eli delete configmap [options] <CONFIGMAP NAME>
+			 
+	 # Delete 'my-configmap' configmap
+	 eli delete configmap my-configmap`,
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() == 0 || clicontext.Args().First() == "" {
+			return fmt.Errorf("You must give ConfigMap name as first argument")
+		}
+		name := clicontext.Args().First()
+
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		uiline := ui.NewLine().Loadingf("Deleting configmap %s", name)
+		deleted, err := client.DeleteConfigMap(name)
+		if err != nil {
+			uiline.Fatalf("Failed to delete configmap %s: %s", name, err)
+		}
+		uiline.Donef("Deleted configmap %s", deleted.Metadata.Name)
+		return nil
+	},
+}
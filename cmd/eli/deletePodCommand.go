@@ -16,7 +16,16 @@ var deletePodCommand = cli.Command{
 	 eli delete pods
 
 	 # Delete all 'my-pod' pod
-	 eli delete pod my-pod`,
+	 eli delete pod my-pod
+
+	 # Delete all Pods with label app=sensor
+	 eli delete pods -l app=sensor`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "selector, l",
+			Usage: "Label selector to filter the pods to delete, e.g. app=sensor,env!=dev",
+		},
+	},
 	Action: func(clicontext *cli.Context) error {
 		config := cmd.GetConfigProvider(clicontext)
 		client := cmd.GetClient(config)
@@ -24,7 +33,7 @@ var deletePodCommand = cli.Command{
 		podName := clicontext.Args().First()
 
 		uiline := ui.NewLine().Loading("Fetch pods...")
-		pods, err := client.GetPods()
+		pods, err := client.GetPodsWithSelector(clicontext.String("selector"))
 		if err != nil {
 			uiline.Fatalf("Failed to fetch pods information: %s", err)
 		}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var deleteSecretCommand = cli.Command{
+	Name:    "secret",
+	Aliases: []string{"secrets"},
+	Usage:   "Delete Secret resource",
+	UsageText: `eli delete secret [options] <SECRET NAME>
+			 
+	 # Delete 'my-secret' secret
+	 eli delete secret my-secret`,
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() == 0 || clicontext.Args().First() == "" {
+			return fmt.Errorf("You must give Secret name as first argument")
+		}
+		name := clicontext.Args().First()
+
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		uiline := ui.NewLine().Loadingf("Deleting secret %s", name)
+		deleted, err := client.DeleteSecret(name)
+		if err != nil {
+			uiline.Fatalf("Failed to delete secret %s: %s", name, err)
+		}
+		uiline.Donef("Deleted secret %s", deleted.Metadata.Name)
+		return nil
+	},
+}
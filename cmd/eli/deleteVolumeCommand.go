@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var deleteVolumeCommand = cli.Command{
+	Name:    "volume",
+	Aliases: []string{"volumes"},
+	Usage:   "Delete Volume resource",
+	UsageText: `eli delete volume [options] VOLUME NAME
+			 
+	 # Delete 'my-data' volume and all of its data
+	 eli delete volume my-data`,
+	Action: func(clicontext *cli.Context) error {
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		if clicontext.NArg() == 0 || clicontext.Args().First() == "" {
+			return fmt.Errorf("You must give Volume name as first argument")
+		}
+		name := clicontext.Args().First()
+
+		uiline := ui.NewLine().Loadingf("Fetch volume %s...", name)
+		volume, err := client.GetVolume(name)
+		if err != nil {
+			uiline.Fatalf("Failed to fetch volume %s: %s", name, err)
+		}
+		uiline.Donef("Fetched volume %s", name)
+
+		uiline = ui.NewLine().Loadingf("Deleting volume %s", name)
+		deleted, err := client.DeleteVolume(volume)
+		if err != nil {
+			uiline.Fatalf("Failed to delete volume %s: %s", name, err)
+		}
+		uiline.Donef("Deleted volume %s", deleted.Metadata.Name)
+		return nil
+	},
+}
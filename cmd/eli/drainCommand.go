@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/urfave/cli"
+)
+
+var drainCommand = cli.Command{
+	Name:        "drain",
+	HelpName:    "drain",
+	Usage:       `Drain resource in preparation for maintenance`,
+	Description: "With this command you can mark the node unschedulable and stop all pods gracefully",
+	ArgsUsage: `eli drain RESOURCE [options]
+
+	 # Stop all pods in node 'my-node'
+	 eli drain node my-node`,
+	Subcommands: []cli.Command{
+		drainNodeCommand,
+	},
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var drainNodeCommand = cli.Command{
+	Name:    "node",
+	Aliases: []string{"nodes"},
+	Usage:   "Drain node in preparation for maintenance",
+	UsageText: `eli drain node [options] <NAME>
+
+	 # Stop all pods gracefully in node 'my-node'
+	 eli drain node my-node`,
+	Action: func(clicontext *cli.Context) error {
+		name := clicontext.Args().First()
+		if name == "" {
+			return fmt.Errorf("You must give node name as first argument")
+		}
+
+		client, err := cmd.GetClientForNode(cmd.GetConfigProvider(clicontext), name)
+		if err != nil {
+			return err
+		}
+
+		uiline := ui.NewLine().Loadingf("Drain node %s", name)
+		stopped, err := client.Drain()
+		if err != nil {
+			uiline.Fatalf("Failed to drain node %s: %s", name, err)
+		}
+		if len(stopped) == 0 {
+			uiline.Donef("Drained node %s", name)
+		} else {
+			uiline.Donef("Drained node %s, stopped pods: %s", name, strings.Join(stopped, ", "))
+		}
+		return nil
+	},
+}
@@ -28,6 +28,12 @@ var execCommand = cli.Command{
 	 # If you have parameters with command, add double dash (--) to separate
 	 # command from the eli command
 	 eli exec --container some-id my-pod -- ls -lt /usr
+
+	 # Run command as user 'nobody' in /tmp with extra environment variable
+	 eli exec --user nobody --workdir /tmp --env DEBUG=true my-pod -- sh -c 'echo "$DEBUG"'
+
+	 # eli exec exits with the command exit code
+	 eli exec my-pod -- test -f /etc/config.yml || echo "No config"
 `,
 	Flags: []cli.Flag{
 		cli.BoolFlag{
@@ -42,6 +48,18 @@ var execCommand = cli.Command{
 			Name:  "container, c",
 			Usage: "Container name. If omitted, the first container in the pod will be chosen",
 		},
+		cli.StringSliceFlag{
+			Name:  "env, e",
+			Usage: "Set environment variable for the command in format KEY=value",
+		},
+		cli.StringFlag{
+			Name:  "user, u",
+			Usage: "User name or UID and optionally group to run the command as, e.g. nobody or 1000:1000",
+		},
+		cli.StringFlag{
+			Name:  "workdir, w",
+			Usage: "Working directory for the command",
+		},
 	},
 	Action: func(clicontext *cli.Context) error {
 		var (
@@ -85,8 +103,31 @@ var execCommand = cli.Command{
 		ui.Stop()
 		defer ui.Start()
 
-		return term.Safe(func() error {
-			return client.Exec(containerID, args, tty, api.NewAttachIO(term.In, term.Out, stderr))
+		attachIO := api.NewAttachIO(term.In, term.Out, stderr)
+		if tty {
+			if attachIO, err = api.NewTTYAttachIO(&term, stderr); err != nil {
+				return err
+			}
+		}
+
+		exitCode := 0
+		err = term.Safe(func() (err error) {
+			exitCode, err = client.Exec(containerID, api.ExecOptions{
+				Args:       args,
+				Env:        clicontext.StringSlice("env"),
+				User:       clicontext.String("user"),
+				WorkingDir: clicontext.String("workdir"),
+				Tty:        tty,
+			}, attachIO)
+			return err
 		})
+		if err != nil {
+			return err
+		}
+		if exitCode != 0 {
+			// Exit with the command exit code so the scripts can check the result
+			return cli.NewExitError("", exitCode)
+		}
+		return nil
 	},
 }
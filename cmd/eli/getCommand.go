@@ -12,9 +12,15 @@ var getCommand = cli.Command{
 	ArgsUsage: `eli get RESOURCE [options]
 
 	 # Get table of running pods
-	 eli get pods`,
+	 eli get pods
+
+	 # Get table of volumes
+	 eli get volumes`,
 	Subcommands: []cli.Command{
 		getPodsCommand,
 		getNodesCommand,
+		getVolumesCommand,
+		getSecretsCommand,
+		getConfigMapsCommand,
 	},
 }
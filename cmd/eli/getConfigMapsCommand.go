@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/printers"
+	"github.com/urfave/cli"
+)
+
+var getConfigMapsCommand = cli.Command{
+	Name:    "configmaps",
+	Aliases: []string{"configmap"},
+	Usage:   "Get ConfigMap resources",
+	UsageText: `eli get configmaps [options]
+			 
+	 # Get table of configmaps
+	 eli get configmaps`,
+	Action: func(clicontext *cli.Context) error {
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		configmaps, err := client.GetConfigMaps()
+		if err != nil {
+			return err
+		}
+
+		writer := printers.GetNewTabWriter(os.Stdout)
+		defer writer.Flush()
+		printer := cmd.GetPrinter(clicontext)
+		return printer.PrintConfigMaps(configmaps, writer)
+	},
+}
@@ -15,12 +15,21 @@ var getPodsCommand = cli.Command{
 	UsageText: `eli get pods [options]
 			 
 	 # Get table of running pods
-	 eli get pods`,
+	 eli get pods
+
+	 # Get pods with label app=sensor
+	 eli get pods -l app=sensor`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "selector, l",
+			Usage: "Label selector to filter the pods, e.g. app=sensor,env!=dev",
+		},
+	},
 	Action: func(clicontext *cli.Context) error {
 		config := cmd.GetConfigProvider(clicontext)
 		client := cmd.GetClient(config)
 
-		pods, err := client.GetPods()
+		pods, err := client.GetPodsWithSelector(clicontext.String("selector"))
 		if err != nil {
 			return err
 		}
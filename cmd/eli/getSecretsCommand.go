@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/printers"
+	"github.com/urfave/cli"
+)
+
+var getSecretsCommand = cli.Command{
+	Name:    "secrets",
+	Aliases: []string{"secret"},
+	Usage:   "Get Secret resources",
+	UsageText: `eli get secrets [options]
+			 
+	 # Get table of secrets
+	 eli get secrets`,
+	Action: func(clicontext *cli.Context) error {
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		secrets, err := client.GetSecrets()
+		if err != nil {
+			return err
+		}
+
+		writer := printers.GetNewTabWriter(os.Stdout)
+		defer writer.Flush()
+		printer := cmd.GetPrinter(clicontext)
+		return printer.PrintSecrets(secrets, writer)
+	},
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/printers"
+	"github.com/urfave/cli"
+)
+
+var getVolumesCommand = cli.Command{
+	Name:    "volumes",
+	Aliases: []string{"volume"},
+	Usage:   "Get Volume resources",
+	UsageText: `eli get volumes [options]
+			 
+	 # Get table of volumes
+	 eli get volumes`,
+	Action: func(clicontext *cli.Context) error {
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		volumes, err := client.GetVolumes()
+		if err != nil {
+			return err
+		}
+
+		writer := printers.GetNewTabWriter(os.Stdout)
+		defer writer.Flush()
+		printer := cmd.GetPrinter(clicontext)
+		return printer.PrintVolumes(volumes, writer)
+	},
+}
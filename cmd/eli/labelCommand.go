@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/urfave/cli"
+)
+
+var labelCommand = cli.Command{
+	Name:        "label",
+	HelpName:    "label",
+	Usage:       `Update labels of the resource`,
+	Description: "With this command you can add, update and remove resource labels",
+	ArgsUsage: `eli label RESOURCE [options]
+
+	 # Set node 'my-node' label location=barn
+	 eli label node my-node location=barn`,
+	Subcommands: []cli.Command{
+		labelNodeCommand,
+	},
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var labelNodeCommand = cli.Command{
+	Name:    "node",
+	Aliases: []string{"nodes"},
+	Usage:   "Update node labels",
+	UsageText: `eli label node [options] <NAME> <KEY=VALUE | KEY-> ...
+
+	 # Set label location=barn to node 'my-node'
+	 eli label node my-node location=barn
+
+	 # Remove label 'location' from node 'my-node'
+	 eli label node my-node location-`,
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() < 2 || clicontext.Args().First() == "" {
+			return fmt.Errorf("You must give node name and at least one label as arguments")
+		}
+		name := clicontext.Args().First()
+
+		set, remove, err := cmd.ParseLabelArgs(clicontext.Args().Tail())
+		if err != nil {
+			return err
+		}
+
+		client, err := cmd.GetClientForNode(cmd.GetConfigProvider(clicontext), name)
+		if err != nil {
+			return err
+		}
+
+		if len(set) > 0 {
+			uiline := ui.NewLine().Loadingf("Setting labels to node %s", name)
+			if _, err := client.SetLabels(set); err != nil {
+				uiline.Fatalf("Failed to set labels to node %s: %s", name, err)
+			}
+			uiline.Donef("Labeled node %s", name)
+		}
+
+		if len(remove) > 0 {
+			uiline := ui.NewLine().Loadingf("Removing labels from node %s", name)
+			if _, err := client.RemoveLabels(remove); err != nil {
+				uiline.Fatalf("Failed to remove labels from node %s: %s", name, err)
+			}
+			uiline.Donef("Removed labels from node %s", name)
+		}
+		return nil
+	},
+}
@@ -69,9 +69,18 @@ func main() {
 		runCommand,
 		upCommand,
 		execCommand,
+		cpCommand,
+		portForwardCommand,
 		createCommand,
 		configCommand,
 		buildCommand,
+		volumeCommand,
+		labelCommand,
+		cordonCommand,
+		drainCommand,
+		uncordonCommand,
+		rebootCommand,
+		shutdownCommand,
 	}
 
 	err := app.Run(os.Args)
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var portForwardCommand = cli.Command{
+	Name:        "port-forward",
+	HelpName:    "port-forward",
+	Usage:       "Forward local ports to the pod",
+	Description: "You can use this command to connect to the pod ports through the node API connection, e.g. when the node firewall allows only the eliotd port",
+	UsageText: `eli port-forward [options] POD_NAME [LOCAL_PORT:]POD_PORT [...[LOCAL_PORT_N:]POD_PORT_N]
+
+	 # Forward local port 8080 to the pod port 80
+	 eli port-forward my-pod 8080:80
+
+	 # Forward multiple ports with the same port number
+	 eli port-forward my-pod 80 9229
+
+	 # Forward random local port to the pod port 80
+	 eli port-forward my-pod :80
+`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "address",
+			Usage: "Local address to listen",
+			Value: "localhost",
+		},
+	},
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() < 2 {
+			return fmt.Errorf("You must give Pod name and at least one port, e.g. eli port-forward my-pod 8080:80")
+		}
+		podName := clicontext.Args().First()
+
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		if _, err := client.GetPod(podName); err != nil {
+			return err
+		}
+
+		ports := clicontext.Args().Tail()
+		errc := make(chan error, len(ports))
+		for _, arg := range ports {
+			localPort, podPort, err := cmd.ParsePortForwardArg(arg)
+			if err != nil {
+				return err
+			}
+
+			listener, err := net.Listen("tcp", net.JoinHostPort(clicontext.String("address"), strconv.Itoa(localPort)))
+			if err != nil {
+				return err
+			}
+			defer listener.Close()
+			ui.NewLine().Infof("Forwarding from %s to %s:%d", listener.Addr(), podName, podPort)
+
+			go func(listener net.Listener, podPort int) {
+				errc <- client.PortForward(podName, podPort, listener, func(err error) {
+					ui.NewLine().Warnf("Failed to forward connection to %s:%d: %s", podName, podPort, err)
+				})
+			}(listener, podPort)
+		}
+
+		return <-errc
+	},
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/urfave/cli"
+)
+
+var rebootCommand = cli.Command{
+	Name:        "reboot",
+	HelpName:    "reboot",
+	Usage:       `Reboot the resource`,
+	Description: "With this command you can stop all pods gracefully and reboot the node",
+	ArgsUsage: `eli reboot RESOURCE [options]
+
+	 # Reboot node 'my-node'
+	 eli reboot node my-node`,
+	Subcommands: []cli.Command{
+		rebootNodeCommand,
+	},
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var rebootNodeCommand = cli.Command{
+	Name:  "node",
+	Usage: "Stop all pods gracefully and reboot the node",
+	UsageText: `eli reboot node [options] <NAME>
+
+	 # Reboot node 'my-node'
+	 eli reboot node my-node
+
+	 # Reboot node 'my-node' without confirmation
+	 eli reboot node --yes my-node`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "yes, y",
+			Usage: "Don't ask for confirmation",
+		},
+	},
+	Action: func(clicontext *cli.Context) error {
+		name := clicontext.Args().First()
+		if name == "" {
+			return fmt.Errorf("You must give node name as first argument")
+		}
+
+		if !clicontext.Bool("yes") && !cmd.Confirm(os.Stdin, os.Stdout, fmt.Sprintf("Reboot node %s?", name)) {
+			return nil
+		}
+
+		client, err := cmd.GetClientForNode(cmd.GetConfigProvider(clicontext), name)
+		if err != nil {
+			return err
+		}
+
+		uiline := ui.NewLine().Loadingf("Rebooting node %s", name)
+		stopped, err := client.Reboot()
+		if err != nil {
+			uiline.Fatalf("Failed to reboot node %s: %s", name, err)
+		}
+		uiline.Donef("Node %s is rebooting, stopped %d pods", name, len(stopped))
+		return nil
+	},
+}
@@ -29,6 +29,9 @@ var runCommand = cli.Command{
 
 	 # Run shell session in 'eaapa/hello-world' container
 	 eli run -i -t eaapa/hello-world -- /bin/sh
+
+	 # Run nginx in the bridge network and publish port 80 as node port 8080
+	 eli run --port 8080:80 docker.io/library/nginx:latest
 `,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -43,6 +46,10 @@ var runCommand = cli.Command{
 			Name:  "bind",
 			Usage: "Bind a directory in host to the container. Format: /source:/target:options, E.g. /var:/var:rshared",
 		},
+		cli.StringSliceFlag{
+			Name:  "port, p",
+			Usage: "Publish container port to the node. Format: hostPort:containerPort/protocol, E.g. --port 8080:80/tcp",
+		},
 		cli.StringSliceFlag{
 			Name:  "env, e",
 			Usage: "Set environment variable into the container. E.g. --env FOO=bar",
@@ -63,6 +70,12 @@ var runCommand = cli.Command{
 			Name:  "workdir, w",
 			Usage: "Working directory inside the container",
 		},
+		cli.StringFlag{
+			Name:   "detach-keys",
+			Usage:  "Key sequence to detach from the container when stdin is open",
+			EnvVar: "ELIOT_DETACH_KEYS",
+			Value:  term.DefaultDetachKeys,
+		},
 	},
 	Action: func(clicontext *cli.Context) (err error) {
 		var (
@@ -74,6 +87,7 @@ var runCommand = cli.Command{
 			workdir = clicontext.String("workdir")
 			mounts  = cmd.MustParseMounts(clicontext.StringSlice("mount"))
 			binds   = cmd.MustParseBinds(clicontext.StringSlice("bind"))
+			ports   = cmd.MustParsePorts(clicontext.StringSlice("port"))
 			args    = cmd.DropDoubleDash(clicontext.Args().Tail())
 
 			stdin  = os.Stdin
@@ -105,7 +119,8 @@ var runCommand = cli.Command{
 				Namespace: conf.GetNamespace(),
 			},
 			Spec: &pods.PodSpec{
-				HostNetwork: true,
+				// Use the bridge network only when some ports get published
+				HostNetwork: len(ports) == 0,
 				Containers: []*containers.Container{
 					{
 						Name:       name,
@@ -115,6 +130,7 @@ var runCommand = cli.Command{
 						Env:        env,
 						WorkingDir: workdir,
 						Mounts:     append(mounts, binds...),
+						Ports:      ports,
 					},
 				},
 			},
@@ -129,8 +145,13 @@ var runCommand = cli.Command{
 			return errors.Wrapf(createErr, "Error in creating pod")
 		}
 
+		// User can keep the pod running when detaches from it
+		keep := false
 		if rm {
 			defer func() {
+				if keep {
+					return
+				}
 				uiline := ui.NewLine().Loadingf("Delete pod %s", pod.Metadata.Name)
 				_, err := client.DeletePod(pod)
 				if err != nil {
@@ -151,13 +172,14 @@ var runCommand = cli.Command{
 			return errors.Wrapf(err, "Cannot attach to container")
 		}
 
-		term := term.TTY{
+		terminal := term.TTY{
 			Out: stdout,
 		}
 
 		if clicontext.Bool("stdin") {
-			term.In = stdin
-			term.Raw = true
+			terminal.In = stdin
+			terminal.Raw = true
+			terminal.DetachKeys = clicontext.String("detach-keys")
 		} else {
 			sigc := cmd.ForwardAllSignals(func(signal syscall.Signal) error {
 				return client.Signal(attachContainerID, signal)
@@ -169,8 +191,21 @@ var runCommand = cli.Command{
 		ui.Stop()
 		defer ui.Start()
 
-		return term.Safe(func() error {
-			return client.Attach(attachContainerID, api.NewAttachIO(term.In, term.Out, stderr))
+		attachIO, err := api.NewTTYAttachIO(&terminal, stderr)
+		if err != nil {
+			return err
+		}
+
+		err = terminal.Safe(func() error {
+			return client.Attach(attachContainerID, attachIO)
 		})
+		if term.IsDetached(err) {
+			keep = !rm || cmd.Confirm(os.Stdin, stdout, fmt.Sprintf("Detached from pod %s. Keep the pod running?", name))
+			if keep {
+				ui.NewLine().Infof("Pod %s keeps running, attach again with 'eli attach -i %s'", name, name)
+			}
+			return nil
+		}
+		return err
 	},
 }
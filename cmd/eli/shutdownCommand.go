@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/urfave/cli"
+)
+
+var shutdownCommand = cli.Command{
+	Name:        "shutdown",
+	HelpName:    "shutdown",
+	Usage:       `Shutdown the resource`,
+	Description: "With this command you can stop all pods gracefully and power off the node",
+	ArgsUsage: `eli shutdown RESOURCE [options]
+
+	 # Shutdown node 'my-node'
+	 eli shutdown node my-node`,
+	Subcommands: []cli.Command{
+		shutdownNodeCommand,
+	},
+}
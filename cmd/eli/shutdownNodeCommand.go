@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var shutdownNodeCommand = cli.Command{
+	Name:  "node",
+	Usage: "Stop all pods gracefully and power off the node",
+	UsageText: `eli shutdown node [options] <NAME>
+
+	 # Shutdown node 'my-node'
+	 eli shutdown node my-node
+
+	 # Shutdown node 'my-node' without confirmation
+	 eli shutdown node --yes my-node`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "yes, y",
+			Usage: "Don't ask for confirmation",
+		},
+	},
+	Action: func(clicontext *cli.Context) error {
+		name := clicontext.Args().First()
+		if name == "" {
+			return fmt.Errorf("You must give node name as first argument")
+		}
+
+		if !clicontext.Bool("yes") && !cmd.Confirm(os.Stdin, os.Stdout, fmt.Sprintf("Shutdown node %s?", name)) {
+			return nil
+		}
+
+		client, err := cmd.GetClientForNode(cmd.GetConfigProvider(clicontext), name)
+		if err != nil {
+			return err
+		}
+
+		uiline := ui.NewLine().Loadingf("Shutting down node %s", name)
+		stopped, err := client.Shutdown()
+		if err != nil {
+			uiline.Fatalf("Failed to shutdown node %s: %s", name, err)
+		}
+		uiline.Donef("Node %s is shutting down, stopped %d pods", name, len(stopped))
+		return nil
+	},
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"github.com/urfave/cli"
+)
+
+var uncordonCommand = cli.Command{
+	Name:        "uncordon",
+	HelpName:    "uncordon",
+	Usage:       `Mark resource schedulable`,
+	Description: "With this command you can restore the node normal operation and restart the drained pods",
+	ArgsUsage: `eli uncordon RESOURCE [options]
+
+	 # Mark node 'my-node' schedulable again
+	 eli uncordon node my-node`,
+	Subcommands: []cli.Command{
+		uncordonNodeCommand,
+	},
+}
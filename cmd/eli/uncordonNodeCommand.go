@@ -0,0 +1,43 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ernoaapa/eliot/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var uncordonNodeCommand = cli.Command{
+	Name:    "node",
+	Aliases: []string{"nodes"},
+	Usage:   "Mark node schedulable",
+	UsageText: `eli uncordon node [options] <NAME>
+
+	 # Accept new pods and restart drained pods in node 'my-node'
+	 eli uncordon node my-node`,
+	Action: func(clicontext *cli.Context) error {
+		name := clicontext.Args().First()
+		if name == "" {
+			return fmt.Errorf("You must give node name as first argument")
+		}
+
+		client, err := cmd.GetClientForNode(cmd.GetConfigProvider(clicontext), name)
+		if err != nil {
+			return err
+		}
+
+		uiline := ui.NewLine().Loadingf("Uncordon node %s", name)
+		started, err := client.Uncordon()
+		if err != nil {
+			uiline.Fatalf("Failed to uncordon node %s: %s", name, err)
+		}
+		if len(started) == 0 {
+			uiline.Donef("Uncordoned node %s", name)
+		} else {
+			uiline.Donef("Uncordoned node %s, restarted pods: %s", name, strings.Join(started, ", "))
+		}
+		return nil
+	},
+}
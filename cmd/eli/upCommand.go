@@ -2,12 +2,14 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 	"syscall"
 	"time"
 
+	"github.com/c2h5oh/datasize"
 	"github.com/ernoaapa/eliot/cmd"
 	"github.com/ernoaapa/eliot/pkg/api"
 	"github.com/ernoaapa/eliot/pkg/api/core"
@@ -22,6 +24,7 @@ import (
 	"github.com/ernoaapa/eliot/pkg/term"
 	"github.com/ernoaapa/eliot/pkg/utils"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"github.com/urfave/cli"
 )
 
@@ -43,6 +46,9 @@ var upCommand = cli.Command{
 
 	 # Run container with name in the node
 	 eli up --image docker.io/eaapa/hello-world:latest --name my-pod
+
+	 # Run code in the bridge network and publish port 3000 to the node
+	 eli up --port 3000
 `,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -61,6 +67,10 @@ var upCommand = cli.Command{
 			Name:  "bind",
 			Usage: "Bind a directory in host to the container. Format: /source:/target:options, E.g. /var:/var:rshared",
 		},
+		cli.StringSliceFlag{
+			Name:  "port, p",
+			Usage: "Publish container port to the node. Format: hostPort:containerPort/protocol, E.g. --port 8080:80/tcp",
+		},
 		cli.StringSliceFlag{
 			Name:  "env, e",
 			Usage: "Set environment variable into the container. E.g. --env FOO=bar",
@@ -85,6 +95,20 @@ var upCommand = cli.Command{
 			Name:  "workdir, w",
 			Usage: "Working directory inside the container",
 		},
+		cli.StringFlag{
+			Name:  "sync-log",
+			Usage: "Append the file sync events to the file",
+		},
+		cli.BoolFlag{
+			Name:  "sync-status",
+			Usage: "Show file sync status of the running 'eli up' session and exit",
+		},
+		cli.StringFlag{
+			Name:   "detach-keys",
+			Usage:  "Key sequence to detach from the container when stdin is open",
+			EnvVar: "ELIOT_DETACH_KEYS",
+			Value:  term.DefaultDetachKeys,
+		},
 	},
 	Action: func(clicontext *cli.Context) (err error) {
 
@@ -99,6 +123,7 @@ var upCommand = cli.Command{
 			syncs         = cmd.MustParseSyncs(append(projectConfig.Syncs, clicontext.StringSlice("sync")...))
 			mounts        = cmd.MustParseMounts(append(projectConfig.Mounts, clicontext.StringSlice("mount")...))
 			binds         = cmd.MustParseBinds(append(projectConfig.Binds, clicontext.StringSlice("bind")...))
+			ports         = cmd.MustParsePorts(append(projectConfig.Ports, clicontext.StringSlice("port")...))
 			args          = append(projectConfig.Command, cmd.DropDoubleDash(clicontext.Args())...)
 
 			stdin  = os.Stdin
@@ -111,6 +136,20 @@ var upCommand = cli.Command{
 		}
 
 		conf := cmd.GetConfigProvider(clicontext)
+		statusPath := cmd.GetSyncStatusPath(conf.GetNamespace(), name)
+
+		if clicontext.Bool("sync-status") {
+			status, err := sync.ReadStatus(statusPath)
+			if err != nil {
+				return err
+			}
+			if status == nil {
+				return fmt.Errorf("No 'eli up' session running for pod [%s]", name)
+			}
+			printSyncStatus(stdout, status)
+			return nil
+		}
+
 		client := cmd.GetClient(conf)
 
 		if image == "" {
@@ -134,23 +173,22 @@ var upCommand = cli.Command{
 
 		opts := []api.PodOpts{}
 
-		if len(syncs) > 0 && projectConfig.SyncContainer != nil {
-			syncDestinations := []string{}
+		if len(syncs) > 0 {
 			mounts := []*containers.Mount{}
-
 			for _, sync := range syncs {
-				syncDestinations = append(syncDestinations, sync.Destination)
-				mounts = append(mounts, cmd.MustParseBindFlag(fmt.Sprintf("/var/lib/volumes/%s/%s:%s:rw,rshared", name, strings.Replace(sync.Destination, "/", "_", -1), sync.Destination)))
+				volumeName := cmd.GetSyncVolumeName(name, sync.Destination)
+				opts = append(opts, api.WithVolume(volumeName, ""))
+				mounts = append(mounts, &containers.Mount{
+					Type:        "volume",
+					Source:      volumeName,
+					Destination: sync.Destination,
+					Options:     []string{"rw", "rshared"},
+				})
 			}
-
-			projectConfig.SyncContainer.Env = append(projectConfig.SyncContainer.Env, fmt.Sprintf("VOLUMES=%s", strings.Join(syncDestinations, " ")))
-
-			opts = append(opts, api.WithContainer(projectConfig.SyncContainer))
-
 			opts = append(opts, api.WithSharedMount(mounts...))
 
-			if workdir == "" && len(syncDestinations) == 1 {
-				opts = append(opts, api.WithWorkingDir(syncDestinations[0]))
+			if workdir == "" && len(syncs) == 1 {
+				opts = append(opts, api.WithWorkingDir(syncs[0].Destination))
 			}
 		}
 
@@ -160,7 +198,8 @@ var upCommand = cli.Command{
 				Namespace: conf.GetNamespace(),
 			},
 			Spec: &pods.PodSpec{
-				HostNetwork: true,
+				// Use the bridge network only when some ports get published
+				HostNetwork: len(ports) == 0,
 				Containers: []*containers.Container{
 					{
 						Name:       name,
@@ -170,6 +209,7 @@ var upCommand = cli.Command{
 						Env:        env,
 						WorkingDir: workdir,
 						Mounts:     append(mounts, binds...),
+						Ports:      ports,
 					},
 				},
 			},
@@ -184,13 +224,13 @@ var upCommand = cli.Command{
 			return errors.Wrapf(createErr, "Error in creating pod")
 		}
 
-		result, err := client.StartPod(name)
-		if err != nil {
-			return errors.Wrapf(err, "Error in starting pod")
-		}
-
+		// User can keep the pod running when detaches from it
+		keep := false
 		if rm {
 			defer func() {
+				if keep {
+					return
+				}
 				log := ui.NewLine().Loadingf("Delete pod %s", pod.Metadata.Name)
 				_, err := client.DeletePod(pod)
 				if err != nil {
@@ -201,25 +241,115 @@ var upCommand = cli.Command{
 			}()
 		}
 
+		// Sync the files before start so the process sees them right away
+		var (
+			syncers  = []*sync.Syncer{}
+			reporter *sync.Reporter
+		)
+		if len(syncs) > 0 {
+			log := ui.NewLine().Loading("Sync files to the pod...")
+
+			syncLog, err := openSyncLog(clicontext.String("sync-log"))
+			if err != nil {
+				log.Errorf("Failed to open sync log: %s", err)
+				return errors.Wrapf(err, "Failed to open sync log")
+			}
+			if syncLog != nil {
+				defer syncLog.Close()
+			}
+			reporter = sync.NewReporter(sync.Status{Pod: name, StartedAt: time.Now()}, statusPath, syncLog)
+			defer reporter.Close()
+
+			for _, s := range syncs {
+				source, err := filepath.Abs(s.Source)
+				if err != nil {
+					log.Errorf("Invalid sync source [%s]: %s", s.Source, err)
+					return errors.Wrapf(err, "Invalid sync source [%s]", s.Source)
+				}
+
+				remote, err := client.OpenVolumeSync(cmd.GetSyncVolumeName(name, s.Destination))
+				if err != nil {
+					log.Errorf("Failed to open sync to [%s]: %s", s.Destination, err)
+					return errors.Wrapf(err, "Failed to open sync to [%s]", s.Destination)
+				}
+				defer remote.Close()
+
+				ignore, err := cmd.GetSyncIgnore(source, projectConfig.SyncIgnore)
+				if err != nil {
+					log.Errorf("Failed to read sync ignore patterns for [%s]: %s", s.Source, err)
+					return errors.Wrapf(err, "Failed to read sync ignore patterns for [%s]", s.Source)
+				}
+
+				syncer := sync.NewSyncer(source, remote, ignore.Match)
+				synced, err := syncer.Sync()
+				if err != nil {
+					log.Errorf("Failed to sync [%s] to [%s]: %s", s.Source, s.Destination, err)
+					return errors.Wrapf(err, "Failed to sync [%s] to [%s]", s.Source, s.Destination)
+				}
+				reporter.Report(sync.Event{Time: time.Now(), Source: source, Result: synced})
+				syncers = append(syncers, syncer)
+			}
+
+			status := reporter.Status()
+			if status.ErrorCount > 0 {
+				log.Warnf("Synced files with %d errors: %s", status.ErrorCount, strings.Join(status.Errors, ", "))
+			} else {
+				log.Donef("Synced files, %d written, %d deleted", status.Written, status.Deleted)
+			}
+		}
+
+		result, err := client.StartPod(name)
+		if err != nil {
+			return errors.Wrapf(err, "Error in starting pod")
+		}
+
 		attachContainerID, err := cmd.FindRunningContainerID(result, name)
 		if err != nil {
 			return errors.Wrapf(err, "Cannot attach to container")
 		}
 
-		hooks := []api.AttachHooks{}
-		if len(syncs) > 0 {
-			hooks = append(hooks, func(endpoint config.Endpoint, done <-chan struct{}) {
-				sync.StartRsync(done, endpoint.GetHost(), 873, syncs, 1*time.Second)
-			})
+		var reloader *cmd.Reloader
+		if projectConfig.Watch != nil && reporter != nil {
+			reloader, err = cmd.NewReloader(client, name, attachContainerID, *projectConfig.Watch, stdout, tty)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Keep syncing over the main process restarts
+		syncDone := make(chan struct{})
+		defer close(syncDone)
+		if reporter != nil {
+			events := make(chan sync.Event)
+			go func() {
+				for event := range events {
+					reporter.Report(event)
+					if reloader != nil {
+						reloader.Changed(event)
+					}
+				}
+			}()
+			if reloader != nil {
+				go reloader.Run(syncDone)
+			}
+
+			for _, syncer := range syncers {
+				go func(syncer *sync.Syncer) {
+					if err := syncer.Watch(syncDone, events); err != nil {
+						log.Errorf("Stopped syncing file changes: %s", err)
+					}
+				}(syncer)
+			}
 		}
 
-		term := term.TTY{
+		terminal := term.TTY{
 			Out: stdout,
 		}
 
 		if clicontext.Bool("stdin") {
-			term.In = stdin
-			term.Raw = true
+			terminal.In = stdin
+			terminal.Raw = true
+			terminal.DetachKeys = clicontext.String("detach-keys")
 		} else {
 			sigc := cmd.ForwardAllSignals(func(signal syscall.Signal) error {
 				return client.Signal(attachContainerID, signal)
@@ -231,8 +361,61 @@ var upCommand = cli.Command{
 		ui.Stop()
 		defer ui.Start()
 
-		return term.Safe(func() error {
-			return client.Attach(attachContainerID, api.NewAttachIO(term.In, term.Out, stderr), hooks...)
-		})
+		attachIO, err := api.NewTTYAttachIO(&terminal, stderr)
+		if err != nil {
+			return err
+		}
+
+		for {
+			err = terminal.Safe(func() error {
+				return client.Attach(attachContainerID, attachIO)
+			})
+			if term.IsDetached(err) || reloader == nil || !reloader.Restarting() {
+				break
+			}
+			// The reloader terminated the main process, attach again when it's started
+			if err = reloader.Start(); err != nil {
+				break
+			}
+		}
+		if reporter != nil {
+			if status := reporter.Status(); status.ErrorCount > 0 {
+				ui.NewLine().Warnf("File sync had %d errors, latest: %s", status.ErrorCount, status.Errors[len(status.Errors)-1])
+			}
+		}
+		if term.IsDetached(err) {
+			keep = !rm || cmd.Confirm(os.Stdin, stdout, fmt.Sprintf("Detached from pod %s. Keep the pod running?", name))
+			if keep {
+				ui.NewLine().Infof("Pod %s keeps running, attach again with 'eli attach -i %s'", name, name)
+			}
+			return nil
+		}
+		return err
 	},
 }
+
+// openSyncLog opens the file for appending the sync events, returns nil if the path is not defined
+func openSyncLog(path string) (*os.File, error) {
+	if path == "" {
+		return nil, nil
+	}
+	return os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+}
+
+func printSyncStatus(w io.Writer, status *sync.Status) {
+	lastSync := "never"
+	if !status.LastSync.IsZero() {
+		lastSync = fmt.Sprintf("%s ago", time.Since(status.LastSync).Round(time.Second))
+	}
+
+	fmt.Fprintf(w, "Pod:        %s\n", status.Pod)
+	fmt.Fprintf(w, "Started:    %s ago\n", time.Since(status.StartedAt).Round(time.Second))
+	fmt.Fprintf(w, "Last sync:  %s\n", lastSync)
+	fmt.Fprintf(w, "Written:    %d\n", status.Written)
+	fmt.Fprintf(w, "Deleted:    %d\n", status.Deleted)
+	fmt.Fprintf(w, "Bytes:      %s\n", datasize.ByteSize(status.Bytes).HumanReadable())
+	fmt.Fprintf(w, "Errors:     %d\n", status.ErrorCount)
+	for _, message := range status.Errors {
+		fmt.Fprintf(w, "  %s\n", message)
+	}
+}
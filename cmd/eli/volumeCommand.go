@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/urfave/cli"
+)
+
+var volumeCommand = cli.Command{
+	Name:        "volume",
+	HelpName:    "volume",
+	Usage:       `Manage volume data`,
+	Description: "With this command you can move data in and out from the node volumes",
+	ArgsUsage: `eli volume COMMAND [options]
+
+	 # Export volume data as tar
+	 eli volume export my-data > data.tar
+
+	 # Import volume data from tar
+	 eli volume import my-data < data.tar`,
+	Subcommands: []cli.Command{
+		volumeExportCommand,
+		volumeImportCommand,
+	},
+}
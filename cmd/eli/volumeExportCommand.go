@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ernoaapa/eliot/cmd"
+	pkgcmd "github.com/ernoaapa/eliot/pkg/cmd"
+	"github.com/urfave/cli"
+)
+
+var volumeExportCommand = cli.Command{
+	Name:  "export",
+	Usage: "Export volume data as tar stream to stdout",
+	UsageText: `eli volume export [options] <VOLUME NAME>
+
+	 # Export all data from 'my-data' volume
+	 eli volume export my-data > data.tar
+
+	 # Export gzip compressed logs directory from 'my-data' volume
+	 eli volume export --gzip --path logs my-data > logs.tar.gz`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "gzip, z",
+			Usage: "Compress the tar stream with gzip",
+		},
+		cli.StringSliceFlag{
+			Name:  "path",
+			Usage: "Export only given path (relative to the volume root). You can give multiple paths",
+		},
+	},
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() == 0 || clicontext.Args().First() == "" {
+			return fmt.Errorf("You must give Volume name as first argument")
+		}
+		name := clicontext.Args().First()
+
+		if !pkgcmd.IsPipingOut() {
+			return fmt.Errorf("Refusing to write tar stream to terminal, redirect the output to file. E.g. eli volume export %s > data.tar", name)
+		}
+
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		return client.ExportVolume(name, os.Stdout, clicontext.Bool("gzip"), clicontext.StringSlice("path"))
+	},
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ernoaapa/eliot/cmd"
+	pkgcmd "github.com/ernoaapa/eliot/pkg/cmd"
+	"github.com/ernoaapa/eliot/pkg/cmd/ui"
+	"github.com/urfave/cli"
+)
+
+var volumeImportCommand = cli.Command{
+	Name:  "import",
+	Usage: "Import tar stream from stdin to volume",
+	UsageText: `eli volume import [options] <VOLUME NAME>
+
+	 # Import data to 'my-data' volume, the volume get created if it doesn't exist
+	 eli volume import my-data < data.tar
+
+	 # Gzip compressed tar get detected automatically
+	 eli volume import my-data < data.tar.gz`,
+	Action: func(clicontext *cli.Context) error {
+		if clicontext.NArg() == 0 || clicontext.Args().First() == "" {
+			return fmt.Errorf("You must give Volume name as first argument")
+		}
+		name := clicontext.Args().First()
+
+		if !pkgcmd.IsPipingIn() {
+			return fmt.Errorf("You must pipe the tar stream to stdin. E.g. eli volume import %s < data.tar", name)
+		}
+
+		config := cmd.GetConfigProvider(clicontext)
+		client := cmd.GetClient(config)
+
+		uiline := ui.NewLine().Loadingf("Importing data to volume %s...", name)
+		volume, err := client.ImportVolume(name, os.Stdin)
+		if err != nil {
+			uiline.Fatalf("Failed to import data to volume %s: %s", name, err)
+		}
+		uiline.Donef("Imported data to volume %s", volume.Metadata.Name)
+		return nil
+	},
+}
@@ -10,10 +10,19 @@ import (
 
 	"github.com/ernoaapa/eliot/cmd"
 	"github.com/ernoaapa/eliot/pkg/api"
+	"github.com/ernoaapa/eliot/pkg/chrootarchive"
+	"github.com/ernoaapa/eliot/pkg/configs"
 	"github.com/ernoaapa/eliot/pkg/controller"
 	"github.com/ernoaapa/eliot/pkg/discovery"
+	"github.com/ernoaapa/eliot/pkg/hardware"
+	"github.com/ernoaapa/eliot/pkg/maintenance"
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/network"
 	"github.com/ernoaapa/eliot/pkg/node"
+	"github.com/ernoaapa/eliot/pkg/power"
 	"github.com/ernoaapa/eliot/pkg/profile"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/ernoaapa/eliot/pkg/volume"
 	log "github.com/sirupsen/logrus"
 	"github.com/thejerf/suture"
 	"github.com/urfave/cli"
@@ -25,6 +34,9 @@ var commit = "unknown"
 var date = time.Now().Format("2006-01-02_15:04:05")
 
 func main() {
+	// Run as the file copy helper if eliotd re-executed itself for it
+	chrootarchive.Init()
+
 	app := cli.NewApp()
 	app.Name = "eliotd"
 	app.Usage = "Daemon for the node to enable Eliot"
@@ -52,6 +64,12 @@ func main() {
 			EnvVar: "ELIOT_CONTAINERD_SNAPSHOTTER",
 			Value:  "overlayfs",
 		},
+		cli.IntFlag{
+			Name:   "attach-replay-size",
+			Usage:  "how many bytes of the latest container output to show when attaching to a container",
+			EnvVar: "ELIOT_ATTACH_REPLAY_SIZE",
+			Value:  runtime.DefaultReplaySize,
+		},
 		cli.DurationFlag{
 			Name:   "timeout, t",
 			Usage:  "total timeout for runtime requests",
@@ -89,11 +107,65 @@ func main() {
 			EnvVar: "ELIOT_PROFILE_ADDRESS",
 			Value:  "0.0.0.0:8000",
 		},
+		cli.StringFlag{
+			Name:   "sysfs-root",
+			Usage:  "Path to the sysfs where to discover node hardware devices",
+			EnvVar: "ELIOT_SYSFS_ROOT",
+			Value:  "/sys",
+		},
+		cli.StringFlag{
+			Name:   "bridge-name",
+			Usage:  "Name of the bridge interface where pods without host network get connected",
+			EnvVar: "ELIOT_BRIDGE_NAME",
+			Value:  network.DefaultBridgeName,
+		},
+		cli.StringFlag{
+			Name:   "pod-subnet",
+			Usage:  "Subnet where the bridge network pod IP addresses get allocated from",
+			EnvVar: "ELIOT_POD_SUBNET",
+			Value:  network.DefaultSubnet,
+		},
+		cli.StringFlag{
+			Name:   "volumes-root",
+			Usage:  "Directory where the named volumes get stored",
+			EnvVar: "ELIOT_VOLUMES_ROOT",
+			Value:  volume.DefaultRoot,
+		},
+		cli.StringFlag{
+			Name:   "configs-root",
+			Usage:  "Directory where the secrets and configmaps get stored",
+			EnvVar: "ELIOT_CONFIGS_ROOT",
+			Value:  configs.DefaultRoot,
+		},
+		cli.StringFlag{
+			Name:   "projected-root",
+			Usage:  "Directory where the secret and configmap files get written for the containers. Tmpfs get mounted to the directory",
+			EnvVar: "ELIOT_PROJECTED_ROOT",
+			Value:  configs.DefaultProjectedRoot,
+		},
 		cli.StringFlag{
 			Name:   "labels",
 			Usage:  "Comma separated list of node labels. E.g. --labels node=rpi3,location=home,environment=testing",
 			EnvVar: "ELIOT_LABELS",
 		},
+		cli.StringFlag{
+			Name:   "maintenance-file",
+			Usage:  "File where the node cordon and drain state get stored",
+			EnvVar: "ELIOT_MAINTENANCE_FILE",
+			Value:  maintenance.DefaultStateFile,
+		},
+		cli.StringFlag{
+			Name:   "power-executor",
+			Usage:  fmt.Sprintf("How to reboot or power off the host. One of: %s, %s", power.SyscallExecutorName, power.SystemdExecutorName),
+			EnvVar: "ELIOT_POWER_EXECUTOR",
+			Value:  power.SyscallExecutorName,
+		},
+		cli.StringFlag{
+			Name:   "labels-file",
+			Usage:  "File where the node labels changed with 'eli label node' get stored. The labels get merged over the --labels at startup",
+			EnvVar: "ELIOT_LABELS_FILE",
+			Value:  node.DefaultLabelsFile,
+		},
 	}, cmd.GlobalFlags...)
 	app.Version = fmt.Sprintf("Version: %s, Commit: %s, Build at: %s", version, commit, date)
 	app.Before = cmd.GlobalBefore
@@ -104,9 +176,21 @@ func main() {
 			grpcPort   = parseGrpcPort(grpcListen)
 		)
 
-		resolver := node.NewResolver(grpcPort, version, cmd.GetLabels(clicontext))
+		resolver := node.NewResolver(grpcPort, version, cmd.GetLabels(clicontext), clicontext.String("labels-file"))
 		node := resolver.GetInfo()
 		client := cmd.GetRuntimeClient(clicontext, node.Hostname)
+		maintenanceManager := maintenance.NewManager(client, clicontext.String("maintenance-file"))
+		volumes := volume.NewManager(clicontext.String("volumes-root"))
+		store := configs.NewStore(clicontext.String("configs-root"), clicontext.String("projected-root"))
+		client.OnStart(func(pod model.Pod, container model.Container) ([]string, error) {
+			if err := volumes.EnsureMounted(pod.Metadata.Namespace, container); err != nil {
+				return nil, err
+			}
+			if err := store.EnsureProjected(pod, resolver.GetInfo(), container); err != nil {
+				return nil, err
+			}
+			return store.ResolveSecretEnv(pod.Metadata.Namespace, container)
+		})
 
 		supervisor := suture.NewSimple("eliotd")
 		serviceCount := 0
@@ -120,19 +204,33 @@ func main() {
 
 		if clicontext.Bool("grpc-api") {
 			log.Infoln("grpc-api enabled")
-			supervisor.Add(api.NewServer(grpcListen, client, resolver))
+			allocator := hardware.NewAllocator(hardware.NewDiscoverer(clicontext.String("sysfs-root")), client)
+			executor, err := power.NewExecutor(clicontext.String("power-executor"))
+			if err != nil {
+				return err
+			}
+			powerManager := power.NewManager(maintenanceManager, executor)
+			supervisor.Add(api.NewServer(grpcListen, client, resolver, allocator, volumes, store, maintenanceManager, powerManager))
 			serviceCount++
 		}
 
 		if clicontext.Bool("lifecycle-controller") {
 			log.Infoln("lifecycle-controller enabled")
-			supervisor.Add(controller.NewLifecycle(client))
+			supervisor.Add(controller.NewLifecycle(client, maintenanceManager))
 			serviceCount++
 		}
 
+		if clicontext.Bool("grpc-api") || clicontext.Bool("lifecycle-controller") {
+			supervisor.Add(controller.NewExits(client))
+		}
+
 		if clicontext.Bool("grpc-api") && clicontext.Bool("discovery") {
 			log.Infoln("grpc discovery over zeroconf enabled")
-			supervisor.Add(discovery.NewServer(node.Hostname, grpcPort, version))
+			discoveryServer := discovery.NewServer(node.Hostname, grpcPort, version, node.Labels)
+			discoveryServer.SetUnschedulable(maintenanceManager.IsCordoned())
+			resolver.OnLabelsChanged(discoveryServer.SetLabels)
+			maintenanceManager.OnChange(discoveryServer.SetUnschedulable)
+			supervisor.Add(discoveryServer)
 			serviceCount++
 		}
 
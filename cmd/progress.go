@@ -1,6 +1,10 @@
 package cmd
 
 import (
+	"io"
+	"sync"
+	"time"
+
 	ui "github.com/ernoaapa/eliot/pkg/cmd/ui"
 	"github.com/ernoaapa/eliot/pkg/progress"
 )
@@ -32,3 +36,77 @@ func ShowDownloadProgress(progressc <-chan []*progress.ImageFetch) {
 		line.Donef("Completed %s", image)
 	}
 }
+
+// progressInterval is how often the transfer progress get updated to the UI line
+const progressInterval = 100 * time.Millisecond
+
+// TransferProgress shows the transferred bytes as UI line progress bar
+type TransferProgress struct {
+	line    ui.Line
+	current int64
+	total   int64
+	updated time.Time
+	mu      sync.Mutex
+}
+
+// NewTransferProgress creates new TransferProgress what updates the line
+func NewTransferProgress(line ui.Line, total int64) *TransferProgress {
+	return &TransferProgress{line: line, total: total}
+}
+
+// SetTotal updates the total bytes to transfer
+func (p *TransferProgress) SetTotal(total int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.total = total
+}
+
+// Reader returns io.Reader what counts the bytes read from the reader
+func (p *TransferProgress) Reader(r io.Reader) io.Reader {
+	return &progressReader{r, p}
+}
+
+// Writer returns io.Writer what counts the bytes written to the writer
+func (p *TransferProgress) Writer(w io.Writer) io.Writer {
+	return &progressWriter{w, p}
+}
+
+func (p *TransferProgress) add(n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.current += int64(n)
+	if time.Since(p.updated) < progressInterval {
+		return
+	}
+	p.updated = time.Now()
+
+	current := p.current
+	if current > p.total {
+		// The total is only approximate
+		current = p.total
+	}
+	p.line.WithProgress(current, p.total)
+}
+
+type progressReader struct {
+	reader   io.Reader
+	progress *TransferProgress
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.progress.add(n)
+	return n, err
+}
+
+type progressWriter struct {
+	writer   io.Writer
+	progress *TransferProgress
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.progress.add(n)
+	return n, err
+}
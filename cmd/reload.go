@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/ernoaapa/eliot/pkg/api"
+	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
+	"github.com/ernoaapa/eliot/pkg/config"
+	"github.com/ernoaapa/eliot/pkg/sync"
+	"github.com/pkg/errors"
+)
+
+// defaultStopTimeout is how long the main process has time to exit after the signal
+// before it gets killed, e.g. process running as PID 1 ignores SIGTERM if it doesn't handle it
+const defaultStopTimeout = 10 * time.Second
+
+// reloadClient is the part of the API client what Reloader uses
+type reloadClient interface {
+	Exec(containerID string, opts api.ExecOptions, attachIO api.AttachIO, hooks ...api.AttachHooks) (int, error)
+	Signal(containerID string, signal syscall.Signal) error
+	GetPod(podName string) (*pods.Pod, error)
+	StartPod(name string) (*pods.Pod, error)
+}
+
+// Reloader reloads the 'eli up' container when the synced files match to the watch paths.
+// It runs the watch command in the container and restarts the main process by terminating it, or killing it
+// if it doesn't exit in time. The caller calls Start and attaches again once the main process has exited
+type Reloader struct {
+	client      reloadClient
+	podName     string
+	containerID string
+	config      config.WatchConfig
+	paths       *sync.Ignore
+	out         io.Writer
+	tty         bool
+	changes     chan int
+	count       int
+	restarting  int32
+	exited      chan struct{}
+	stopTimeout time.Duration
+}
+
+// NewReloader creates new Reloader what writes the reload separators and the command output to the out
+func NewReloader(client reloadClient, podName, containerID string, watch config.WatchConfig, out io.Writer, tty bool) (*Reloader, error) {
+	paths, err := sync.NewIgnore(watch.Paths)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid watch paths")
+	}
+	return &Reloader{
+		client:      client,
+		podName:     podName,
+		containerID: containerID,
+		config:      watch,
+		paths:       paths,
+		out:         out,
+		tty:         tty,
+		changes:     make(chan int, 1),
+		exited:      make(chan struct{}, 1),
+		stopTimeout: defaultStopTimeout,
+	}, nil
+}
+
+// Changed triggers reload if any of the synced changes match to the watch paths
+func (r *Reloader) Changed(event sync.Event) {
+	count := 0
+	for _, change := range event.Changes {
+		if r.paths.MatchPath(change.State.Path, change.State.IsDir()) {
+			count++
+		}
+	}
+	if count == 0 {
+		return
+	}
+
+	for {
+		select {
+		case r.changes <- count:
+			return
+		case pending := <-r.changes:
+			// Reload is already waiting, merge the changes to it
+			count += pending
+		}
+	}
+}
+
+// Run reloads on changes until the done channel get closed
+func (r *Reloader) Run(done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case count := <-r.changes:
+			r.reload(count)
+		}
+	}
+}
+
+// Restarting returns true if the main process exited because of the reload
+func (r *Reloader) Restarting() bool {
+	return atomic.LoadInt32(&r.restarting) == 1
+}
+
+// Start starts the main process again after restart, unless the node already restarted it
+func (r *Reloader) Start() error {
+	defer func() {
+		atomic.StoreInt32(&r.restarting, 0)
+		select {
+		case r.exited <- struct{}{}:
+		default:
+		}
+	}()
+
+	pod, err := r.client.GetPod(r.podName)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to restart pod [%s]", r.podName)
+	}
+	if isContainerRunning(pod, r.containerID) {
+		return nil
+	}
+
+	if _, err := r.client.StartPod(r.podName); err != nil {
+		return errors.Wrapf(err, "Failed to restart pod [%s]", r.podName)
+	}
+	return nil
+}
+
+func (r *Reloader) reload(count int) {
+	r.count++
+
+	if len(r.config.Command) > 0 {
+		r.separator("%d file(s) changed, run %s", count, strings.Join(r.config.Command, " "))
+
+		exitCode, err := r.client.Exec(r.containerID, api.ExecOptions{Args: r.config.Command, Tty: r.tty}, api.AttachIO{Stdout: r.out, Stderr: r.out})
+		if err != nil {
+			r.separator("failed: %s", err)
+			return
+		}
+		if exitCode != 0 {
+			r.separator("failed: command exited with code %d", exitCode)
+			return
+		}
+		if !r.config.Restart {
+			r.separator("done")
+			return
+		}
+		r.separator("restart")
+	} else {
+		r.separator("%d file(s) changed, restart", count)
+	}
+
+	atomic.StoreInt32(&r.restarting, 1)
+	if err := r.terminate(); err != nil {
+		atomic.StoreInt32(&r.restarting, 0)
+		r.separator("failed: %s", err)
+	}
+}
+
+// terminate signals the main process to exit and kills it if it doesn't exit in time.
+// Returns once the caller has noticed the exit and called Start
+func (r *Reloader) terminate() error {
+	select {
+	case <-r.exited:
+	default:
+	}
+
+	for _, signal := range []syscall.Signal{syscall.SIGTERM, syscall.SIGKILL} {
+		if err := r.client.Signal(r.containerID, signal); err != nil {
+			return err
+		}
+		select {
+		case <-r.exited:
+			return nil
+		case <-time.After(r.stopTimeout):
+		}
+	}
+	return errors.Errorf("main process didn't exit in %s after kill", r.stopTimeout)
+}
+
+// separator writes the reload status line what separates the reload cycles in the output
+func (r *Reloader) separator(format string, args ...interface{}) {
+	newline := "\n"
+	if r.tty {
+		// The terminal is in raw mode
+		newline = "\r\n"
+	}
+	message := fmt.Sprintf(format, args...)
+	fmt.Fprintf(r.out, "%s----- [%s] reload #%d: %s -----%s", newline, time.Now().Format("15:04:05"), r.count, message, newline)
+}
+
+func isContainerRunning(pod *pods.Pod, containerID string) bool {
+	if pod.Status == nil {
+		return false
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.ContainerID == containerID {
+			return status.State == "running"
+		}
+	}
+	return false
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"bytes"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/ernoaapa/eliot/pkg/api"
+	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
+	"github.com/ernoaapa/eliot/pkg/config"
+	"github.com/ernoaapa/eliot/pkg/sync"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeReloadClient struct {
+	execs    chan []string
+	exitCode int
+	signals  chan syscall.Signal
+	state    string
+	started  bool
+	onSignal func(signal syscall.Signal)
+}
+
+func newFakeReloadClient() *fakeReloadClient {
+	return &fakeReloadClient{
+		execs:   make(chan []string, 10),
+		signals: make(chan syscall.Signal, 10),
+	}
+}
+
+func (c *fakeReloadClient) Exec(containerID string, opts api.ExecOptions, attachIO api.AttachIO, hooks ...api.AttachHooks) (int, error) {
+	attachIO.Stdout.Write([]byte("building\n"))
+	c.execs <- opts.Args
+	return c.exitCode, nil
+}
+
+func (c *fakeReloadClient) Signal(containerID string, signal syscall.Signal) error {
+	c.signals <- signal
+	if c.onSignal != nil {
+		c.onSignal(signal)
+	}
+	return nil
+}
+
+func (c *fakeReloadClient) GetPod(podName string) (*pods.Pod, error) {
+	return &pods.Pod{
+		Status: &pods.PodStatus{
+			ContainerStatuses: []*containers.ContainerStatus{
+				{ContainerID: "my-app", State: c.state},
+			},
+		},
+	}, nil
+}
+
+func (c *fakeReloadClient) StartPod(name string) (*pods.Pod, error) {
+	c.started = true
+	return nil, nil
+}
+
+func changedEvent(paths ...string) sync.Event {
+	event := sync.Event{}
+	for _, path := range paths {
+		event.Changes = append(event.Changes, sync.Change{State: sync.FileState{Path: path}})
+	}
+	return event
+}
+
+func TestReloaderRunsCommandAndRestarts(t *testing.T) {
+	client := newFakeReloadClient()
+	out := &bytes.Buffer{}
+	reloader, err := NewReloader(client, "my-app", "my-app", config.WatchConfig{
+		Paths:   []string{"*.go"},
+		Command: []string{"go", "build"},
+		Restart: true,
+	}, out, false)
+	assert.NoError(t, err)
+
+	done := make(chan struct{})
+	defer close(done)
+	go reloader.Run(done)
+
+	reloader.Changed(changedEvent("README.md"))
+	reloader.Changed(changedEvent("cmd/main.go", "README.md"))
+
+	select {
+	case args := <-client.execs:
+		assert.Equal(t, []string{"go", "build"}, args)
+	case <-time.After(time.Second):
+		t.Fatal("Reloader didn't run the command")
+	}
+	select {
+	case signal := <-client.signals:
+		assert.Equal(t, syscall.SIGTERM, signal)
+	case <-time.After(time.Second):
+		t.Fatal("Reloader didn't restart the main process")
+	}
+
+	assert.True(t, reloader.Restarting())
+	client.state = "stopped"
+	assert.NoError(t, reloader.Start())
+	assert.True(t, client.started, "Should start the pod if the container is not running")
+	assert.False(t, reloader.Restarting())
+
+	assert.Contains(t, out.String(), "reload #1: 1 file(s) changed, run go build -----")
+	assert.Contains(t, out.String(), "building\n")
+	assert.Contains(t, out.String(), "reload #1: restart -----")
+	assert.Len(t, client.execs, 0, "Should not reload when other files change")
+}
+
+func TestReloaderDoesNotRestartIfCommandFails(t *testing.T) {
+	client := newFakeReloadClient()
+	client.exitCode = 2
+	out := &bytes.Buffer{}
+	reloader, err := NewReloader(client, "my-app", "my-app", config.WatchConfig{
+		Paths:   []string{"src/"},
+		Command: []string{"make"},
+		Restart: true,
+	}, out, false)
+	assert.NoError(t, err)
+
+	reloader.reload(1)
+
+	assert.Len(t, client.signals, 0)
+	assert.False(t, reloader.Restarting())
+	assert.Contains(t, out.String(), "failed: command exited with code 2")
+}
+
+func TestReloaderWithoutCommandRestarts(t *testing.T) {
+	client := newFakeReloadClient()
+	reloader, err := NewReloader(client, "my-app", "my-app", config.WatchConfig{Paths: []string{"src/"}}, &bytes.Buffer{}, true)
+	assert.NoError(t, err)
+
+	client.state = "running"
+	client.onSignal = func(signal syscall.Signal) {
+		assert.NoError(t, reloader.Start())
+	}
+
+	reloader.Changed(changedEvent("src/app/main.py"))
+	reloader.reload(<-reloader.changes)
+
+	assert.Len(t, client.execs, 0)
+	assert.Len(t, client.signals, 1)
+	assert.False(t, client.started, "Should not start the pod if the node already restarted it")
+}
+
+func TestReloaderKillsIfProcessIgnoresTerm(t *testing.T) {
+	client := newFakeReloadClient()
+	out := &bytes.Buffer{}
+	reloader, err := NewReloader(client, "my-app", "my-app", config.WatchConfig{Paths: []string{"src/"}}, out, false)
+	assert.NoError(t, err)
+	reloader.stopTimeout = 10 * time.Millisecond
+
+	client.state = "stopped"
+	client.onSignal = func(signal syscall.Signal) {
+		if signal == syscall.SIGKILL {
+			assert.True(t, reloader.Restarting())
+			assert.NoError(t, reloader.Start())
+		}
+	}
+	reloader.reload(1)
+
+	assert.Equal(t, syscall.SIGTERM, <-client.signals)
+	assert.Equal(t, syscall.SIGKILL, <-client.signals)
+	assert.True(t, client.started)
+	assert.False(t, reloader.Restarting())
+	assert.NotContains(t, out.String(), "failed")
+}
+
+func TestReloaderClearsRestartingIfProcessDoesNotExit(t *testing.T) {
+	client := newFakeReloadClient()
+	out := &bytes.Buffer{}
+	reloader, err := NewReloader(client, "my-app", "my-app", config.WatchConfig{Paths: []string{"src/"}}, out, false)
+	assert.NoError(t, err)
+	reloader.stopTimeout = 10 * time.Millisecond
+
+	reloader.reload(1)
+
+	assert.Len(t, client.signals, 2)
+	assert.False(t, reloader.Restarting(), "Should not treat the next exit as reload")
+	assert.Contains(t, out.String(), "failed: main process didn't exit")
+}
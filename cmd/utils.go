@@ -1,13 +1,17 @@
 package cmd
 
 import (
+	"bufio"
 	"context"
 	"encoding/csv"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"os/signal"
 	"os/user"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
 	"time"
@@ -23,10 +27,14 @@ import (
 
 	"github.com/ernoaapa/eliot/pkg/api"
 	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+	node "github.com/ernoaapa/eliot/pkg/api/services/node/v1"
 	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
 	"github.com/ernoaapa/eliot/pkg/config"
 	"github.com/ernoaapa/eliot/pkg/fs"
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/network"
 	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/pkg/errors"
 	"github.com/urfave/cli"
 )
 
@@ -97,6 +105,80 @@ func GetClient(config *config.Provider) *api.Client {
 	}
 }
 
+// GetClientForNode finds the node endpoint by name and returns client connected to it
+func GetClientForNode(provider *config.Provider, name string) (*api.Client, error) {
+	endpoint, ok := provider.GetEndpointByName(name)
+	if !ok {
+		return nil, fmt.Errorf("No node found with name %s", name)
+	}
+	provider.OverrideEndpoints([]config.Endpoint{endpoint})
+	return GetClient(provider), nil
+}
+
+// GetClientsForNodeSelector returns clients to the configured nodes what match to the node selector.
+// With single node, the client is returned without checking the labels, so the node rejects the pod with clear error
+func GetClientsForNodeSelector(config *config.Provider, nodeSelector map[string]string) (result []*api.Client) {
+	endpoints := config.GetEndpoints()
+	if len(endpoints) <= 1 {
+		return []*api.Client{GetClient(config)}
+	}
+
+	if len(nodeSelector) == 0 {
+		ui.NewLine().Fatalf("%d node found. You must give target node or nodeSelector. E.g. --endpoint=192.168.1.2", len(endpoints))
+	}
+
+	for _, endpoint := range endpoints {
+		uiline := ui.NewLine().Loadingf("Connecting to %s (%s)", endpoint.Name, endpoint.URL)
+		client := api.NewClient(config.GetNamespace(), endpoint)
+		info, err := client.GetInfo()
+		if err != nil {
+			logrus.Debugf("Connection failure: %s", err)
+			uiline.Warnf("Skip %s (%s), failed to connect", endpoint.Name, endpoint.URL)
+			continue
+		}
+
+		if !model.MatchesNodeSelector(nodeSelector, MapLabels(info.Labels)) {
+			uiline.Infof("Skip %s (%s), labels don't match to nodeSelector", info.Hostname, endpoint.URL)
+			continue
+		}
+		uiline.Donef("Connected to %s (%s)", info.Hostname, endpoint.URL)
+		result = append(result, client)
+	}
+
+	if len(result) == 0 {
+		ui.NewLine().Fatalf("No node found matching to nodeSelector %v", nodeSelector)
+	}
+	return result
+}
+
+// ParseLabelArgs parses label arguments in format KEY=VALUE to set and KEY- to remove
+func ParseLabelArgs(args []string) (set []*node.Label, remove []string, err error) {
+	for _, arg := range args {
+		switch {
+		case strings.Contains(arg, "="):
+			parts := strings.SplitN(arg, "=", 2)
+			if parts[0] == "" {
+				return nil, nil, fmt.Errorf("Invalid label [%s], key cannot be empty", arg)
+			}
+			set = append(set, &node.Label{Key: parts[0], Value: parts[1]})
+		case strings.HasSuffix(arg, "-") && len(arg) > 1:
+			remove = append(remove, strings.TrimSuffix(arg, "-"))
+		default:
+			return nil, nil, fmt.Errorf("Invalid label [%s], must be in format KEY=VALUE to set or KEY- to remove", arg)
+		}
+	}
+	return set, remove, nil
+}
+
+// MapLabels maps node labels to map
+func MapLabels(labels []*node.Label) map[string]string {
+	result := map[string]string{}
+	for _, label := range labels {
+		result[label.Key] = label.Value
+	}
+	return result
+}
+
 // GetConfig parse yaml config and return the file representation
 // In normal cases, you should use GetConfigProvider
 func GetConfig(clicontext *cli.Context) *config.Config {
@@ -191,12 +273,23 @@ func GetLabels(clicontext *cli.Context) map[string]string {
 
 // GetRuntimeClient initialises new runtime client from CLI parameters
 func GetRuntimeClient(clicontext *cli.Context, hostname string) runtime.Client {
+	bridge, err := network.NewBridge(
+		clicontext.String("bridge-name"),
+		clicontext.String("pod-subnet"),
+		network.DefaultDataDir,
+	)
+	if err != nil {
+		logrus.Fatalf("Failed to initialise pod network: %s", err)
+	}
+
 	return runtime.NewContainerdClient(
 		context.Background(),
 		clicontext.GlobalDuration("timeout"),
 		clicontext.GlobalString("containerd"),
 		clicontext.String("containerd-snapshotter"),
 		hostname,
+		bridge,
+		clicontext.Int("attach-replay-size"),
 	)
 }
 
@@ -304,6 +397,76 @@ func ParseBindFlag(b string) (*containers.Mount, error) {
 	}, nil
 }
 
+// MustParsePorts parses list of port strings in the form "8080:80/tcp"
+func MustParsePorts(ports []string) (result []*containers.ContainerPort) {
+	for _, flag := range ports {
+		port, err := ParsePortFlag(flag)
+		if err != nil {
+			ui.NewLine().Fatalf("Failed to parse --port flag: %s", err)
+		}
+		result = append(result, port)
+	}
+	return result
+}
+
+// ParsePortFlag parses a port mapping string in the form "hostPort:containerPort/protocol".
+// Host port and protocol are optional, e.g. "80" publishes container port 80 to host port 80 over tcp
+func ParsePortFlag(p string) (*containers.ContainerPort, error) {
+	port := &containers.ContainerPort{}
+	value := p
+	if i := strings.LastIndex(value, "/"); i >= 0 {
+		port.Protocol = value[i+1:]
+		value = value[:i]
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("Cannot parse port, too many ':': %s", p)
+	}
+
+	containerPort, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse port, invalid container port: %s", p)
+	}
+	port.ContainerPort = int32(containerPort)
+
+	if len(parts) == 2 {
+		hostPort, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("Cannot parse port, invalid host port: %s", p)
+		}
+		port.HostPort = int32(hostPort)
+	}
+	return port, nil
+}
+
+// ParsePortForwardArg parses a port forward string in the form "localPort:podPort".
+// Local port is optional, e.g. "80" forwards local port 80 and ":80" forwards random local port to pod port 80
+func ParsePortForwardArg(arg string) (localPort, podPort int, err error) {
+	parts := strings.Split(arg, ":")
+	if len(parts) > 2 {
+		return 0, 0, fmt.Errorf("Cannot parse port, too many ':': %s", arg)
+	}
+
+	podPort, err = strconv.Atoi(parts[len(parts)-1])
+	if err != nil || podPort <= 0 || podPort > 65535 {
+		return 0, 0, fmt.Errorf("Cannot parse port, invalid pod port: %s", arg)
+	}
+
+	if len(parts) == 1 {
+		return podPort, podPort, nil
+	}
+	if parts[0] == "" {
+		return 0, podPort, nil
+	}
+
+	localPort, err = strconv.Atoi(parts[0])
+	if err != nil || localPort < 0 || localPort > 65535 {
+		return 0, 0, fmt.Errorf("Cannot parse port, invalid local port: %s", arg)
+	}
+	return localPort, podPort, nil
+}
+
 // MustParseSyncs parses a sync string in the form "~/local/dir:/data"
 func MustParseSyncs(syncs []string) (result []sync.Sync) {
 	for _, value := range syncs {
@@ -317,6 +480,61 @@ func MustParseSyncs(syncs []string) (result []sync.Sync) {
 	return result
 }
 
+// ParseConfigData parses --from-literal KEY=value and --from-file [KEY=]path flag values to Secret or ConfigMap data.
+// If the file key is not given, the file name is used as key
+func ParseConfigData(literals, files []string) (map[string][]byte, error) {
+	result := map[string][]byte{}
+	for _, literal := range literals {
+		parts := strings.SplitN(literal, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("Invalid --from-literal value [%s], must be in format KEY=value", literal)
+		}
+		result[parts[0]] = []byte(parts[1])
+	}
+
+	for _, file := range files {
+		key, path := filepath.Base(file), file
+		if parts := strings.SplitN(file, "=", 2); len(parts) == 2 {
+			key, path = parts[0], parts[1]
+		}
+
+		content, err := ioutil.ReadFile(expandTilde(path))
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to read --from-file [%s]", path)
+		}
+		result[key] = content
+	}
+	return result, nil
+}
+
+// GetSyncVolumeName returns name of the volume where the pod sync destination data get stored.
+// E.g. pod "my-app" and destination "/go/src/app" returns "my-app-go-src-app"
+func GetSyncVolumeName(podName, destination string) string {
+	parts := strings.FieldsFunc(destination, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	})
+	return strings.Join(append([]string{podName}, parts...), "-")
+}
+
+// GetSyncIgnore returns the ignore rules for the sync source.
+// The default patterns come first, then the patterns from the project config and the .eliotignore file in the source
+func GetSyncIgnore(source string, patterns []string) (*sync.Ignore, error) {
+	filePatterns, err := sync.ReadIgnoreFile(filepath.Join(source, sync.IgnoreFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	all := append([]string{}, sync.DefaultIgnorePatterns...)
+	all = append(all, patterns...)
+	all = append(all, filePatterns...)
+	return sync.NewIgnore(all)
+}
+
+// GetSyncStatusPath returns path to the file where the running 'eli up' session keeps the pod sync status
+func GetSyncStatusPath(namespace, podName string) string {
+	return expandTilde(filepath.Join("~/.eli/sync", namespace, podName+".yml"))
+}
+
 func fileExists(path string) bool {
 	_, err := os.Stat(path)
 	return !os.IsNotExist(err)
@@ -435,6 +653,31 @@ func FindRunningContainerID(pod *pods.Pod, name string) (string, error) {
 	return "", fmt.Errorf("Cannot find ContainerID with name %s", name)
 }
 
+// Confirm writes the question and reads the answer, returns true only if the answer is yes
+func Confirm(in io.Reader, out io.Writer, question string) bool {
+	fmt.Fprintf(out, "%s [y/N]: ", question)
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseCopyPath splits 'eli cp' argument in format POD_NAME:PATH to pod name and path.
+// The pod name is empty if the argument is local path
+func ParseCopyPath(arg string) (podName, path string) {
+	i := strings.Index(arg, ":")
+	if i <= 0 || strings.ContainsAny(arg[:i], `/\`) {
+		return "", arg
+	}
+	return arg[:i], arg[i+1:]
+}
+
 // StopCatch will close the given channel when receives Stop signal (^C)
 func StopCatch(sigc chan os.Signal) {
 	signal.Stop(sigc)
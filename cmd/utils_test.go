@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"bytes"
 	"flag"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+	node "github.com/ernoaapa/eliot/pkg/api/services/node/v1"
 	"github.com/ernoaapa/eliot/pkg/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli"
@@ -83,6 +87,73 @@ func TestParseBindFlag(t *testing.T) {
 	assert.Equal(t, []string{"rshared", "rbind"}, result.Options)
 }
 
+func TestParsePortFlag(t *testing.T) {
+	result, err := ParsePortFlag("8080:80/udp")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(8080), result.HostPort)
+	assert.Equal(t, int32(80), result.ContainerPort)
+	assert.Equal(t, "udp", result.Protocol)
+
+	result, err = ParsePortFlag("80")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(0), result.HostPort)
+	assert.Equal(t, int32(80), result.ContainerPort)
+	assert.Equal(t, "", result.Protocol)
+
+	_, err = ParsePortFlag("foo:80")
+	assert.Error(t, err)
+
+	_, err = ParsePortFlag("1:2:3")
+	assert.Error(t, err)
+}
+
+func TestParseConfigData(t *testing.T) {
+	file, err := ioutil.TempFile("", "config-data")
+	assert.NoError(t, err)
+	defer os.Remove(file.Name())
+	file.WriteString("foo: bar")
+	file.Close()
+
+	data, err := ParseConfigData([]string{"TOKEN=abc=123"}, []string{file.Name(), "config.yml=" + file.Name()})
+	assert.NoError(t, err)
+	assert.Equal(t, "abc=123", string(data["TOKEN"]))
+	assert.Equal(t, "foo: bar", string(data[filepath.Base(file.Name())]))
+	assert.Equal(t, "foo: bar", string(data["config.yml"]))
+
+	_, err = ParseConfigData([]string{"TOKEN"}, nil)
+	assert.Error(t, err, "should return error if literal is not KEY=value")
+}
+
+func TestGetSyncVolumeName(t *testing.T) {
+	assert.Equal(t, "my-app-go-src-app", GetSyncVolumeName("my-app", "/go/src/app"))
+	assert.Equal(t, "my-app-data", GetSyncVolumeName("my-app", "/data/"))
+	assert.Equal(t, "my-app-my-data", GetSyncVolumeName("my-app", "/my_data"))
+}
+
+func TestGetSyncIgnore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "sync-ignore")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".eliotignore"), []byte("*.log\n!keep.txt\n"), 0644))
+
+	ignore, err := GetSyncIgnore(dir, []string{"node_modules", "*.txt"})
+	assert.NoError(t, err)
+
+	assert.True(t, ignore.Match(".git", true), "should ignore .git by default")
+	assert.True(t, ignore.Match(".eliot.yml", false), "should ignore .eliot.yml by default")
+	assert.True(t, ignore.Match("node_modules", true))
+	assert.True(t, ignore.Match("out.log", false))
+	assert.True(t, ignore.Match("other.txt", false))
+	assert.False(t, ignore.Match("keep.txt", false), ".eliotignore should override project config")
+	assert.False(t, ignore.Match("main.go", false))
+}
+
+func TestGetSyncStatusPath(t *testing.T) {
+	path := GetSyncStatusPath("eliot", "my-app")
+	assert.True(t, filepath.IsAbs(path), "should expand home directory")
+	assert.True(t, strings.HasSuffix(path, "/.eli/sync/eliot/my-app.yml"))
+}
+
 func TestGetCurrentDirectory(t *testing.T) {
 	assert.NotEmpty(t, GetCurrentDirectory())
 }
@@ -139,3 +210,71 @@ func TestGetConfigProviderWithEndpointFlag(t *testing.T) {
 		URL:  "1.2.3.4:5000",
 	}}, provider.GetEndpoints(), "")
 }
+
+func TestMapLabels(t *testing.T) {
+	result := MapLabels([]*node.Label{
+		{Key: "camera", Value: "true"},
+		{Key: "location", Value: "office"},
+	})
+	assert.Equal(t, map[string]string{"camera": "true", "location": "office"}, result)
+}
+
+func TestParseLabelArgs(t *testing.T) {
+	set, remove, err := ParseLabelArgs([]string{"location=barn", "eliot.io/camera=", "env-"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*node.Label{{Key: "location", Value: "barn"}, {Key: "eliot.io/camera", Value: ""}}, set)
+	assert.Equal(t, []string{"env"}, remove)
+
+	_, _, err = ParseLabelArgs([]string{"location"})
+	assert.Error(t, err, "should return error if no value or remove suffix")
+
+	_, _, err = ParseLabelArgs([]string{"=barn"})
+	assert.Error(t, err, "should return error if key is empty")
+}
+
+func TestConfirm(t *testing.T) {
+	var out bytes.Buffer
+	assert.True(t, Confirm(strings.NewReader("y\n"), &out, "Reboot?"))
+	assert.Equal(t, "Reboot? [y/N]: ", out.String())
+
+	assert.True(t, Confirm(strings.NewReader("Yes"), &out, "Reboot?"))
+	assert.False(t, Confirm(strings.NewReader("n\n"), &out, "Reboot?"))
+	assert.False(t, Confirm(strings.NewReader("\n"), &out, "Reboot?"), "should default to no")
+	assert.False(t, Confirm(strings.NewReader(""), &out, "Reboot?"))
+}
+
+func TestParseCopyPath(t *testing.T) {
+	for arg, expected := range map[string][2]string{
+		"my-pod:/etc/app.yml": {"my-pod", "/etc/app.yml"},
+		"my-pod:":             {"my-pod", ""},
+		"./app.yml":           {"", "./app.yml"},
+		"./dir:with/colon":    {"", "./dir:with/colon"},
+		":/etc":               {"", ":/etc"},
+	} {
+		podName, path := ParseCopyPath(arg)
+		assert.Equal(t, expected[0], podName, "pod name of %s", arg)
+		assert.Equal(t, expected[1], path, "path of %s", arg)
+	}
+}
+
+func TestParsePortForwardArg(t *testing.T) {
+	local, pod, err := ParsePortForwardArg("8080:80")
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, local)
+	assert.Equal(t, 80, pod)
+
+	local, pod, err = ParsePortForwardArg("9229")
+	assert.NoError(t, err)
+	assert.Equal(t, 9229, local, "should default to the pod port")
+	assert.Equal(t, 9229, pod)
+
+	local, pod, err = ParsePortForwardArg(":80")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, local, "should allow random local port")
+	assert.Equal(t, 80, pod)
+
+	for _, invalid := range []string{"", "foo", "8080:", "1:2:3", "80:70000", "-1:80"} {
+		_, _, err := ParsePortForwardArg(invalid)
+		assert.Error(t, err, "should fail to parse %q", invalid)
+	}
+}
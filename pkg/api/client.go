@@ -3,8 +3,7 @@ package api
 import (
 	"fmt"
 	"io"
-	"strconv"
-	"strings"
+	"net"
 	"syscall"
 
 	log "github.com/sirupsen/logrus"
@@ -13,12 +12,17 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/ernoaapa/eliot/pkg/api/mapping"
+	configs "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
 	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+	files "github.com/ernoaapa/eliot/pkg/api/services/files/v1"
 	node "github.com/ernoaapa/eliot/pkg/api/services/node/v1"
 	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
+	volumes "github.com/ernoaapa/eliot/pkg/api/services/volumes/v1"
 	"github.com/ernoaapa/eliot/pkg/api/stream"
 	"github.com/ernoaapa/eliot/pkg/config"
 	"github.com/ernoaapa/eliot/pkg/progress"
+	"github.com/ernoaapa/eliot/pkg/term"
+	"github.com/pkg/errors"
 	"github.com/rs/xid"
 )
 
@@ -55,8 +59,132 @@ func (c *Client) GetInfo() (*node.Info, error) {
 	return resp.GetInfo(), nil
 }
 
+// SetLabels adds or updates the node labels and returns all node labels after the change
+func (c *Client) SetLabels(labels []*node.Label) ([]*node.Label, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := node.NewNodeClient(conn)
+	resp, err := client.SetLabels(c.ctx, &node.SetLabelsRequest{
+		Labels: labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetLabels(), nil
+}
+
+// RemoveLabels removes the node labels by the keys and returns all node labels after the change
+func (c *Client) RemoveLabels(keys []string) ([]*node.Label, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := node.NewNodeClient(conn)
+	resp, err := client.RemoveLabels(c.ctx, &node.RemoveLabelsRequest{
+		Keys: keys,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetLabels(), nil
+}
+
+// Cordon marks the node unschedulable so it rejects new pods
+func (c *Client) Cordon() error {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := node.NewNodeClient(conn)
+	_, err = client.Cordon(c.ctx, &node.CordonRequest{})
+	return err
+}
+
+// Drain cordons the node and stops all pods gracefully and returns the stopped pods
+func (c *Client) Drain() ([]string, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := node.NewNodeClient(conn)
+	resp, err := client.Drain(c.ctx, &node.DrainRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetPods(), nil
+}
+
+// Uncordon restores the node normal operation and returns the restarted pods
+func (c *Client) Uncordon() ([]string, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := node.NewNodeClient(conn)
+	resp, err := client.Uncordon(c.ctx, &node.UncordonRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetPods(), nil
+}
+
+// Reboot stops all pods gracefully and reboots the node, returns the stopped pods
+func (c *Client) Reboot() ([]string, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := node.NewNodeClient(conn)
+	resp, err := client.Reboot(c.ctx, &node.RebootRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetPods(), nil
+}
+
+// Shutdown stops all pods gracefully and powers off the node, returns the stopped pods
+func (c *Client) Shutdown() ([]string, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := node.NewNodeClient(conn)
+	resp, err := client.Shutdown(c.ctx, &node.ShutdownRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetPods(), nil
+}
+
 // GetPods calls server and fetches all pods information
 func (c *Client) GetPods() ([]*pods.Pod, error) {
+	return c.GetPodsWithSelector("")
+}
+
+// GetPodsWithSelector return all pods matching to the label selector, e.g. app=sensor,env!=dev
+func (c *Client) GetPodsWithSelector(selector string) ([]*pods.Pod, error) {
 	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
 	if err != nil {
 		return nil, err
@@ -66,6 +194,7 @@ func (c *Client) GetPods() ([]*pods.Pod, error) {
 	client := pods.NewPodsClient(conn)
 	resp, err := client.List(c.ctx, &pods.ListPodsRequest{
 		Namespace: c.Namespace,
+		Selector:  selector,
 	})
 	if err != nil {
 		return nil, err
@@ -166,10 +295,297 @@ func (c *Client) DeletePod(pod *pods.Pod) (*pods.Pod, error) {
 	return resp.GetPod(), nil
 }
 
+// GetVolumes calls server and fetches all volumes information
+func (c *Client) GetVolumes() ([]*volumes.Volume, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := volumes.NewVolumesClient(conn)
+	resp, err := client.List(c.ctx, &volumes.ListVolumesRequest{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetVolumes(), nil
+}
+
+// GetVolume return Volume by name
+func (c *Client) GetVolume(name string) (*volumes.Volume, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := volumes.NewVolumesClient(conn)
+	resp, err := client.Get(c.ctx, &volumes.GetVolumeRequest{
+		Namespace: c.Namespace,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetVolume(), nil
+}
+
+// CreateVolume creates new named volume to the node
+func (c *Client) CreateVolume(volume *volumes.Volume) (*volumes.Volume, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := volumes.NewVolumesClient(conn)
+	resp, err := client.Create(c.ctx, &volumes.CreateVolumeRequest{
+		Volume: volume,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetVolume(), nil
+}
+
+// DeleteVolume removes volume and all of its data from the node
+func (c *Client) DeleteVolume(volume *volumes.Volume) (*volumes.Volume, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := volumes.NewVolumesClient(conn)
+	resp, err := client.Delete(c.ctx, &volumes.DeleteVolumeRequest{
+		Namespace: volume.Metadata.Namespace,
+		Name:      volume.Metadata.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetVolume(), nil
+}
+
+// ExportVolume streams volume data as tar to the writer
+func (c *Client) ExportVolume(name string, w io.Writer, gzip bool, paths []string) error {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := volumes.NewVolumesClient(conn)
+	s, err := client.Export(c.ctx, &volumes.ExportVolumeRequest{
+		Namespace: c.Namespace,
+		Name:      name,
+		Gzip:      gzip,
+		Paths:     paths,
+	})
+	if err != nil {
+		return err
+	}
+
+	reader := stream.NewChunkReader(func() ([]byte, error) {
+		resp, err := s.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	})
+	_, err = io.Copy(w, reader)
+	return err
+}
+
+// ImportVolume streams tar from the reader to the volume, creates the volume if it doesn't exist
+func (c *Client) ImportVolume(name string, r io.Reader) (*volumes.Volume, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := volumes.NewVolumesClient(conn)
+	s, err := client.Import(c.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.Send(&volumes.ImportVolumeRequest{Namespace: c.Namespace, Name: name}); err != nil {
+		return nil, err
+	}
+
+	writer := stream.NewChunkWriter(func(chunk []byte) error {
+		return s.Send(&volumes.ImportVolumeRequest{Data: chunk})
+	})
+	// Server closes the stream with io.EOF on failure, the actual error get returned by CloseAndRecv
+	if _, err := io.Copy(writer, r); err != nil && err != io.EOF {
+		s.CloseSend()
+		return nil, err
+	}
+
+	resp, err := s.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetVolume(), nil
+}
+
+// OpenVolumeSync opens sync stream to the volume, creates the volume if it doesn't exist.
+// The stream must be closed when the sync is done
+func (c *Client) OpenVolumeSync(name string) (*VolumeSync, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	s, err := volumes.NewVolumesClient(conn).Sync(ctx)
+	if err != nil {
+		cancel()
+		conn.Close()
+		return nil, err
+	}
+
+	if err := s.Send(&volumes.SyncVolumeRequest{Namespace: c.Namespace, Name: name}); err != nil {
+		cancel()
+		conn.Close()
+		return nil, err
+	}
+
+	return &VolumeSync{
+		stream: s,
+		close: func() {
+			s.CloseSend()
+			cancel()
+			conn.Close()
+		},
+	}, nil
+}
+
+// GetSecrets calls server and fetches all secrets, the values are always empty
+func (c *Client) GetSecrets() ([]*configs.Secret, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := configs.NewSecretsClient(conn)
+	resp, err := client.List(c.ctx, &configs.ListSecretsRequest{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetSecrets(), nil
+}
+
+// CreateSecret creates new secret to the node
+func (c *Client) CreateSecret(secret *configs.Secret) (*configs.Secret, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := configs.NewSecretsClient(conn)
+	resp, err := client.Create(c.ctx, &configs.CreateSecretRequest{
+		Secret: secret,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetSecret(), nil
+}
+
+// DeleteSecret removes secret from the node
+func (c *Client) DeleteSecret(name string) (*configs.Secret, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := configs.NewSecretsClient(conn)
+	resp, err := client.Delete(c.ctx, &configs.DeleteSecretRequest{
+		Namespace: c.Namespace,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSecret(), nil
+}
+
+// GetConfigMaps calls server and fetches all configmaps
+func (c *Client) GetConfigMaps() ([]*configs.ConfigMap, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := configs.NewConfigMapsClient(conn)
+	resp, err := client.List(c.ctx, &configs.ListConfigMapsRequest{
+		Namespace: c.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetConfigMaps(), nil
+}
+
+// CreateConfigMap creates new configmap to the node
+func (c *Client) CreateConfigMap(configMap *configs.ConfigMap) (*configs.ConfigMap, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := configs.NewConfigMapsClient(conn)
+	resp, err := client.Create(c.ctx, &configs.CreateConfigMapRequest{
+		ConfigMap: configMap,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetConfigMap(), nil
+}
+
+// DeleteConfigMap removes configmap from the node
+func (c *Client) DeleteConfigMap(name string) (*configs.ConfigMap, error) {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	client := configs.NewConfigMapsClient(conn)
+	resp, err := client.Delete(c.ctx, &configs.DeleteConfigMapRequest{
+		Namespace: c.Namespace,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetConfigMap(), nil
+}
+
 // Attach hooks to container main process stdin/stout
 func (c *Client) Attach(containerID string, attachIO AttachIO, hooks ...AttachHooks) (err error) {
 	done := make(chan struct{})
-	errc := make(chan error)
+	errc := make(chan error, 2)
 
 	md := metadata.Pairs(
 		"namespace", c.Namespace,
@@ -190,6 +606,7 @@ func (c *Client) Attach(containerID string, attachIO AttachIO, hooks ...AttachHo
 	if err != nil {
 		return err
 	}
+	sender := stream.NewSyncSender(s)
 
 	go func() {
 		errc <- stream.PipeStdout(s, attachIO.Stdout, attachIO.Stderr)
@@ -197,10 +614,14 @@ func (c *Client) Attach(containerID string, attachIO AttachIO, hooks ...AttachHo
 
 	if attachIO.Stdin != nil {
 		go func() {
-			errc <- stream.PipeStdin(s, attachIO.Stdin)
+			errc <- stream.PipeStdin(sender, attachIO.Stdin)
 		}()
 	}
 
+	if attachIO.Resize != nil {
+		go pipeResize(sender, attachIO.Resize)
+	}
+
 	for _, hook := range hooks {
 		go hook(c.Endpoint, done)
 	}
@@ -213,23 +634,17 @@ func (c *Client) Attach(containerID string, attachIO AttachIO, hooks ...AttachHo
 }
 
 // Exec executes command inside some container
-func (c *Client) Exec(containerID string, args []string, tty bool, attachIO AttachIO, hooks ...AttachHooks) (err error) {
+func (c *Client) Exec(containerID string, opts ExecOptions, attachIO AttachIO, hooks ...AttachHooks) (exitCode int, err error) {
 	done := make(chan struct{})
-	errc := make(chan error)
+	errc := make(chan error, 2)
+	exitc := make(chan int32, 1)
 
-	md := metadata.Pairs(
-		"namespace", c.Namespace,
-		"container", containerID,
-		"execid", xid.New().String(),
-		"args", strings.Join(args, " "),
-		"tty", strconv.FormatBool(tty),
-	)
-	ctx, cancel := context.WithCancel(metadata.NewOutgoingContext(c.ctx, md))
+	ctx, cancel := context.WithCancel(c.ctx)
 	defer cancel()
 
 	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
 	if err != nil {
-		return err
+		return 0, err
 	}
 	defer conn.Close()
 
@@ -237,27 +652,65 @@ func (c *Client) Exec(containerID string, args []string, tty bool, attachIO Atta
 	log.Debugf("Open connection to server to start stdin/stdout streaming")
 	s, err := client.Exec(ctx)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
+	err = s.Send(&containers.StdinStreamRequest{
+		Start: &containers.ExecStart{
+			Namespace:   c.Namespace,
+			ContainerID: containerID,
+			ExecID:      xid.New().String(),
+			Args:        opts.Args,
+			Env:         opts.Env,
+			User:        opts.User,
+			WorkingDir:  opts.WorkingDir,
+			Tty:         opts.Tty,
+		},
+	})
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to send Exec start message")
+	}
+	sender := stream.NewSyncSender(s)
+
 	go func() {
-		errc <- stream.PipeStdout(s, attachIO.Stdout, attachIO.Stderr)
+		code, err := stream.PipeExecStdout(s, attachIO.Stdout, attachIO.Stderr)
+		exitc <- code
+		errc <- err
 	}()
 
 	if attachIO.Stdin != nil {
 		go func() {
-			errc <- stream.PipeStdin(s, attachIO.Stdin)
+			if err := stream.PipeStdin(sender, attachIO.Stdin); err != nil {
+				errc <- err
+				return
+			}
+			// Let the process know that there's no more input
+			if err := sender.CloseSend(); err != nil {
+				errc <- err
+			}
 		}()
 	}
 
+	if opts.Tty && attachIO.Resize != nil {
+		go pipeResize(sender, attachIO.Resize)
+	}
+
 	for _, hook := range hooks {
 		go hook(c.Endpoint, done)
 	}
 
-	for {
-		err := <-errc
-		close(done)
-		return err
+	err = <-errc
+	close(done)
+	if err != nil {
+		return 0, err
+	}
+	return int(<-exitc), nil
+}
+
+// pipeResize sends the terminal size changes to the stream. Resizing is best effort so the errors don't stop the session
+func pipeResize(sender *stream.SyncSender, queue term.TerminalSizeQueue) {
+	if err := stream.PipeResize(sender, queue); err != nil {
+		log.Debugf("Stopped sending terminal size: %s", err)
 	}
 }
 
@@ -279,3 +732,132 @@ func (c *Client) Signal(containerID string, signal syscall.Signal) (err error) {
 
 	return err
 }
+
+// CopyFromContainer streams the file or directory from the container as tar to the writer.
+// The onSize get called with the approximate tar stream size before any data get written
+func (c *Client) CopyFromContainer(containerID, path string, w io.Writer, onSize func(size int64)) error {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := files.NewFilesClient(conn)
+	s, err := client.CopyFrom(c.ctx, &files.CopyFromRequest{
+		Namespace:   c.Namespace,
+		ContainerID: containerID,
+		Path:        path,
+	})
+	if err != nil {
+		return err
+	}
+
+	first, err := s.Recv()
+	if err != nil {
+		return err
+	}
+	if onSize != nil {
+		onSize(first.Size)
+	}
+
+	reader := stream.NewChunkReader(func() ([]byte, error) {
+		resp, err := s.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	})
+	_, err = io.Copy(w, reader)
+	return err
+}
+
+// CopyToContainer streams tar from the reader to the path in the container
+func (c *Client) CopyToContainer(containerID, path string, r io.Reader) error {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := files.NewFilesClient(conn)
+	s, err := client.CopyTo(c.ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Send(&files.CopyToRequest{Namespace: c.Namespace, ContainerID: containerID, Path: path}); err != nil {
+		return err
+	}
+
+	writer := stream.NewChunkWriter(func(chunk []byte) error {
+		return s.Send(&files.CopyToRequest{Data: chunk})
+	})
+	// Server closes the stream with io.EOF on failure, the actual error get returned by CloseAndRecv
+	if _, err := io.Copy(writer, r); err != nil && err != io.EOF {
+		s.CloseSend()
+		return err
+	}
+
+	_, err = s.CloseAndRecv()
+	return err
+}
+
+// PortForward forwards each connection accepted from the listener to the TCP port in the pod.
+// The connections get multiplexed as separate streams over single connection to the node.
+// Blocks until the listener fails to accept new connection, e.g. get closed
+func (c *Client) PortForward(podName string, port int, listener net.Listener, onError func(err error)) error {
+	conn, err := grpc.Dial(c.Endpoint.URL, grpc.WithInsecure())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := pods.NewPodsClient(conn)
+	for {
+		local, err := listener.Accept()
+		if err != nil {
+			return err
+		}
+
+		go func() {
+			defer local.Close()
+			if err := c.forwardConnection(client, podName, port, local); err != nil && onError != nil {
+				onError(err)
+			}
+		}()
+	}
+}
+
+func (c *Client) forwardConnection(client pods.PodsClient, podName string, port int, local net.Conn) error {
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+
+	s, err := client.PortForward(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Send(&pods.PortForwardRequest{Namespace: c.Namespace, PodName: podName, Port: int32(port)}); err != nil {
+		return err
+	}
+
+	go func() {
+		writer := stream.NewChunkWriter(func(chunk []byte) error {
+			return s.Send(&pods.PortForwardRequest{Data: chunk})
+		})
+		if _, err := io.Copy(writer, local); err != nil {
+			log.Debugf("Stopped forwarding local connection: %s", err)
+		}
+		s.CloseSend()
+	}()
+
+	reader := stream.NewChunkReader(func() ([]byte, error) {
+		resp, err := s.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return resp.Data, nil
+	})
+	_, err = io.Copy(local, reader)
+	return err
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"fmt"
+
+	"golang.org/x/net/context"
+
+	"github.com/ernoaapa/eliot/pkg/api/mapping"
+	configsapi "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
+	"github.com/ernoaapa/eliot/pkg/configs"
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SecretsServer implements the 'secrets' GRPC service
+type SecretsServer struct {
+	store *configs.Store
+}
+
+// Create is 'secrets' service Create implementation
+func (s *SecretsServer) Create(context context.Context, req *configsapi.CreateSecretRequest) (*configsapi.CreateSecretResponse, error) {
+	if req.Secret == nil || req.Secret.Metadata == nil {
+		return nil, fmt.Errorf("You must define secret metadata")
+	}
+	secret := mapping.MapSecretToInternalModel(req.Secret)
+
+	if err := model.ValidateSecret(secret); err != nil {
+		return nil, errors.Wrapf(err, "Invalid secret [%s]", secret.Metadata.Name)
+	}
+
+	if err := s.store.CreateSecret(secret); err != nil {
+		return nil, errors.Wrapf(err, "Failed to create secret [%s]", secret.Metadata.Name)
+	}
+
+	return &configsapi.CreateSecretResponse{
+		Secret: mapping.MapSecretToAPIModel(secret),
+	}, nil
+}
+
+// List is 'secrets' service List implementation, the secret values are never returned
+func (s *SecretsServer) List(context context.Context, req *configsapi.ListSecretsRequest) (*configsapi.ListSecretsResponse, error) {
+	if err := validateConfigRef(req.Namespace, ""); err != nil {
+		return nil, err
+	}
+	secrets, err := s.store.ListSecrets(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &configsapi.ListSecretsResponse{
+		Secrets: mapping.MapSecretsToAPIModel(secrets),
+	}, nil
+}
+
+// Delete is 'secrets' service Delete implementation
+func (s *SecretsServer) Delete(context context.Context, req *configsapi.DeleteSecretRequest) (*configsapi.DeleteSecretResponse, error) {
+	if err := validateConfigRef(req.Namespace, req.Name); err != nil {
+		return nil, err
+	}
+	deleted, err := s.store.DeleteSecret(req.Namespace, req.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to delete secret [%s]", req.Name)
+	}
+	return &configsapi.DeleteSecretResponse{
+		Secret: mapping.MapSecretToAPIModel(deleted),
+	}, nil
+}
+
+// ConfigMapsServer implements the 'configmaps' GRPC service
+type ConfigMapsServer struct {
+	store *configs.Store
+}
+
+// Create is 'configmaps' service Create implementation
+func (s *ConfigMapsServer) Create(context context.Context, req *configsapi.CreateConfigMapRequest) (*configsapi.CreateConfigMapResponse, error) {
+	if req.ConfigMap == nil || req.ConfigMap.Metadata == nil {
+		return nil, fmt.Errorf("You must define configmap metadata")
+	}
+	configMap := mapping.MapConfigMapToInternalModel(req.ConfigMap)
+
+	if err := model.ValidateConfigMap(configMap); err != nil {
+		return nil, errors.Wrapf(err, "Invalid configmap [%s]", configMap.Metadata.Name)
+	}
+
+	if err := s.store.CreateConfigMap(configMap); err != nil {
+		return nil, errors.Wrapf(err, "Failed to create configmap [%s]", configMap.Metadata.Name)
+	}
+
+	return &configsapi.CreateConfigMapResponse{
+		ConfigMap: mapping.MapConfigMapToAPIModel(configMap),
+	}, nil
+}
+
+// List is 'configmaps' service List implementation
+func (s *ConfigMapsServer) List(context context.Context, req *configsapi.ListConfigMapsRequest) (*configsapi.ListConfigMapsResponse, error) {
+	if err := validateConfigRef(req.Namespace, ""); err != nil {
+		return nil, err
+	}
+	configMaps, err := s.store.ListConfigMaps(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	return &configsapi.ListConfigMapsResponse{
+		ConfigMaps: mapping.MapConfigMapsToAPIModel(configMaps),
+	}, nil
+}
+
+// Delete is 'configmaps' service Delete implementation
+func (s *ConfigMapsServer) Delete(context context.Context, req *configsapi.DeleteConfigMapRequest) (*configsapi.DeleteConfigMapResponse, error) {
+	if err := validateConfigRef(req.Namespace, req.Name); err != nil {
+		return nil, err
+	}
+	deleted, err := s.store.DeleteConfigMap(req.Namespace, req.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to delete configmap [%s]", req.Name)
+	}
+	return &configsapi.DeleteConfigMapResponse{
+		ConfigMap: mapping.MapConfigMapToAPIModel(deleted),
+	}, nil
+}
+
+// validateConfigRef validates the Secret or ConfigMap namespace and name, empty name validates only the namespace
+func validateConfigRef(namespace, name string) error {
+	if err := model.ValidateNamespace(namespace); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid namespace [%s]: %s", namespace, err)
+	}
+	if name == "" {
+		return nil
+	}
+	if err := model.ValidateConfigName(name); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid name [%s]: %s", name, err)
+	}
+	return nil
+}
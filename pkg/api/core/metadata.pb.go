@@ -37,6 +37,10 @@ type ResourceMetadata struct {
 	// An empty namespace is equivalent to the default namespace.
 	// Cannot be updated.
 	Namespace string `protobuf:"bytes,2,opt,name=namespace" json:"namespace,omitempty"`
+	// Labels are key value pairs what can be used to group and select resources
+	Labels map[string]string `protobuf:"bytes,3,rep,name=labels" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Annotations are key value pairs to store arbitrary non-identifying metadata
+	Annotations map[string]string `protobuf:"bytes,4,rep,name=annotations" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 func (m *ResourceMetadata) Reset()                    { *m = ResourceMetadata{} }
@@ -58,22 +62,42 @@ func (m *ResourceMetadata) GetNamespace() string {
 	return ""
 }
 
+func (m *ResourceMetadata) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ResourceMetadata) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
 func init() {
-	proto.RegisterType((*ResourceMetadata)(nil), "cand.core.ResourceMetadata")
+	proto.RegisterType((*ResourceMetadata)(nil), "eliot.core.ResourceMetadata")
 }
 
 func init() { proto.RegisterFile("core/metadata.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 148 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4e, 0xce, 0x2f, 0x4a,
-	0xd5, 0xcf, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0xe2, 0x4c, 0x4e, 0xcc, 0x4b, 0xd1, 0x03, 0xc9, 0x28, 0xb9, 0x70, 0x09, 0x04, 0xa5, 0x16, 0xe7,
-	0x97, 0x16, 0x25, 0xa7, 0xfa, 0x42, 0x15, 0x09, 0x09, 0x71, 0xb1, 0xe4, 0x25, 0xe6, 0xa6, 0x4a,
-	0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x81, 0xd9, 0x42, 0x32, 0x5c, 0x9c, 0x20, 0xba, 0xb8, 0x20,
-	0x31, 0x39, 0x55, 0x82, 0x09, 0x2c, 0x81, 0x10, 0x70, 0xd2, 0x8e, 0xd2, 0x4c, 0xcf, 0x2c, 0xc9,
-	0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0x2d, 0xca, 0xcb, 0x4f, 0x4c, 0x2c, 0x48, 0xd4,
-	0x4f, 0x4e, 0xcc, 0xd3, 0x2f, 0xc8, 0x4e, 0xd7, 0x4f, 0x2c, 0xc8, 0xd4, 0x07, 0xd9, 0x66, 0x0d,
-	0x22, 0x92, 0xd8, 0xc0, 0x8e, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x60, 0x2e, 0x75, 0xb6,
-	0x9b, 0x00, 0x00, 0x00,
+	// 254 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x4f, 0x4b, 0xf4, 0x30,
+	0x10, 0xc6, 0x69, 0xbb, 0xef, 0xc2, 0x4e, 0x2f, 0x25, 0xaf, 0x87, 0xb2, 0x78, 0x58, 0x3c, 0x15,
+	0x64, 0x13, 0xd0, 0x8b, 0x7f, 0x40, 0x54, 0xf0, 0xa6, 0x08, 0x3d, 0x7a, 0x9b, 0xd6, 0x61, 0x2d,
+	0xdb, 0x66, 0x42, 0x9a, 0x0a, 0xfb, 0x35, 0xfd, 0x44, 0x92, 0x6c, 0xa5, 0xcb, 0x1e, 0x04, 0x2f,
+	0xc9, 0x24, 0xf3, 0xcc, 0xef, 0xe1, 0x61, 0xe0, 0x7f, 0xcd, 0x96, 0x54, 0x47, 0x0e, 0xdf, 0xd1,
+	0xa1, 0x34, 0x96, 0x1d, 0x0b, 0xa0, 0xb6, 0x61, 0x27, 0x7d, 0xeb, 0xec, 0x2b, 0x86, 0xac, 0xa4,
+	0x9e, 0x07, 0x5b, 0xd3, 0xcb, 0x28, 0x13, 0x02, 0x66, 0x1a, 0x3b, 0xca, 0xa3, 0x55, 0x54, 0x2c,
+	0xca, 0x50, 0x8b, 0x53, 0x58, 0xf8, 0xbb, 0x37, 0x58, 0x53, 0x1e, 0x87, 0xc6, 0xf4, 0x21, 0xee,
+	0x61, 0xde, 0x62, 0x45, 0x6d, 0x9f, 0x27, 0xab, 0xa4, 0x48, 0x2f, 0x0a, 0x39, 0x79, 0xc8, 0x63,
+	0xbe, 0x7c, 0x0e, 0xd2, 0x27, 0xed, 0xec, 0xae, 0x1c, 0xe7, 0xc4, 0x2b, 0xa4, 0xa8, 0x35, 0x3b,
+	0x74, 0x0d, 0xeb, 0x3e, 0x9f, 0x05, 0xcc, 0xfa, 0x57, 0xcc, 0xc3, 0xa4, 0xdf, 0xb3, 0x0e, 0x09,
+	0xcb, 0x6b, 0x48, 0x0f, 0x7c, 0x44, 0x06, 0xc9, 0x96, 0x76, 0x63, 0x24, 0x5f, 0x8a, 0x13, 0xf8,
+	0xf7, 0x89, 0xed, 0xf0, 0x93, 0x66, 0xff, 0xb8, 0x89, 0xaf, 0xa2, 0xe5, 0x1d, 0x64, 0xc7, 0xec,
+	0xbf, 0xcc, 0x3f, 0xae, 0xdf, 0xce, 0x37, 0x8d, 0xfb, 0x18, 0x2a, 0x59, 0x73, 0xa7, 0xc8, 0x6a,
+	0x46, 0x34, 0xa8, 0x42, 0x16, 0x65, 0xb6, 0x1b, 0x85, 0xa6, 0x51, 0x3e, 0xd3, 0xad, 0x3f, 0xaa,
+	0x79, 0x58, 0xcb, 0xe5, 0xf7, 0x00, 0x6b, 0xcd, 0x34, 0x61, 0xad, 0x01, 0x00, 0x00,
 }
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"bufio"
+
+	files "github.com/ernoaapa/eliot/pkg/api/services/files/v1"
+	"github.com/ernoaapa/eliot/pkg/api/stream"
+	"github.com/ernoaapa/eliot/pkg/chrootarchive"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FilesServer implements the 'files' GRPC service
+type FilesServer struct {
+	client runtime.Client
+}
+
+// CopyFrom is 'files' service CopyFrom implementation, streams the file or directory from the container as tar
+func (s *FilesServer) CopyFrom(req *files.CopyFromRequest, server files.Files_CopyFromServer) error {
+	root, err := s.getRoot(req.Namespace, req.ContainerID, req.Path)
+	if err != nil {
+		return err
+	}
+
+	size, err := chrootarchive.Size(root, req.Path)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "Cannot copy [%s] from container [%s]: %s", req.Path, req.ContainerID, err)
+	}
+	if err := server.Send(&files.FileData{Size: size}); err != nil {
+		return err
+	}
+
+	log.Debugf("Copy [%s] from container [%s] in namespace [%s]", req.Path, req.ContainerID, req.Namespace)
+	writer := bufio.NewWriterSize(stream.NewChunkWriter(func(chunk []byte) error {
+		return server.Send(&files.FileData{Data: chunk})
+	}), chunkSize)
+
+	if err := chrootarchive.WritePath(root, req.Path, writer); err != nil {
+		return errors.Wrapf(err, "Failed to copy [%s] from container [%s]", req.Path, req.ContainerID)
+	}
+	return writer.Flush()
+}
+
+// CopyTo is 'files' service CopyTo implementation, extracts received tar stream to the container
+func (s *FilesServer) CopyTo(server files.Files_CopyToServer) error {
+	first, err := server.Recv()
+	if err != nil {
+		return errors.Wrapf(err, "Failed to receive copy request")
+	}
+
+	root, err := s.getRoot(first.Namespace, first.ContainerID, first.Path)
+	if err != nil {
+		return err
+	}
+
+	pending := first.Data
+	reader := stream.NewChunkReader(func() ([]byte, error) {
+		if pending != nil {
+			chunk := pending
+			pending = nil
+			return chunk, nil
+		}
+		req, err := server.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+
+	log.Debugf("Copy files to [%s] in container [%s] in namespace [%s]", first.Path, first.ContainerID, first.Namespace)
+	if err := chrootarchive.ExtractToPath(root, first.Path, reader); err != nil {
+		return errors.Wrapf(err, "Failed to copy files to [%s] in container [%s]", first.Path, first.ContainerID)
+	}
+
+	return server.SendAndClose(&files.CopyToResponse{})
+}
+
+// getRoot returns the running container root filesystem path in the host.
+// The files must be accessed only through chrootarchive, so the container cannot redirect the access outside
+// of the root by changing the paths to symlinks while copying
+func (s *FilesServer) getRoot(namespace, containerID, path string) (string, error) {
+	if namespace == "" {
+		return "", status.Errorf(codes.InvalidArgument, "You must define 'namespace'")
+	}
+
+	if containerID == "" {
+		return "", status.Errorf(codes.InvalidArgument, "You must define 'containerID'")
+	}
+
+	if path == "" {
+		return "", status.Errorf(codes.InvalidArgument, "You must define 'path'")
+	}
+
+	root, err := s.client.GetContainerRoot(namespace, containerID)
+	if err != nil {
+		if runtime.IsNotFound(err) {
+			return "", status.Errorf(codes.FailedPrecondition, "Cannot copy files: %s", err)
+		}
+		return "", err
+	}
+
+	return root, nil
+}
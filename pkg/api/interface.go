@@ -5,6 +5,7 @@ import (
 
 	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
 	"github.com/ernoaapa/eliot/pkg/config"
+	"github.com/ernoaapa/eliot/pkg/term"
 )
 
 // PodOpts adds more information to the Pod going to be created
@@ -13,14 +14,43 @@ type PodOpts func(pod *pods.Pod) error
 // AttachHooks is additional process what runs when is attached to container
 type AttachHooks func(endpoint config.Endpoint, done <-chan struct{})
 
+// ExecOptions defines the process to execute in the container
+type ExecOptions struct {
+	Args []string
+	// Additional environment variables in format KEY=value
+	Env []string
+	// User name or UID and optionally group, e.g. nobody or 1000:1000. Defaults to the container user
+	User string
+	// Defaults to the container working directory
+	WorkingDir string
+	Tty        bool
+}
+
 // AttachIO wraps stdin/stdout for attach
 type AttachIO struct {
 	Stdin  io.Reader
 	Stdout io.Writer
 	Stderr io.Writer
+	// Resize provides the local terminal size changes what get sent to the container, optional
+	Resize term.TerminalSizeQueue
 }
 
 // NewAttachIO is wrapper for stdin, stdout and stderr
 func NewAttachIO(stdin io.Reader, stdout, stderr io.Writer) AttachIO {
-	return AttachIO{stdin, stdout, stderr}
+	return AttachIO{Stdin: stdin, Stdout: stdout, Stderr: stderr}
+}
+
+// NewTTYAttachIO is wrapper for stdin, stdout and stderr what also sends the terminal size changes
+// and detaches when the TTY detach keys get typed
+func NewTTYAttachIO(tty *term.TTY, stderr io.Writer) (AttachIO, error) {
+	stdin, err := tty.InputReader()
+	if err != nil {
+		return AttachIO{}, err
+	}
+	return AttachIO{
+		Stdin:  stdin,
+		Stdout: tty.Out,
+		Stderr: stderr,
+		Resize: tty.MonitorSize(tty.GetSize()),
+	}, nil
 }
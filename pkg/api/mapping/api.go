@@ -1,7 +1,9 @@
 package mapping
 
 import (
+	configs "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
 	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+	node "github.com/ernoaapa/eliot/pkg/api/services/node/v1"
 	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
 	"github.com/ernoaapa/eliot/pkg/model"
 )
@@ -18,34 +20,103 @@ func MapPodsToInternalModel(pods []*pods.Pod) (result []model.Pod) {
 func MapPodToInternalModel(pod *pods.Pod) model.Pod {
 	return model.Pod{
 		Metadata: model.Metadata{
-			Name:      pod.Metadata.Name,
-			Namespace: pod.Metadata.Namespace,
+			Name:        pod.Metadata.Name,
+			Namespace:   pod.Metadata.Namespace,
+			Labels:      pod.Metadata.Labels,
+			Annotations: pod.Metadata.Annotations,
 		},
 		Spec: model.PodSpec{
-			Containers:  MapContainerToInternalModel(pod.Spec.Containers),
-			HostNetwork: pod.Spec.HostNetwork,
-			HostPID:     pod.Spec.HostPID,
+			Containers:                    MapContainerToInternalModel(pod.Spec.Containers),
+			HostNetwork:                   pod.Spec.HostNetwork,
+			HostPID:                       pod.Spec.HostPID,
+			ShareProcessNamespace:         pod.Spec.ShareProcessNamespace,
+			Hostname:                      pod.Spec.Hostname,
+			Volumes:                       mapPodVolumesToInternalModel(pod.Spec.Volumes),
+			NodeSelector:                  pod.Spec.NodeSelector,
+			TerminationGracePeriodSeconds: pod.Spec.TerminationGracePeriodSeconds,
 		},
 	}
 }
 
+func mapPodVolumesToInternalModel(volumes []*pods.PodVolume) (result []model.PodVolume) {
+	for _, volume := range volumes {
+		result = append(result, model.PodVolume{
+			Name: volume.Name,
+			Size: volume.Size,
+		})
+	}
+	return result
+}
+
 // MapContainerToInternalModel maps API Container model to internal model
 func MapContainerToInternalModel(containers []*containers.Container) (result []model.Container) {
 	for _, container := range containers {
 		result = append(result, model.Container{
-			Name:       container.Name,
-			Image:      container.Image,
-			Tty:        container.Tty,
-			Args:       container.Args,
-			Env:        container.Env,
-			WorkingDir: container.WorkingDir,
-			Mounts:     mapMountsToInternalModel(container.Mounts),
-			Pipe:       mapPipeToInternalModel(container.Pipe),
+			Name:            container.Name,
+			Image:           container.Image,
+			Tty:             container.Tty,
+			Args:            container.Args,
+			Env:             container.Env,
+			EnvVars:         mapEnvVarsToInternalModel(container.EnvVars),
+			WorkingDir:      container.WorkingDir,
+			Mounts:          mapMountsToInternalModel(container.Mounts),
+			Devices:         mapDevicesToInternalModel(container.Devices),
+			Resources:       mapResourcesToInternalModel(container.Resources),
+			SecurityContext: mapSecurityContextToInternalModel(container.SecurityContext),
+			Ports:           mapPortsToInternalModel(container.Ports),
+			Pipe:            mapPipeToInternalModel(container.Pipe),
 		})
 	}
 	return result
 }
 
+func mapEnvVarsToInternalModel(envVars []*containers.EnvVar) (result []model.EnvVar) {
+	for _, envVar := range envVars {
+		target := model.EnvVar{Name: envVar.Name}
+		if envVar.ValueFrom != nil {
+			target.ValueFrom = model.EnvVarSource{
+				SecretKeyRef:    mapKeySelectorToInternalModel(envVar.ValueFrom.SecretKeyRef),
+				ConfigMapKeyRef: mapKeySelectorToInternalModel(envVar.ValueFrom.ConfigMapKeyRef),
+				FieldRef:        envVar.ValueFrom.FieldRef,
+			}
+		}
+		result = append(result, target)
+	}
+	return result
+}
+
+func mapKeySelectorToInternalModel(selector *containers.KeySelector) *model.KeySelector {
+	if selector == nil {
+		return nil
+	}
+	return &model.KeySelector{
+		Name: selector.Name,
+		Key:  selector.Key,
+	}
+}
+
+// MapSecretToInternalModel maps API Secret model to internal model
+func MapSecretToInternalModel(secret *configs.Secret) model.Secret {
+	return model.Secret{
+		Metadata: model.Metadata{
+			Name:      secret.Metadata.Name,
+			Namespace: secret.Metadata.Namespace,
+		},
+		Data: secret.Data,
+	}
+}
+
+// MapConfigMapToInternalModel maps API ConfigMap model to internal model
+func MapConfigMapToInternalModel(configMap *configs.ConfigMap) model.ConfigMap {
+	return model.ConfigMap{
+		Metadata: model.Metadata{
+			Name:      configMap.Metadata.Name,
+			Namespace: configMap.Metadata.Namespace,
+		},
+		Data: configMap.Data,
+	}
+}
+
 func mapPipeToInternalModel(pipe *containers.PipeSet) *model.PipeSet {
 	if pipe == nil {
 		return nil
@@ -71,3 +142,66 @@ func mapMountsToInternalModel(mounts []*containers.Mount) (result []model.Mount)
 	}
 	return result
 }
+
+func mapDevicesToInternalModel(devices []*containers.Device) (result []model.Device) {
+	for _, device := range devices {
+		result = append(result, model.Device{
+			HostPath:      device.HostPath,
+			ContainerPath: device.ContainerPath,
+			Permissions:   device.Permissions,
+		})
+	}
+	return result
+}
+
+func mapResourcesToInternalModel(resources map[string]int32) map[string]int {
+	if len(resources) == 0 {
+		return nil
+	}
+	result := map[string]int{}
+	for name, count := range resources {
+		result[name] = int(count)
+	}
+	return result
+}
+
+func mapPortsToInternalModel(ports []*containers.ContainerPort) (result []model.ContainerPort) {
+	for _, port := range ports {
+		result = append(result, model.ContainerPort{
+			ContainerPort: int(port.ContainerPort),
+			HostPort:      int(port.HostPort),
+			Protocol:      port.Protocol,
+		})
+	}
+	return result
+}
+
+func mapSecurityContextToInternalModel(securityContext *containers.SecurityContext) *model.SecurityContext {
+	if securityContext == nil {
+		return nil
+	}
+	result := &model.SecurityContext{
+		User:                   securityContext.User,
+		Group:                  securityContext.Group,
+		Privileged:             securityContext.Privileged,
+		ReadOnlyRootFilesystem: securityContext.ReadOnlyRootFilesystem,
+		NoNewPrivileges:        securityContext.NoNewPrivileges,
+		SeccompProfile:         securityContext.SeccompProfile,
+	}
+	if securityContext.Capabilities != nil {
+		result.Capabilities = &model.Capabilities{
+			Add:  securityContext.Capabilities.Add,
+			Drop: securityContext.Capabilities.Drop,
+		}
+	}
+	return result
+}
+
+// MapLabelsToInternalModel maps API node labels to map
+func MapLabelsToInternalModel(labels []*node.Label) map[string]string {
+	result := map[string]string{}
+	for _, label := range labels {
+		result[label.Key] = label.Value
+	}
+	return result
+}
@@ -2,33 +2,39 @@ package mapping
 
 import (
 	"net"
+	"time"
 
 	core "github.com/ernoaapa/eliot/pkg/api/core"
+	configs "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
 	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
 	node "github.com/ernoaapa/eliot/pkg/api/services/node/v1"
 	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
+	volumes "github.com/ernoaapa/eliot/pkg/api/services/volumes/v1"
 	"github.com/ernoaapa/eliot/pkg/model"
 )
 
 // MapInfoToAPIModel maps internal node info model to API model
 func MapInfoToAPIModel(info *model.NodeInfo) *node.Info {
 	return &node.Info{
-		Uptime:      info.Uptime,
-		Labels:      mapLabelsToAPIModel(info.Labels),
-		Hostname:    info.Hostname,
-		Addresses:   addressesToString(info.Addresses),
-		GrpcPort:    int64(info.GrpcPort),
-		MachineID:   info.MachineID,
-		SystemUUID:  info.SystemUUID,
-		BootID:      info.BootID,
-		Arch:        info.Arch,
-		Os:          info.OS,
-		Version:     info.Version,
-		Filesystems: mapFilesystemsToAPIModel(info.Filesystems),
-	}
-}
-
-func mapLabelsToAPIModel(labels map[string]string) (result []*node.Label) {
+		Uptime:        info.Uptime,
+		Labels:        MapLabelsToAPIModel(info.Labels),
+		Hostname:      info.Hostname,
+		Addresses:     addressesToString(info.Addresses),
+		GrpcPort:      int64(info.GrpcPort),
+		MachineID:     info.MachineID,
+		SystemUUID:    info.SystemUUID,
+		BootID:        info.BootID,
+		Arch:          info.Arch,
+		Os:            info.OS,
+		Version:       info.Version,
+		Filesystems:   mapFilesystemsToAPIModel(info.Filesystems),
+		Devices:       mapDeviceResourcesToAPIModel(info.Devices),
+		Unschedulable: info.Unschedulable,
+	}
+}
+
+// MapLabelsToAPIModel maps labels to API node labels
+func MapLabelsToAPIModel(labels map[string]string) (result []*node.Label) {
 	for key, value := range labels {
 		result = append(result, &node.Label{Key: key, Value: value})
 	}
@@ -54,38 +60,155 @@ func MapPodsToAPIModel(pods []model.Pod) (result []*pods.Pod) {
 func MapPodToAPIModel(pod model.Pod) *pods.Pod {
 	return &pods.Pod{
 		Metadata: &core.ResourceMetadata{
-			Name:      pod.Metadata.Name,
-			Namespace: pod.Metadata.Namespace,
+			Name:        pod.Metadata.Name,
+			Namespace:   pod.Metadata.Namespace,
+			Labels:      pod.Metadata.Labels,
+			Annotations: pod.Metadata.Annotations,
 		},
 		Spec: &pods.PodSpec{
-			Containers:    MapContainersToAPIModel(pod.Spec.Containers),
-			HostNetwork:   pod.Spec.HostNetwork,
-			HostPID:       pod.Spec.HostPID,
-			RestartPolicy: pod.Spec.RestartPolicy,
+			Containers:                    MapContainersToAPIModel(pod.Spec.Containers),
+			HostNetwork:                   pod.Spec.HostNetwork,
+			HostPID:                       pod.Spec.HostPID,
+			ShareProcessNamespace:         pod.Spec.ShareProcessNamespace,
+			Hostname:                      pod.Spec.Hostname,
+			RestartPolicy:                 pod.Spec.RestartPolicy,
+			Volumes:                       mapPodVolumesToAPIModel(pod.Spec.Volumes),
+			NodeSelector:                  pod.Spec.NodeSelector,
+			TerminationGracePeriodSeconds: pod.Spec.TerminationGracePeriodSeconds,
 		},
 		Status: &pods.PodStatus{
 			Hostname:          pod.Status.Hostname,
+			PodIP:             pod.Status.PodIP,
 			ContainerStatuses: MapContainerStatusesToAPIModel(pod.Status.ContainerStatuses),
 		},
 	}
 }
 
+func mapPodVolumesToAPIModel(source []model.PodVolume) (result []*pods.PodVolume) {
+	for _, volume := range source {
+		result = append(result, &pods.PodVolume{
+			Name: volume.Name,
+			Size: volume.Size,
+		})
+	}
+	return result
+}
+
+// MapVolumesToAPIModel maps list of internal volume models to API model
+func MapVolumesToAPIModel(source []model.Volume) (result []*volumes.Volume) {
+	for _, volume := range source {
+		result = append(result, MapVolumeToAPIModel(volume))
+	}
+	return result
+}
+
+// MapVolumeToAPIModel maps internal Volume model to API model
+func MapVolumeToAPIModel(volume model.Volume) *volumes.Volume {
+	return &volumes.Volume{
+		Metadata: &core.ResourceMetadata{
+			Name:      volume.Metadata.Name,
+			Namespace: volume.Metadata.Namespace,
+		},
+		Spec: &volumes.VolumeSpec{
+			Size: volume.Spec.Size,
+		},
+		Status: &volumes.VolumeStatus{
+			CreatedAt: timeToUnix(volume.Status.CreatedAt),
+			Path:      volume.Status.Path,
+			Usage:     volume.Status.Usage,
+			UsedBy:    volume.Status.UsedBy,
+		},
+	}
+}
+
 // MapContainersToAPIModel maps list of internal Container models to API model
 func MapContainersToAPIModel(source []model.Container) (result []*containers.Container) {
 	for _, container := range source {
 		result = append(result, &containers.Container{
-			Name:       container.Name,
-			Image:      container.Image,
-			WorkingDir: container.WorkingDir,
-			Args:       container.Args,
-			Env:        container.Env,
-			Mounts:     mapMountsToAPIModel(container.Mounts),
-			Pipe:       mapPipeToAPIModel(container.Pipe),
+			Name:            container.Name,
+			Image:           container.Image,
+			WorkingDir:      container.WorkingDir,
+			Args:            container.Args,
+			Env:             container.Env,
+			EnvVars:         mapEnvVarsToAPIModel(container.EnvVars),
+			Mounts:          mapMountsToAPIModel(container.Mounts),
+			Devices:         mapDevicesToAPIModel(container.Devices),
+			Resources:       mapResourcesToAPIModel(container.Resources),
+			SecurityContext: mapSecurityContextToAPIModel(container.SecurityContext),
+			Ports:           mapPortsToAPIModel(container.Ports),
+			Pipe:            mapPipeToAPIModel(container.Pipe),
 		})
 	}
 	return result
 }
 
+func mapEnvVarsToAPIModel(envVars []model.EnvVar) (result []*containers.EnvVar) {
+	for _, envVar := range envVars {
+		result = append(result, &containers.EnvVar{
+			Name: envVar.Name,
+			ValueFrom: &containers.EnvVarSource{
+				SecretKeyRef:    mapKeySelectorToAPIModel(envVar.ValueFrom.SecretKeyRef),
+				ConfigMapKeyRef: mapKeySelectorToAPIModel(envVar.ValueFrom.ConfigMapKeyRef),
+				FieldRef:        envVar.ValueFrom.FieldRef,
+			},
+		})
+	}
+	return result
+}
+
+func mapKeySelectorToAPIModel(selector *model.KeySelector) *containers.KeySelector {
+	if selector == nil {
+		return nil
+	}
+	return &containers.KeySelector{
+		Name: selector.Name,
+		Key:  selector.Key,
+	}
+}
+
+// MapSecretsToAPIModel maps list of internal Secret models to API model with redacted values
+func MapSecretsToAPIModel(secrets []model.Secret) (result []*configs.Secret) {
+	for _, secret := range secrets {
+		result = append(result, MapSecretToAPIModel(secret))
+	}
+	return result
+}
+
+// MapSecretToAPIModel maps internal Secret model to API model.
+// Only the keys get mapped, the values are always left empty
+func MapSecretToAPIModel(secret model.Secret) *configs.Secret {
+	data := map[string][]byte{}
+	for key := range secret.Data {
+		data[key] = []byte{}
+	}
+	return &configs.Secret{
+		Metadata: &core.ResourceMetadata{
+			Name:      secret.Metadata.Name,
+			Namespace: secret.Metadata.Namespace,
+		},
+		Data: data,
+	}
+}
+
+// MapConfigMapsToAPIModel maps list of internal ConfigMap models to API model
+func MapConfigMapsToAPIModel(configMaps []model.ConfigMap) (result []*configs.ConfigMap) {
+	for _, configMap := range configMaps {
+		result = append(result, MapConfigMapToAPIModel(configMap))
+	}
+	return result
+}
+
+// MapConfigMapToAPIModel maps internal ConfigMap model to API model
+func MapConfigMapToAPIModel(configMap model.ConfigMap) *configs.ConfigMap {
+	return &configs.ConfigMap{
+		Metadata: &core.ResourceMetadata{
+			Name:      configMap.Metadata.Name,
+			Namespace: configMap.Metadata.Namespace,
+		},
+		Data: configMap.Data,
+	}
+}
+
 func mapMountsToAPIModel(mounts []model.Mount) (result []*containers.Mount) {
 	for _, mount := range mounts {
 		result = append(result, &containers.Mount{
@@ -98,6 +221,60 @@ func mapMountsToAPIModel(mounts []model.Mount) (result []*containers.Mount) {
 	return result
 }
 
+func mapDevicesToAPIModel(devices []model.Device) (result []*containers.Device) {
+	for _, device := range devices {
+		result = append(result, &containers.Device{
+			HostPath:      device.HostPath,
+			ContainerPath: device.ContainerPath,
+			Permissions:   device.Permissions,
+		})
+	}
+	return result
+}
+
+func mapResourcesToAPIModel(resources map[string]int) map[string]int32 {
+	if len(resources) == 0 {
+		return nil
+	}
+	result := map[string]int32{}
+	for name, count := range resources {
+		result[name] = int32(count)
+	}
+	return result
+}
+
+func mapPortsToAPIModel(ports []model.ContainerPort) (result []*containers.ContainerPort) {
+	for _, port := range ports {
+		result = append(result, &containers.ContainerPort{
+			ContainerPort: int32(port.ContainerPort),
+			HostPort:      int32(port.HostPort),
+			Protocol:      port.Protocol,
+		})
+	}
+	return result
+}
+
+func mapSecurityContextToAPIModel(securityContext *model.SecurityContext) *containers.SecurityContext {
+	if securityContext == nil {
+		return nil
+	}
+	result := &containers.SecurityContext{
+		User:                   securityContext.User,
+		Group:                  securityContext.Group,
+		Privileged:             securityContext.Privileged,
+		ReadOnlyRootFilesystem: securityContext.ReadOnlyRootFilesystem,
+		NoNewPrivileges:        securityContext.NoNewPrivileges,
+		SeccompProfile:         securityContext.SeccompProfile,
+	}
+	if securityContext.Capabilities != nil {
+		result.Capabilities = &containers.Capabilities{
+			Add:  securityContext.Capabilities.Add,
+			Drop: securityContext.Capabilities.Drop,
+		}
+	}
+	return result
+}
+
 func mapPipeToAPIModel(pipe *model.PipeSet) *containers.PipeSet {
 	if pipe == nil {
 		return nil
@@ -115,16 +292,41 @@ func mapPipeToAPIModel(pipe *model.PipeSet) *containers.PipeSet {
 func MapContainerStatusesToAPIModel(statuses []model.ContainerStatus) (result []*containers.ContainerStatus) {
 	for _, status := range statuses {
 		result = append(result, &containers.ContainerStatus{
-			ContainerID:  status.ContainerID,
-			Name:         status.Name,
-			Image:        status.Image,
-			State:        status.State,
-			RestartCount: int32(status.RestartCount),
+			ContainerID:          status.ContainerID,
+			Name:                 status.Name,
+			Image:                status.Image,
+			State:                status.State,
+			RestartCount:         int32(status.RestartCount),
+			ExitCode:             int32(status.ExitCode),
+			StartedAt:            timeToUnix(status.StartedAt),
+			FinishedAt:           timeToUnix(status.FinishedAt),
+			Reason:               status.Reason,
+			LastTerminationState: mapContainerTerminatedToAPIModel(status.LastTerminationState),
 		})
 	}
 	return result
 }
 
+func mapContainerTerminatedToAPIModel(terminated *model.ContainerTerminated) *containers.ContainerTerminated {
+	if terminated == nil {
+		return nil
+	}
+	return &containers.ContainerTerminated{
+		ExitCode:   int32(terminated.ExitCode),
+		StartedAt:  timeToUnix(terminated.StartedAt),
+		FinishedAt: timeToUnix(terminated.FinishedAt),
+		Reason:     terminated.Reason,
+	}
+}
+
+// timeToUnix returns time as unix seconds or zero if time is not set
+func timeToUnix(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
 func mapFilesystemsToAPIModel(disks []model.Filesystem) (result []*node.Filesystem) {
 	for _, disk := range disks {
 		result = append(result, &node.Filesystem{
@@ -138,3 +340,21 @@ func mapFilesystemsToAPIModel(disks []model.Filesystem) (result []*node.Filesyst
 	}
 	return result
 }
+
+func mapDeviceResourcesToAPIModel(resources []model.DeviceResource) (result []*node.DeviceResource) {
+	for _, resource := range resources {
+		devices := []*node.NodeDevice{}
+		for _, device := range resource.Devices {
+			devices = append(devices, &node.NodeDevice{
+				Path:        device.Path,
+				AllocatedTo: device.AllocatedTo,
+			})
+		}
+		result = append(result, &node.DeviceResource{
+			Name:      resource.Name,
+			Exclusive: resource.Exclusive,
+			Devices:   devices,
+		})
+	}
+	return result
+}
@@ -0,0 +1,61 @@
+package mapping
+
+import (
+	"os"
+	"time"
+
+	pb "github.com/ernoaapa/eliot/pkg/api/services/volumes/v1"
+	"github.com/ernoaapa/eliot/pkg/sync"
+)
+
+// MapSyncFilesToAPIModel maps sync file states to API model
+func MapSyncFilesToAPIModel(states []sync.FileState) (result []*pb.SyncFile) {
+	for _, state := range states {
+		result = append(result, MapSyncFileToAPIModel(state))
+	}
+	return result
+}
+
+// MapSyncFileToAPIModel maps sync file state to API model
+func MapSyncFileToAPIModel(state sync.FileState) *pb.SyncFile {
+	return &pb.SyncFile{
+		Path:    state.Path,
+		Mode:    uint32(state.Mode),
+		ModTime: state.ModTime.UnixNano(),
+		Size:    state.Size,
+		Hash:    state.Hash,
+		Link:    state.Link,
+	}
+}
+
+// MapAPIModelToSyncFile maps API sync file to internal file state
+func MapAPIModelToSyncFile(file *pb.SyncFile) sync.FileState {
+	return sync.FileState{
+		Path:    file.Path,
+		Mode:    os.FileMode(file.Mode),
+		ModTime: time.Unix(0, file.ModTime),
+		Size:    file.Size,
+		Hash:    file.Hash,
+		Link:    file.Link,
+	}
+}
+
+// MapSyncResultToAPIModel maps sync result to API model
+func MapSyncResultToAPIModel(result sync.Result) *pb.SyncResult {
+	return &pb.SyncResult{
+		Written: int32(result.Written),
+		Deleted: int32(result.Deleted),
+		Bytes:   result.Bytes,
+		Errors:  result.Errors,
+	}
+}
+
+// MapAPIModelToSyncResult maps API sync result to internal model
+func MapAPIModelToSyncResult(result *pb.SyncResult) sync.Result {
+	return sync.Result{
+		Written: int(result.Written),
+		Deleted: int(result.Deleted),
+		Bytes:   result.Bytes,
+		Errors:  result.Errors,
+	}
+}
@@ -38,3 +38,16 @@ func WithContainer(container *containers.Container) PodOpts {
 		return nil
 	}
 }
+
+// WithVolume adds named volume to the Pod spec if it's not already defined
+func WithVolume(name, size string) PodOpts {
+	return func(pod *pods.Pod) error {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.Name == name {
+				return nil
+			}
+		}
+		pod.Spec.Volumes = append(pod.Spec.Volumes, &pods.PodVolume{Name: name, Size: size})
+		return nil
+	}
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"io"
+	"net"
+
+	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
+	"github.com/ernoaapa/eliot/pkg/api/stream"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PortForward is 'pods' service PortForward implementation, tunnels the stream to TCP port in the pod
+func (s *Server) PortForward(server pods.Pods_PortForwardServer) error {
+	first, err := server.Recv()
+	if err != nil {
+		return errors.Wrap(err, "Failed to receive PortForward request")
+	}
+
+	if first.Namespace == "" {
+		return status.Errorf(codes.InvalidArgument, "You must define 'namespace'")
+	}
+
+	if first.PodName == "" {
+		return status.Errorf(codes.InvalidArgument, "You must define 'podName'")
+	}
+
+	if first.Port <= 0 || first.Port > 65535 {
+		return status.Errorf(codes.InvalidArgument, "Invalid port [%d]", first.Port)
+	}
+
+	conn, err := s.client.DialPod(first.Namespace, first.PodName, int(first.Port))
+	if err != nil {
+		if runtime.IsNotFound(err) {
+			return status.Errorf(codes.NotFound, "Pod [%s] not found", first.PodName)
+		}
+		return status.Errorf(codes.Unavailable, "Failed to connect to port [%d] in pod [%s]: %s", first.Port, first.PodName, err)
+	}
+	defer conn.Close()
+	log.Debugf("Forward connection to port [%d] in pod [%s] in namespace [%s]", first.Port, first.PodName, first.Namespace)
+
+	pending := first.Data
+	reader := stream.NewChunkReader(func() ([]byte, error) {
+		if pending != nil {
+			chunk := pending
+			pending = nil
+			return chunk, nil
+		}
+		req, err := server.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+
+	go func() {
+		if _, err := io.Copy(conn, reader); err != nil {
+			log.Debugf("Stopped forwarding to port [%d] in pod [%s]: %s", first.Port, first.PodName, err)
+			conn.Close()
+			return
+		}
+		// Client stopped sending, pass the half-close to the pod
+		closeWrite(conn)
+	}()
+
+	writer := stream.NewChunkWriter(func(chunk []byte) error {
+		return server.Send(&pods.PortForwardResponse{Data: chunk})
+	})
+	_, err = io.Copy(writer, conn)
+	return err
+}
+
+func closeWrite(conn net.Conn) {
+	if tcp, ok := conn.(*net.TCPConn); ok {
+		tcp.CloseWrite()
+		return
+	}
+	conn.Close()
+}
@@ -3,8 +3,6 @@ package api
 import (
 	"fmt"
 	"net"
-	"strconv"
-	"strings"
 	"syscall"
 	"time"
 
@@ -13,47 +11,252 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/ernoaapa/eliot/pkg/api/mapping"
+	configsapi "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
 	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+	files "github.com/ernoaapa/eliot/pkg/api/services/files/v1"
 	node "github.com/ernoaapa/eliot/pkg/api/services/node/v1"
 	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
+	volumesapi "github.com/ernoaapa/eliot/pkg/api/services/volumes/v1"
 	"github.com/ernoaapa/eliot/pkg/api/stream"
+	"github.com/ernoaapa/eliot/pkg/configs"
+	"github.com/ernoaapa/eliot/pkg/hardware"
+	"github.com/ernoaapa/eliot/pkg/maintenance"
 	resolver "github.com/ernoaapa/eliot/pkg/node"
+	"github.com/ernoaapa/eliot/pkg/power"
 	"github.com/ernoaapa/eliot/pkg/progress"
 	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/ernoaapa/eliot/pkg/volume"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // Server implements the GRPC API for the eli
 type Server struct {
-	resolver *resolver.Resolver
-	client   runtime.Client
-	grpc     *grpc.Server
-	listen   string
+	resolver  *resolver.Resolver
+	client    runtime.Client
+	allocator *hardware.Allocator
+	volumes   *volume.Manager
+	configs   *configs.Store
+	// maintenance keeps track if the node is cordoned or drained
+	maintenance *maintenance.Manager
+	// power stops the pods and reboots or powers off the host
+	power  *power.Manager
+	grpc   *grpc.Server
+	listen string
 }
 
 // Info is Node service Info implementation
 func (s *Server) Info(context context.Context, req *node.InfoRequest) (*node.InfoResponse, error) {
+	info := s.resolver.GetInfo()
+
+	devices, err := s.allocator.GetResources()
+	if err != nil {
+		log.Warnf("Failed to resolve node hardware devices, will respond without them: %s", err)
+	}
+	info.Devices = devices
+	info.Unschedulable = s.maintenance.IsCordoned()
+
 	return &node.InfoResponse{
-		Info: mapping.MapInfoToAPIModel(s.resolver.GetInfo()),
+		Info: mapping.MapInfoToAPIModel(info),
+	}, nil
+}
+
+// SetLabels is Node service SetLabels implementation
+func (s *Server) SetLabels(context context.Context, req *node.SetLabelsRequest) (*node.LabelsResponse, error) {
+	labels := mapping.MapLabelsToInternalModel(req.Labels)
+	if err := model.ValidateLabels(labels); err != nil {
+		return nil, errors.Wrap(err, "Invalid labels")
+	}
+
+	updated, err := s.resolver.SetLabels(labels)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to set node labels")
+	}
+	return &node.LabelsResponse{
+		Labels: mapping.MapLabelsToAPIModel(updated),
+	}, nil
+}
+
+// RemoveLabels is Node service RemoveLabels implementation
+func (s *Server) RemoveLabels(context context.Context, req *node.RemoveLabelsRequest) (*node.LabelsResponse, error) {
+	updated, err := s.resolver.RemoveLabels(req.Keys)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to remove node labels")
+	}
+	return &node.LabelsResponse{
+		Labels: mapping.MapLabelsToAPIModel(updated),
+	}, nil
+}
+
+// Cordon is Node service Cordon implementation
+func (s *Server) Cordon(context context.Context, req *node.CordonRequest) (*node.CordonResponse, error) {
+	if err := s.maintenance.Cordon(); err != nil {
+		return nil, errors.Wrap(err, "Failed to cordon node")
+	}
+	return &node.CordonResponse{}, nil
+}
+
+// Drain is Node service Drain implementation
+func (s *Server) Drain(context context.Context, req *node.DrainRequest) (*node.DrainResponse, error) {
+	drained, err := s.maintenance.Drain()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to drain node")
+	}
+	return &node.DrainResponse{
+		Pods: formatPodRefs(drained),
+	}, nil
+}
+
+// Uncordon is Node service Uncordon implementation
+func (s *Server) Uncordon(ctx context.Context, req *node.UncordonRequest) (*node.UncordonResponse, error) {
+	restarted, err := s.maintenance.Uncordon(s.podStarter(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to uncordon node")
+	}
+	return &node.UncordonResponse{
+		Pods: formatPodRefs(restarted),
+	}, nil
+}
+
+// Reboot is Node service Reboot implementation
+func (s *Server) Reboot(context context.Context, req *node.RebootRequest) (*node.PowerResponse, error) {
+	stopped, err := s.power.Execute(power.Reboot, s.podStarter(context))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to reboot node")
+	}
+	return &node.PowerResponse{
+		Pods: formatPodRefs(stopped),
 	}, nil
 }
 
+// Shutdown is Node service Shutdown implementation
+func (s *Server) Shutdown(context context.Context, req *node.ShutdownRequest) (*node.PowerResponse, error) {
+	stopped, err := s.power.Execute(power.Shutdown, s.podStarter(context))
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to shutdown node")
+	}
+	return &node.PowerResponse{
+		Pods: formatPodRefs(stopped),
+	}, nil
+}
+
+// podStarter returns function what starts the pod containers, e.g. to restart the stopped pods
+func (s *Server) podStarter(ctx context.Context) func(namespace, name string) error {
+	return func(namespace, name string) error {
+		_, err := s.Start(ctx, &pods.StartPodRequest{Namespace: namespace, Name: name})
+		return err
+	}
+}
+
+func formatPodRefs(refs []maintenance.PodRef) (result []string) {
+	for _, ref := range refs {
+		result = append(result, fmt.Sprintf("%s/%s", ref.Namespace, ref.Name))
+	}
+	return result
+}
+
 // Create is 'pods' service Create implementation
-func (s *Server) Create(req *pods.CreatePodRequest, server pods.Pods_CreateServer) error {
+func (s *Server) Create(req *pods.CreatePodRequest, server pods.Pods_CreateServer) (err error) {
 	pod := mapping.MapPodToInternalModel(req.Pod)
 	var (
 		done       = make(chan struct{})
 		progresses = []*progress.ImageFetch{}
+		// rollback undoes the completed steps in reverse order if the create fails
+		rollback = []func(){}
 	)
 	defer close(done)
+	defer func() {
+		if err != nil {
+			for i := len(rollback) - 1; i >= 0; i-- {
+				rollback[i]()
+			}
+		}
+	}()
 
 	if err := s.ensurePodNotExist(pod.Metadata.Namespace, pod.Metadata.Name); err != nil {
 		return errors.Wrapf(err, "Cannot create pod [%s]", pod.Metadata.Name)
 	}
 
+	if err := model.ValidateMetadata(pod.Metadata); err != nil {
+		return errors.Wrapf(err, "Invalid metadata in pod [%s]", pod.Metadata.Name)
+	}
+
+	if err := model.ValidateNodeSelector(pod.Spec.NodeSelector); err != nil {
+		return errors.Wrapf(err, "Invalid nodeSelector in pod [%s]", pod.Metadata.Name)
+	}
+
+	if s.maintenance.IsCordoned() {
+		return status.Errorf(codes.FailedPrecondition, "Node is cordoned, cannot create pod [%s]", pod.Metadata.Name)
+	}
+
+	info := s.resolver.GetInfo()
+	if !model.MatchesNodeSelector(pod.Spec.NodeSelector, info.Labels) {
+		return status.Errorf(codes.FailedPrecondition, "Pod [%s] nodeSelector %v doesn't match to node [%s] labels %v", pod.Metadata.Name, pod.Spec.NodeSelector, info.Hostname, info.Labels)
+	}
+
+	for _, container := range pod.Spec.Containers {
+		if container.SecurityContext == nil {
+			continue
+		}
+		if err := model.ValidateSecurityContext(*container.SecurityContext); err != nil {
+			return errors.Wrapf(err, "Invalid securityContext in container [%s]", container.Name)
+		}
+	}
+
+	if err := model.ValidatePorts(pod.Spec); err != nil {
+		return errors.Wrapf(err, "Invalid ports in pod [%s]", pod.Metadata.Name)
+	}
+
+	existing, err := s.getAllPods()
+	if err != nil {
+		return errors.Wrapf(err, "Cannot validate pod [%s] ports", pod.Metadata.Name)
+	}
+	if err := model.ValidatePortConflicts(pod.Spec, existing); err != nil {
+		return status.Errorf(codes.FailedPrecondition, "Cannot create pod [%s]: %s", pod.Metadata.Name, err)
+	}
+
+	if err := model.ValidateNamespaces(pod.Spec); err != nil {
+		return errors.Wrapf(err, "Invalid namespace options in pod [%s]", pod.Metadata.Name)
+	}
+
+	for _, container := range pod.Spec.Containers {
+		if err := model.ValidateEnvVars(container.EnvVars); err != nil {
+			return errors.Wrapf(err, "Invalid env in container [%s]", container.Name)
+		}
+	}
+
+	if err := model.ValidateVolumes(pod.Spec); err != nil {
+		return errors.Wrapf(err, "Invalid volumes in pod [%s]", pod.Metadata.Name)
+	}
+
+	createdVolumes, err := s.volumes.Prepare(&pod)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot prepare volumes for pod [%s]", pod.Metadata.Name)
+	}
+	rollback = append(rollback, func() {
+		s.volumes.Remove(pod.Metadata.Namespace, createdVolumes)
+	})
+
+	// Prepare might write some of the files before it fails
+	rollback = append(rollback, func() {
+		if err := s.configs.Cleanup(pod.Metadata.Namespace, pod.Metadata.Name); err != nil {
+			log.Warnf("Failed to remove projected files of failed pod [%s]: %s", pod.Metadata.Name, err)
+		}
+	})
+	if err := s.configs.Prepare(&pod, info); err != nil {
+		return errors.Wrapf(err, "Cannot resolve secrets, configmaps and fields for pod [%s]", pod.Metadata.Name)
+	}
+
+	// The reservation is needed only until the containers exist, so it get released also on success
+	if err := s.allocator.Allocate(&pod); err != nil {
+		return errors.Wrapf(err, "Cannot create pod [%s]", pod.Metadata.Name)
+	}
+	defer s.allocator.Release(pod)
+
 	go func() {
 		for {
 			select {
@@ -85,16 +288,43 @@ func (s *Server) Create(req *pods.CreatePodRequest, server pods.Pods_CreateServe
 		}
 		progress.AllDone()
 
-		_, err := s.client.CreateContainer(pod, container)
+		containerStatus, err := s.client.CreateContainer(pod, container)
 		if err != nil {
 			return errors.Wrapf(err, "Failed to create container [%s]", container.Name)
 		}
+		rollback = append(rollback, func() {
+			s.removeContainer(pod, containerStatus.ContainerID)
+		})
 		log.Debugf("Container [%s] created", container.Name)
 	}
 
 	return nil
 }
 
+// removeContainer removes the container of failed pod, removing the last container releases the pod network
+func (s *Server) removeContainer(pod model.Pod, id string) {
+	if _, err := s.client.StopContainer(pod.Metadata.Namespace, id); err != nil {
+		log.Warnf("Failed to remove container [%s] of failed pod [%s]: %s", id, pod.Metadata.Name, err)
+	}
+}
+
+// getAllPods returns the pods in all namespaces
+func (s *Server) getAllPods() (result []model.Pod, err error) {
+	namespaces, err := s.client.GetNamespaces()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to fetch namespaces")
+	}
+
+	for _, namespace := range namespaces {
+		pods, err := s.client.GetPods(namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to fetch pods in namespace [%s]", namespace)
+		}
+		result = append(result, pods...)
+	}
+	return result, nil
+}
+
 func (s *Server) ensurePodNotExist(namespace, name string) error {
 	_, err := s.client.GetPod(namespace, name)
 	if err != nil {
@@ -177,6 +407,10 @@ func (s *Server) Delete(context context.Context, req *pods.DeletePodRequest) (*p
 
 	pod.Status.ContainerStatuses = statuses
 
+	if err := s.configs.Cleanup(req.Namespace, req.Name); err != nil {
+		log.Warnf("Failed to remove pod [%s] projected secret and configmap files: %s", req.Name, err)
+	}
+
 	return &pods.DeletePodResponse{
 		Pod: mapping.MapPodToAPIModel(pod),
 	}, nil
@@ -184,56 +418,77 @@ func (s *Server) Delete(context context.Context, req *pods.DeletePodRequest) (*p
 
 // List is 'pods' service List implementation
 func (s *Server) List(context context.Context, req *pods.ListPodsRequest) (*pods.ListPodsResponse, error) {
+	selector, err := model.ParseSelector(req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
 	p, err := s.client.GetPods(req.Namespace)
 	if err != nil {
 		return nil, err
 	}
+
+	matching := []model.Pod{}
+	for _, pod := range p {
+		if selector.Matches(pod.Metadata.Labels) {
+			matching = append(matching, pod)
+		}
+	}
 	return &pods.ListPodsResponse{
-		Pods: mapping.MapPodsToAPIModel(p),
+		Pods: mapping.MapPodsToAPIModel(matching),
 	}, nil
 }
 
 // Exec connects to process in container and streams stdout and stderr outputs to client
 func (s *Server) Exec(server containers.Containers_ExecServer) error {
-	md, ok := metadata.FromIncomingContext(server.Context())
-	if !ok {
-		return fmt.Errorf("Incoming Exec request don't have metadata. You must provide 'namespace', 'container', 'execid' and 'command' through metadata")
+	req, err := server.Recv()
+	if err != nil {
+		return errors.Wrap(err, "Failed to receive Exec start message")
+	}
+	start := req.GetStart()
+	if start == nil {
+		return status.Errorf(codes.InvalidArgument, "The first Exec message must define the process to execute")
 	}
-	log.Debugf("Received metadata: %s", md)
-	var (
-		execID      = getMetadataValue(md, "execid")
-		namespace   = getMetadataValue(md, "namespace")
-		containerID = getMetadataValue(md, "container")
-		args        = strings.Split(getMetadataValue(md, "args"), " ")
-		tty         = false
-	)
-	tty, _ = strconv.ParseBool(getMetadataValue(md, "tty"))
 
-	if namespace == "" {
-		return fmt.Errorf("You must define 'namespace' metadata")
+	if start.Namespace == "" {
+		return status.Errorf(codes.InvalidArgument, "You must define 'namespace'")
 	}
 
-	if containerID == "" {
-		return fmt.Errorf("You must define 'container' metadata")
+	if start.ContainerID == "" {
+		return status.Errorf(codes.InvalidArgument, "You must define 'containerID'")
 	}
 
-	if len(args) == 0 {
-		return fmt.Errorf("You must define 'args' metadata")
+	if len(start.Args) == 0 {
+		return status.Errorf(codes.InvalidArgument, "You must define 'args'")
 	}
 
-	log.Debugf("Execute command [%s](tty: %s) in container [%s] in namespace [%s]", strings.Join(args, " "), tty, containerID, namespace)
-	return s.client.Exec(
-		namespace,
-		containerID,
-		execID,
-		args,
-		tty,
+	log.Debugf("Execute command %q (tty: %t) in container [%s] in namespace [%s]", start.Args, start.Tty, start.ContainerID, start.Namespace)
+	resize := make(chan runtime.TerminalSize, 1)
+	exitCode, err := s.client.Exec(
+		start.Namespace,
+		start.ContainerID,
+		runtime.ExecProcess{
+			ID:         start.ExecID,
+			Args:       start.Args,
+			Env:        start.Env,
+			User:       start.User,
+			WorkingDir: start.WorkingDir,
+			Tty:        start.Tty,
+		},
 		runtime.AttachIO{
-			Stdin:  stream.NewReader(server),
+			Stdin:  stream.NewResizeReader(server, sendLatestSize(resize)),
 			Stdout: stream.NewWriter(server, false),
 			Stderr: stream.NewWriter(server, true),
+			Resize: resize,
 		},
 	)
+	if err != nil {
+		return err
+	}
+
+	return server.Send(&containers.StdoutStreamResponse{
+		Exit: &containers.ExecExit{ExitCode: int32(exitCode)},
+	})
 }
 
 // Attach connects to process in container and streams stdout and stderr outputs to client
@@ -257,16 +512,38 @@ func (s *Server) Attach(server containers.Containers_AttachServer) error {
 	}
 
 	log.Debugf("Attach to container [%s] in namespace [%s]", containerID, namespace)
+	resize := make(chan runtime.TerminalSize, 1)
 	return s.client.Attach(
 		namespace, containerID,
 		runtime.AttachIO{
-			Stdin:  stream.NewReader(server),
+			Stdin:  stream.NewResizeReader(server, sendLatestSize(resize)),
 			Stdout: stream.NewWriter(server, false),
 			Stderr: stream.NewWriter(server, true),
+			Resize: resize,
+			Detach: server.Context().Done(),
 		},
 	)
 }
 
+// sendLatestSize returns function what sends the terminal size to the channel without blocking the stdin stream.
+// If the runtime haven't handled the previous size yet, it gets replaced with the latest
+func sendLatestSize(resize chan runtime.TerminalSize) func(width, height uint32) {
+	return func(width, height uint32) {
+		size := runtime.TerminalSize{Width: width, Height: height}
+		for {
+			select {
+			case resize <- size:
+				return
+			default:
+			}
+			select {
+			case <-resize:
+			default:
+			}
+		}
+	}
+}
+
 // Signal connects to process in container and send signal to the process
 func (s *Server) Signal(cxt context.Context, req *containers.SignalRequest) (*containers.SignalResponse, error) {
 	err := s.client.Signal(req.Namespace, req.ContainerID, syscall.Signal(req.Signal))
@@ -284,17 +561,26 @@ func getMetadataValue(md metadata.MD, key string) string {
 }
 
 // NewServer creates new API server
-func NewServer(listen string, client runtime.Client, resolver *resolver.Resolver) *Server {
+func NewServer(listen string, client runtime.Client, resolver *resolver.Resolver, allocator *hardware.Allocator, volumes *volume.Manager, configs *configs.Store, maintenance *maintenance.Manager, power *power.Manager) *Server {
 	apiserver := &Server{
-		resolver: resolver,
-		client:   client,
-		listen:   listen,
+		resolver:    resolver,
+		client:      client,
+		allocator:   allocator,
+		volumes:     volumes,
+		configs:     configs,
+		maintenance: maintenance,
+		power:       power,
+		listen:      listen,
 	}
 
 	apiserver.grpc = grpc.NewServer()
 	pods.RegisterPodsServer(apiserver.grpc, apiserver)
 	containers.RegisterContainersServer(apiserver.grpc, apiserver)
 	node.RegisterNodeServer(apiserver.grpc, apiserver)
+	volumesapi.RegisterVolumesServer(apiserver.grpc, &VolumesServer{client: client, volumes: volumes})
+	configsapi.RegisterSecretsServer(apiserver.grpc, &SecretsServer{store: configs})
+	configsapi.RegisterConfigMapsServer(apiserver.grpc, &ConfigMapsServer{store: configs})
+	files.RegisterFilesServer(apiserver.grpc, &FilesServer{client: client})
 	return apiserver
 }
 
@@ -3,6 +3,7 @@ package api
 import (
 	"testing"
 
+	"github.com/ernoaapa/eliot/pkg/runtime"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,3 +22,14 @@ func TestGetMetadataValue(t *testing.T) {
 	assert.Equal(t, "first", getMetadataValue(md, "crazy"))
 	assert.Equal(t, "", getMetadataValue(md, "dontexist"))
 }
+
+func TestSendLatestSize(t *testing.T) {
+	resize := make(chan runtime.TerminalSize, 1)
+	send := sendLatestSize(resize)
+
+	send(80, 24)
+	send(120, 40)
+
+	assert.Equal(t, runtime.TerminalSize{Width: 120, Height: 40}, <-resize, "should replace the pending size with the latest")
+	assert.Len(t, resize, 0)
+}
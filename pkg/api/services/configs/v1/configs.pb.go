@@ -0,0 +1,632 @@
+// Code generated by protoc-gen-go.
+// source: services/configs/v1/configs.proto
+// DO NOT EDIT!
+
+/*
+Package configs is a generated protocol buffer package.
+
+It is generated from these files:
+	services/configs/v1/configs.proto
+
+It has these top-level messages:
+	CreateSecretRequest
+	CreateSecretResponse
+	ListSecretsRequest
+	ListSecretsResponse
+	DeleteSecretRequest
+	DeleteSecretResponse
+	Secret
+	CreateConfigMapRequest
+	CreateConfigMapResponse
+	ListConfigMapsRequest
+	ListConfigMapsResponse
+	DeleteConfigMapRequest
+	DeleteConfigMapResponse
+	ConfigMap
+*/
+package configs
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import eliot_core "github.com/ernoaapa/eliot/pkg/api/core"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type CreateSecretRequest struct {
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty"`
+}
+
+func (m *CreateSecretRequest) Reset()                    { *m = CreateSecretRequest{} }
+func (m *CreateSecretRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateSecretRequest) ProtoMessage()               {}
+func (*CreateSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *CreateSecretRequest) GetSecret() *Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+type CreateSecretResponse struct {
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty"`
+}
+
+func (m *CreateSecretResponse) Reset()                    { *m = CreateSecretResponse{} }
+func (m *CreateSecretResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateSecretResponse) ProtoMessage()               {}
+func (*CreateSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *CreateSecretResponse) GetSecret() *Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+type ListSecretsRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+}
+
+func (m *ListSecretsRequest) Reset()                    { *m = ListSecretsRequest{} }
+func (m *ListSecretsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListSecretsRequest) ProtoMessage()               {}
+func (*ListSecretsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *ListSecretsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ListSecretsResponse struct {
+	Secrets []*Secret `protobuf:"bytes,1,rep,name=secrets" json:"secrets,omitempty"`
+}
+
+func (m *ListSecretsResponse) Reset()                    { *m = ListSecretsResponse{} }
+func (m *ListSecretsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListSecretsResponse) ProtoMessage()               {}
+func (*ListSecretsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *ListSecretsResponse) GetSecrets() []*Secret {
+	if m != nil {
+		return m.Secrets
+	}
+	return nil
+}
+
+type DeleteSecretRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *DeleteSecretRequest) Reset()                    { *m = DeleteSecretRequest{} }
+func (m *DeleteSecretRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteSecretRequest) ProtoMessage()               {}
+func (*DeleteSecretRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *DeleteSecretRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteSecretRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteSecretResponse struct {
+	Secret *Secret `protobuf:"bytes,1,opt,name=secret" json:"secret,omitempty"`
+}
+
+func (m *DeleteSecretResponse) Reset()                    { *m = DeleteSecretResponse{} }
+func (m *DeleteSecretResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteSecretResponse) ProtoMessage()               {}
+func (*DeleteSecretResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *DeleteSecretResponse) GetSecret() *Secret {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+type Secret struct {
+	Metadata *eliot_core.ResourceMetadata `protobuf:"bytes,1,opt,name=metadata" json:"metadata,omitempty"`
+	// Secret data, values are empty in the responses
+	Data map[string][]byte `protobuf:"bytes,2,rep,name=data" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *Secret) Reset()                    { *m = Secret{} }
+func (m *Secret) String() string            { return proto.CompactTextString(m) }
+func (*Secret) ProtoMessage()               {}
+func (*Secret) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *Secret) GetMetadata() *eliot_core.ResourceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Secret) GetData() map[string][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type CreateConfigMapRequest struct {
+	ConfigMap *ConfigMap `protobuf:"bytes,1,opt,name=configMap" json:"configMap,omitempty"`
+}
+
+func (m *CreateConfigMapRequest) Reset()                    { *m = CreateConfigMapRequest{} }
+func (m *CreateConfigMapRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateConfigMapRequest) ProtoMessage()               {}
+func (*CreateConfigMapRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *CreateConfigMapRequest) GetConfigMap() *ConfigMap {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return nil
+}
+
+type CreateConfigMapResponse struct {
+	ConfigMap *ConfigMap `protobuf:"bytes,1,opt,name=configMap" json:"configMap,omitempty"`
+}
+
+func (m *CreateConfigMapResponse) Reset()                    { *m = CreateConfigMapResponse{} }
+func (m *CreateConfigMapResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateConfigMapResponse) ProtoMessage()               {}
+func (*CreateConfigMapResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *CreateConfigMapResponse) GetConfigMap() *ConfigMap {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return nil
+}
+
+type ListConfigMapsRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+}
+
+func (m *ListConfigMapsRequest) Reset()                    { *m = ListConfigMapsRequest{} }
+func (m *ListConfigMapsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListConfigMapsRequest) ProtoMessage()               {}
+func (*ListConfigMapsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *ListConfigMapsRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ListConfigMapsResponse struct {
+	ConfigMaps []*ConfigMap `protobuf:"bytes,1,rep,name=configMaps" json:"configMaps,omitempty"`
+}
+
+func (m *ListConfigMapsResponse) Reset()                    { *m = ListConfigMapsResponse{} }
+func (m *ListConfigMapsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListConfigMapsResponse) ProtoMessage()               {}
+func (*ListConfigMapsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *ListConfigMapsResponse) GetConfigMaps() []*ConfigMap {
+	if m != nil {
+		return m.ConfigMaps
+	}
+	return nil
+}
+
+type DeleteConfigMapRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *DeleteConfigMapRequest) Reset()                    { *m = DeleteConfigMapRequest{} }
+func (m *DeleteConfigMapRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteConfigMapRequest) ProtoMessage()               {}
+func (*DeleteConfigMapRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *DeleteConfigMapRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteConfigMapRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteConfigMapResponse struct {
+	ConfigMap *ConfigMap `protobuf:"bytes,1,opt,name=configMap" json:"configMap,omitempty"`
+}
+
+func (m *DeleteConfigMapResponse) Reset()                    { *m = DeleteConfigMapResponse{} }
+func (m *DeleteConfigMapResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteConfigMapResponse) ProtoMessage()               {}
+func (*DeleteConfigMapResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *DeleteConfigMapResponse) GetConfigMap() *ConfigMap {
+	if m != nil {
+		return m.ConfigMap
+	}
+	return nil
+}
+
+type ConfigMap struct {
+	Metadata *eliot_core.ResourceMetadata `protobuf:"bytes,1,opt,name=metadata" json:"metadata,omitempty"`
+	Data     map[string]string            `protobuf:"bytes,2,rep,name=data" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+}
+
+func (m *ConfigMap) Reset()                    { *m = ConfigMap{} }
+func (m *ConfigMap) String() string            { return proto.CompactTextString(m) }
+func (*ConfigMap) ProtoMessage()               {}
+func (*ConfigMap) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ConfigMap) GetMetadata() *eliot_core.ResourceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ConfigMap) GetData() map[string]string {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CreateSecretRequest)(nil), "eliot.services.configs.v1.CreateSecretRequest")
+	proto.RegisterType((*CreateSecretResponse)(nil), "eliot.services.configs.v1.CreateSecretResponse")
+	proto.RegisterType((*ListSecretsRequest)(nil), "eliot.services.configs.v1.ListSecretsRequest")
+	proto.RegisterType((*ListSecretsResponse)(nil), "eliot.services.configs.v1.ListSecretsResponse")
+	proto.RegisterType((*DeleteSecretRequest)(nil), "eliot.services.configs.v1.DeleteSecretRequest")
+	proto.RegisterType((*DeleteSecretResponse)(nil), "eliot.services.configs.v1.DeleteSecretResponse")
+	proto.RegisterType((*Secret)(nil), "eliot.services.configs.v1.Secret")
+	proto.RegisterType((*CreateConfigMapRequest)(nil), "eliot.services.configs.v1.CreateConfigMapRequest")
+	proto.RegisterType((*CreateConfigMapResponse)(nil), "eliot.services.configs.v1.CreateConfigMapResponse")
+	proto.RegisterType((*ListConfigMapsRequest)(nil), "eliot.services.configs.v1.ListConfigMapsRequest")
+	proto.RegisterType((*ListConfigMapsResponse)(nil), "eliot.services.configs.v1.ListConfigMapsResponse")
+	proto.RegisterType((*DeleteConfigMapRequest)(nil), "eliot.services.configs.v1.DeleteConfigMapRequest")
+	proto.RegisterType((*DeleteConfigMapResponse)(nil), "eliot.services.configs.v1.DeleteConfigMapResponse")
+	proto.RegisterType((*ConfigMap)(nil), "eliot.services.configs.v1.ConfigMap")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Secrets service
+
+type SecretsClient interface {
+	Create(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*CreateSecretResponse, error)
+	List(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
+	Delete(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error)
+}
+
+type secretsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSecretsClient(cc *grpc.ClientConn) SecretsClient {
+	return &secretsClient{cc}
+}
+
+func (c *secretsClient) Create(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*CreateSecretResponse, error) {
+	out := new(CreateSecretResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.configs.v1.Secrets/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) List(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
+	out := new(ListSecretsResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.configs.v1.Secrets/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) Delete(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error) {
+	out := new(DeleteSecretResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.configs.v1.Secrets/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for Secrets service
+
+type SecretsServer interface {
+	Create(context.Context, *CreateSecretRequest) (*CreateSecretResponse, error)
+	List(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
+	Delete(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error)
+}
+
+func RegisterSecretsServer(s *grpc.Server, srv SecretsServer) {
+	s.RegisterService(&_Secrets_serviceDesc, srv)
+}
+
+func _Secrets_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.configs.v1.Secrets/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).Create(ctx, req.(*CreateSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.configs.v1.Secrets/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).List(ctx, req.(*ListSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.configs.v1.Secrets/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).Delete(ctx, req.(*DeleteSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Secrets_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eliot.services.configs.v1.Secrets",
+	HandlerType: (*SecretsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Secrets_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Secrets_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Secrets_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/configs/v1/configs.proto",
+}
+
+// Client API for ConfigMaps service
+
+type ConfigMapsClient interface {
+	Create(ctx context.Context, in *CreateConfigMapRequest, opts ...grpc.CallOption) (*CreateConfigMapResponse, error)
+	List(ctx context.Context, in *ListConfigMapsRequest, opts ...grpc.CallOption) (*ListConfigMapsResponse, error)
+	Delete(ctx context.Context, in *DeleteConfigMapRequest, opts ...grpc.CallOption) (*DeleteConfigMapResponse, error)
+}
+
+type configMapsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewConfigMapsClient(cc *grpc.ClientConn) ConfigMapsClient {
+	return &configMapsClient{cc}
+}
+
+func (c *configMapsClient) Create(ctx context.Context, in *CreateConfigMapRequest, opts ...grpc.CallOption) (*CreateConfigMapResponse, error) {
+	out := new(CreateConfigMapResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.configs.v1.ConfigMaps/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configMapsClient) List(ctx context.Context, in *ListConfigMapsRequest, opts ...grpc.CallOption) (*ListConfigMapsResponse, error) {
+	out := new(ListConfigMapsResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.configs.v1.ConfigMaps/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *configMapsClient) Delete(ctx context.Context, in *DeleteConfigMapRequest, opts ...grpc.CallOption) (*DeleteConfigMapResponse, error) {
+	out := new(DeleteConfigMapResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.configs.v1.ConfigMaps/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for ConfigMaps service
+
+type ConfigMapsServer interface {
+	Create(context.Context, *CreateConfigMapRequest) (*CreateConfigMapResponse, error)
+	List(context.Context, *ListConfigMapsRequest) (*ListConfigMapsResponse, error)
+	Delete(context.Context, *DeleteConfigMapRequest) (*DeleteConfigMapResponse, error)
+}
+
+func RegisterConfigMapsServer(s *grpc.Server, srv ConfigMapsServer) {
+	s.RegisterService(&_ConfigMaps_serviceDesc, srv)
+}
+
+func _ConfigMaps_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigMapsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.configs.v1.ConfigMaps/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigMapsServer).Create(ctx, req.(*CreateConfigMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigMaps_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigMapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigMapsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.configs.v1.ConfigMaps/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigMapsServer).List(ctx, req.(*ListConfigMapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ConfigMaps_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteConfigMapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ConfigMapsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.configs.v1.ConfigMaps/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ConfigMapsServer).Delete(ctx, req.(*DeleteConfigMapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ConfigMaps_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eliot.services.configs.v1.ConfigMaps",
+	HandlerType: (*ConfigMapsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _ConfigMaps_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _ConfigMaps_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ConfigMaps_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "services/configs/v1/configs.proto",
+}
+
+func init() { proto.RegisterFile("services/configs/v1/configs.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0x55, 0xba, 0xd2, 0x91, 0x0b, 0x0f, 0xc8, 0x1d, 0x5d, 0x89, 0xf6, 0xb0, 0x45, 0x3c, 0x20,
+	0x21, 0x6c, 0x5a, 0x84, 0x18, 0x4c, 0x68, 0x52, 0x57, 0x84, 0x84, 0x98, 0x04, 0xe1, 0x0d, 0x31,
+	0x24, 0x2f, 0x5c, 0x4a, 0xd4, 0x0f, 0x87, 0xd8, 0xad, 0xb4, 0xff, 0xc6, 0x13, 0x0f, 0xfc, 0x09,
+	0xfe, 0xcc, 0x14, 0xdb, 0x49, 0xd7, 0x66, 0x4b, 0x53, 0x75, 0x6f, 0xee, 0xf5, 0x3d, 0xe7, 0x1e,
+	0x9f, 0x1e, 0x5b, 0x81, 0x03, 0x89, 0xc9, 0x2c, 0x0a, 0x51, 0xb2, 0x50, 0x4c, 0x7e, 0x46, 0x03,
+	0xc9, 0x66, 0x9d, 0x6c, 0x49, 0xe3, 0x44, 0x28, 0x41, 0x1e, 0xe1, 0x28, 0x12, 0x8a, 0x66, 0x8d,
+	0x34, 0xdb, 0x9d, 0x75, 0xbc, 0x66, 0x28, 0x12, 0x64, 0x63, 0x54, 0xfc, 0x07, 0x57, 0xdc, 0xf4,
+	0xfb, 0x9f, 0xa0, 0x79, 0x92, 0x20, 0x57, 0xf8, 0x05, 0xc3, 0x04, 0x55, 0x80, 0xbf, 0xa7, 0x28,
+	0x15, 0x79, 0x0d, 0x0d, 0xa9, 0x0b, 0x6d, 0x67, 0xdf, 0x79, 0x72, 0xaf, 0x7b, 0x40, 0x6f, 0xe4,
+	0xa5, 0x16, 0x69, 0x01, 0xfe, 0x67, 0xd8, 0x59, 0x64, 0x94, 0xb1, 0x98, 0x48, 0xdc, 0x84, 0xb2,
+	0x0b, 0xe4, 0x63, 0x24, 0x95, 0xa9, 0xca, 0x4c, 0xe3, 0x1e, 0xb8, 0x13, 0x3e, 0x46, 0x19, 0xf3,
+	0x10, 0x35, 0xa7, 0x1b, 0xcc, 0x0b, 0x7e, 0x00, 0xcd, 0x05, 0x8c, 0x55, 0x71, 0x04, 0xdb, 0x86,
+	0x54, 0xb6, 0x9d, 0xfd, 0xad, 0x6a, 0x32, 0x32, 0x84, 0xff, 0x1e, 0x9a, 0x7d, 0x1c, 0xe1, 0xb2,
+	0x59, 0xa5, 0x42, 0x08, 0x81, 0x7a, 0xfa, 0xa3, 0x5d, 0xd3, 0x1b, 0x7a, 0x9d, 0x7a, 0xb4, 0x48,
+	0xb4, 0xb9, 0x47, 0x7f, 0x1c, 0x68, 0x98, 0x12, 0x39, 0x84, 0xbb, 0xd9, 0xbf, 0x6c, 0x79, 0xf6,
+	0x2c, 0x4f, 0x9a, 0x00, 0x1a, 0xa0, 0x14, 0xd3, 0x24, 0xc4, 0x53, 0xdb, 0x13, 0xe4, 0xdd, 0xe4,
+	0x18, 0xea, 0x1a, 0x55, 0xd3, 0xd6, 0x3c, 0x5d, 0x39, 0x9d, 0xf6, 0xb9, 0xe2, 0xef, 0x26, 0x2a,
+	0xb9, 0x08, 0x34, 0xd0, 0x7b, 0x05, 0x6e, 0x5e, 0x22, 0x0f, 0x60, 0x6b, 0x88, 0x17, 0xd6, 0x91,
+	0x74, 0x49, 0x76, 0xe0, 0xce, 0x8c, 0x8f, 0xa6, 0xc6, 0x8c, 0xfb, 0x81, 0xf9, 0xf1, 0xa6, 0x76,
+	0xe8, 0xf8, 0xdf, 0xa0, 0x65, 0x52, 0x73, 0xa2, 0x67, 0x9c, 0xf2, 0x38, 0x73, 0xb7, 0x07, 0x6e,
+	0x98, 0xd5, 0xec, 0x71, 0x1e, 0x97, 0x08, 0x9b, 0xe3, 0xe7, 0x30, 0xff, 0x0c, 0x76, 0x0b, 0xec,
+	0xd6, 0xf2, 0xdb, 0xa0, 0x7f, 0x09, 0x0f, 0xd3, 0xac, 0xe5, 0x7b, 0x15, 0x23, 0xfa, 0x1d, 0x5a,
+	0xcb, 0x30, 0x2b, 0xaa, 0x0f, 0x90, 0xb3, 0x67, 0x41, 0xad, 0xa6, 0xea, 0x0a, 0xce, 0xff, 0x00,
+	0x2d, 0x93, 0xb2, 0x82, 0xa7, 0xeb, 0x27, 0xf6, 0x0c, 0x76, 0x0b, 0x5c, 0xb7, 0xe8, 0xe0, 0x5f,
+	0x07, 0xdc, 0x7c, 0x63, 0x83, 0x00, 0xf7, 0x16, 0x02, 0x4c, 0xab, 0xc8, 0xd8, 0x28, 0xc3, 0xee,
+	0x95, 0x0c, 0x77, 0xff, 0xd5, 0x60, 0xdb, 0xbe, 0x37, 0x24, 0x82, 0x86, 0x49, 0x1c, 0x29, 0x15,
+	0x51, 0x7c, 0x7a, 0x3d, 0x56, 0xb9, 0xdf, 0xfa, 0x8f, 0x50, 0x4f, 0x63, 0x44, 0x9e, 0x95, 0x00,
+	0x8b, 0xcf, 0xa7, 0x47, 0xab, 0xb6, 0xdb, 0x31, 0x11, 0x34, 0x4c, 0x02, 0x4a, 0x4f, 0x74, 0xcd,
+	0xfb, 0xe8, 0xb1, 0xca, 0xfd, 0x66, 0x54, 0xf7, 0x7f, 0x0d, 0x60, 0x7e, 0x2b, 0x88, 0xc8, 0xbd,
+	0xec, 0xac, 0xf4, 0x66, 0x39, 0xea, 0x5e, 0x77, 0x1d, 0x88, 0x3d, 0xea, 0xd0, 0x3a, 0xfa, 0x7c,
+	0x85, 0x45, 0x85, 0x0b, 0xef, 0x75, 0xd6, 0x40, 0xd8, 0x61, 0x22, 0xf7, 0xb5, 0xb3, 0xd2, 0xa7,
+	0xb5, 0x4e, 0x77, 0xc3, 0x7d, 0xed, 0x1d, 0x7f, 0x7d, 0x3b, 0x88, 0xd4, 0xaf, 0xe9, 0x39, 0x0d,
+	0xc5, 0x98, 0x61, 0x32, 0x11, 0x9c, 0xc7, 0x9c, 0x69, 0x22, 0x16, 0x0f, 0x07, 0x8c, 0xc7, 0x11,
+	0xbb, 0xe6, 0x4b, 0xe3, 0xc8, 0x2e, 0xcf, 0x1b, 0xfa, 0xd3, 0xe1, 0xc5, 0xe5, 0x00, 0x90, 0xd2,
+	0x53, 0xb4, 0x8f, 0x08, 0x00, 0x00,
+}
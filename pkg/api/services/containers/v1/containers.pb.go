@@ -10,15 +10,26 @@ It is generated from these files:
 
 It has these top-level messages:
 	StdinStreamRequest
+	TerminalSize
 	StdoutStreamResponse
+	ExecStart
+	ExecExit
 	SignalRequest
 	SignalResponse
 	Container
+	EnvVar
+	EnvVarSource
+	KeySelector
+	ContainerPort
+	SecurityContext
+	Capabilities
 	PipeSet
 	PipeFromStdout
 	PipeToStdin
 	Mount
+	Device
 	ContainerStatus
+	ContainerTerminated
 */
 package containers
 
@@ -44,6 +55,10 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type StdinStreamRequest struct {
 	Input []byte `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// The first Exec stream message defines the process to execute
+	Start *ExecStart `protobuf:"bytes,2,opt,name=start" json:"start,omitempty"`
+	// Client terminal size changed
+	Resize *TerminalSize `protobuf:"bytes,3,opt,name=resize" json:"resize,omitempty"`
 }
 
 func (m *StdinStreamRequest) Reset()                    { *m = StdinStreamRequest{} }
@@ -58,16 +73,56 @@ func (m *StdinStreamRequest) GetInput() []byte {
 	return nil
 }
 
+func (m *StdinStreamRequest) GetStart() *ExecStart {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *StdinStreamRequest) GetResize() *TerminalSize {
+	if m != nil {
+		return m.Resize
+	}
+	return nil
+}
+
+type TerminalSize struct {
+	Width  uint32 `protobuf:"varint,1,opt,name=width" json:"width,omitempty"`
+	Height uint32 `protobuf:"varint,2,opt,name=height" json:"height,omitempty"`
+}
+
+func (m *TerminalSize) Reset()                    { *m = TerminalSize{} }
+func (m *TerminalSize) String() string            { return proto.CompactTextString(m) }
+func (*TerminalSize) ProtoMessage()               {}
+func (*TerminalSize) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *TerminalSize) GetWidth() uint32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *TerminalSize) GetHeight() uint32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type StdoutStreamResponse struct {
 	Output []byte `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
 	// Is this stderr(=true) or stdout(=false)
 	Stderr bool `protobuf:"varint,2,opt,name=stderr" json:"stderr,omitempty"`
+	// The last Exec stream message carries the process exit status
+	Exit *ExecExit `protobuf:"bytes,3,opt,name=exit" json:"exit,omitempty"`
 }
 
 func (m *StdoutStreamResponse) Reset()                    { *m = StdoutStreamResponse{} }
 func (m *StdoutStreamResponse) String() string            { return proto.CompactTextString(m) }
 func (*StdoutStreamResponse) ProtoMessage()               {}
-func (*StdoutStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*StdoutStreamResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 func (m *StdoutStreamResponse) GetOutput() []byte {
 	if m != nil {
@@ -83,6 +138,105 @@ func (m *StdoutStreamResponse) GetStderr() bool {
 	return false
 }
 
+func (m *StdoutStreamResponse) GetExit() *ExecExit {
+	if m != nil {
+		return m.Exit
+	}
+	return nil
+}
+
+type ExecStart struct {
+	Namespace   string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	ContainerID string `protobuf:"bytes,2,opt,name=containerID" json:"containerID,omitempty"`
+	// Unique id for the exec process
+	ExecID string   `protobuf:"bytes,3,opt,name=execID" json:"execID,omitempty"`
+	Args   []string `protobuf:"bytes,4,rep,name=args" json:"args,omitempty"`
+	// Additional environment variables in format KEY=value
+	Env []string `protobuf:"bytes,5,rep,name=env" json:"env,omitempty"`
+	// User name or UID and optionally group, e.g. nobody or 1000:1000. Defaults to the container user
+	User string `protobuf:"bytes,6,opt,name=user" json:"user,omitempty"`
+	// Defaults to the container working directory
+	WorkingDir string `protobuf:"bytes,7,opt,name=workingDir" json:"workingDir,omitempty"`
+	Tty        bool   `protobuf:"varint,8,opt,name=tty" json:"tty,omitempty"`
+}
+
+func (m *ExecStart) Reset()                    { *m = ExecStart{} }
+func (m *ExecStart) String() string            { return proto.CompactTextString(m) }
+func (*ExecStart) ProtoMessage()               {}
+func (*ExecStart) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *ExecStart) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ExecStart) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *ExecStart) GetExecID() string {
+	if m != nil {
+		return m.ExecID
+	}
+	return ""
+}
+
+func (m *ExecStart) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *ExecStart) GetEnv() []string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
+}
+
+func (m *ExecStart) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *ExecStart) GetWorkingDir() string {
+	if m != nil {
+		return m.WorkingDir
+	}
+	return ""
+}
+
+func (m *ExecStart) GetTty() bool {
+	if m != nil {
+		return m.Tty
+	}
+	return false
+}
+
+type ExecExit struct {
+	ExitCode int32 `protobuf:"varint,1,opt,name=exitCode" json:"exitCode,omitempty"`
+}
+
+func (m *ExecExit) Reset()                    { *m = ExecExit{} }
+func (m *ExecExit) String() string            { return proto.CompactTextString(m) }
+func (*ExecExit) ProtoMessage()               {}
+func (*ExecExit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *ExecExit) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
 type SignalRequest struct {
 	Namespace   string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
 	ContainerID string `protobuf:"bytes,2,opt,name=containerID" json:"containerID,omitempty"`
@@ -92,7 +246,7 @@ type SignalRequest struct {
 func (m *SignalRequest) Reset()                    { *m = SignalRequest{} }
 func (m *SignalRequest) String() string            { return proto.CompactTextString(m) }
 func (*SignalRequest) ProtoMessage()               {}
-func (*SignalRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*SignalRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *SignalRequest) GetNamespace() string {
 	if m != nil {
@@ -121,23 +275,31 @@ type SignalResponse struct {
 func (m *SignalResponse) Reset()                    { *m = SignalResponse{} }
 func (m *SignalResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignalResponse) ProtoMessage()               {}
-func (*SignalResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*SignalResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
 
 type Container struct {
-	Name       string   `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	Image      string   `protobuf:"bytes,2,opt,name=image" json:"image,omitempty"`
-	Tty        bool     `protobuf:"varint,3,opt,name=tty" json:"tty,omitempty"`
-	WorkingDir string   `protobuf:"bytes,4,opt,name=workingDir" json:"workingDir,omitempty"`
-	Args       []string `protobuf:"bytes,5,rep,name=args" json:"args,omitempty"`
-	Env        []string `protobuf:"bytes,6,rep,name=env" json:"env,omitempty"`
-	Mounts     []*Mount `protobuf:"bytes,7,rep,name=mounts" json:"mounts,omitempty"`
-	Pipe       *PipeSet `protobuf:"bytes,8,opt,name=pipe" json:"pipe,omitempty"`
+	Name       string    `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Image      string    `protobuf:"bytes,2,opt,name=image" json:"image,omitempty"`
+	Tty        bool      `protobuf:"varint,3,opt,name=tty" json:"tty,omitempty"`
+	WorkingDir string    `protobuf:"bytes,4,opt,name=workingDir" json:"workingDir,omitempty"`
+	Args       []string  `protobuf:"bytes,5,rep,name=args" json:"args,omitempty"`
+	Env        []string  `protobuf:"bytes,6,rep,name=env" json:"env,omitempty"`
+	Mounts     []*Mount  `protobuf:"bytes,7,rep,name=mounts" json:"mounts,omitempty"`
+	Pipe       *PipeSet  `protobuf:"bytes,8,opt,name=pipe" json:"pipe,omitempty"`
+	Devices    []*Device `protobuf:"bytes,9,rep,name=devices" json:"devices,omitempty"`
+	// Hardware resources from the node what get allocated to the container. E.g. camera: 1
+	Resources       map[string]int32 `protobuf:"bytes,10,rep,name=resources" json:"resources,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	SecurityContext *SecurityContext `protobuf:"bytes,11,opt,name=securityContext" json:"securityContext,omitempty"`
+	// Ports to publish from the pod network to the host. Requires the pod to use bridge network
+	Ports []*ContainerPort `protobuf:"bytes,12,rep,name=ports" json:"ports,omitempty"`
+	// Environment variables what get the value from Secret or ConfigMap
+	EnvVars []*EnvVar `protobuf:"bytes,13,rep,name=envVars" json:"envVars,omitempty"`
 }
 
 func (m *Container) Reset()                    { *m = Container{} }
 func (m *Container) String() string            { return proto.CompactTextString(m) }
 func (*Container) ProtoMessage()               {}
-func (*Container) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*Container) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
 func (m *Container) GetName() string {
 	if m != nil {
@@ -195,6 +357,253 @@ func (m *Container) GetPipe() *PipeSet {
 	return nil
 }
 
+func (m *Container) GetDevices() []*Device {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *Container) GetResources() map[string]int32 {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *Container) GetSecurityContext() *SecurityContext {
+	if m != nil {
+		return m.SecurityContext
+	}
+	return nil
+}
+
+func (m *Container) GetPorts() []*ContainerPort {
+	if m != nil {
+		return m.Ports
+	}
+	return nil
+}
+
+func (m *Container) GetEnvVars() []*EnvVar {
+	if m != nil {
+		return m.EnvVars
+	}
+	return nil
+}
+
+type EnvVar struct {
+	Name      string        `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	ValueFrom *EnvVarSource `protobuf:"bytes,2,opt,name=valueFrom" json:"valueFrom,omitempty"`
+}
+
+func (m *EnvVar) Reset()                    { *m = EnvVar{} }
+func (m *EnvVar) String() string            { return proto.CompactTextString(m) }
+func (*EnvVar) ProtoMessage()               {}
+func (*EnvVar) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *EnvVar) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EnvVar) GetValueFrom() *EnvVarSource {
+	if m != nil {
+		return m.ValueFrom
+	}
+	return nil
+}
+
+// EnvVarSource defines the source of the value, only one can be defined
+type EnvVarSource struct {
+	SecretKeyRef    *KeySelector `protobuf:"bytes,1,opt,name=secretKeyRef" json:"secretKeyRef,omitempty"`
+	ConfigMapKeyRef *KeySelector `protobuf:"bytes,2,opt,name=configMapKeyRef" json:"configMapKeyRef,omitempty"`
+	// Path to the node or pod field, e.g. node.labels['location'] or metadata.name
+	FieldRef string `protobuf:"bytes,3,opt,name=fieldRef" json:"fieldRef,omitempty"`
+}
+
+func (m *EnvVarSource) Reset()                    { *m = EnvVarSource{} }
+func (m *EnvVarSource) String() string            { return proto.CompactTextString(m) }
+func (*EnvVarSource) ProtoMessage()               {}
+func (*EnvVarSource) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *EnvVarSource) GetSecretKeyRef() *KeySelector {
+	if m != nil {
+		return m.SecretKeyRef
+	}
+	return nil
+}
+
+func (m *EnvVarSource) GetConfigMapKeyRef() *KeySelector {
+	if m != nil {
+		return m.ConfigMapKeyRef
+	}
+	return nil
+}
+
+func (m *EnvVarSource) GetFieldRef() string {
+	if m != nil {
+		return m.FieldRef
+	}
+	return ""
+}
+
+type KeySelector struct {
+	// Name of the Secret or ConfigMap
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	Key  string `protobuf:"bytes,2,opt,name=key" json:"key,omitempty"`
+}
+
+func (m *KeySelector) Reset()                    { *m = KeySelector{} }
+func (m *KeySelector) String() string            { return proto.CompactTextString(m) }
+func (*KeySelector) ProtoMessage()               {}
+func (*KeySelector) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *KeySelector) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *KeySelector) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ContainerPort struct {
+	ContainerPort int32 `protobuf:"varint,1,opt,name=containerPort" json:"containerPort,omitempty"`
+	// Port in the host, defaults to containerPort
+	HostPort int32 `protobuf:"varint,2,opt,name=hostPort" json:"hostPort,omitempty"`
+	// Protocol of the port, tcp or udp. Defaults to tcp
+	Protocol string `protobuf:"bytes,3,opt,name=protocol" json:"protocol,omitempty"`
+}
+
+func (m *ContainerPort) Reset()                    { *m = ContainerPort{} }
+func (m *ContainerPort) String() string            { return proto.CompactTextString(m) }
+func (*ContainerPort) ProtoMessage()               {}
+func (*ContainerPort) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *ContainerPort) GetContainerPort() int32 {
+	if m != nil {
+		return m.ContainerPort
+	}
+	return 0
+}
+
+func (m *ContainerPort) GetHostPort() int32 {
+	if m != nil {
+		return m.HostPort
+	}
+	return 0
+}
+
+func (m *ContainerPort) GetProtocol() string {
+	if m != nil {
+		return m.Protocol
+	}
+	return ""
+}
+
+type SecurityContext struct {
+	// User name or UID to run the container process as
+	User string `protobuf:"bytes,1,opt,name=user" json:"user,omitempty"`
+	// Group name or GID to run the container process as. Requires user to be defined
+	Group string `protobuf:"bytes,2,opt,name=group" json:"group,omitempty"`
+	// Capabilities to add or drop from the default set
+	Capabilities *Capabilities `protobuf:"bytes,3,opt,name=capabilities" json:"capabilities,omitempty"`
+	// Run the container in privileged mode, i.e. with all capabilities and access to all devices
+	Privileged bool `protobuf:"varint,4,opt,name=privileged" json:"privileged,omitempty"`
+	// Mount the container root filesystem as read-only
+	ReadOnlyRootFilesystem bool `protobuf:"varint,5,opt,name=readOnlyRootFilesystem" json:"readOnlyRootFilesystem,omitempty"`
+	// Prevent the process gaining more privileges than its parent process
+	NoNewPrivileges bool `protobuf:"varint,6,opt,name=noNewPrivileges" json:"noNewPrivileges,omitempty"`
+	// Seccomp profile to apply. One of "unconfined", "runtime/default" or "localhost/<path-to-profile-json>"
+	SeccompProfile string `protobuf:"bytes,7,opt,name=seccompProfile" json:"seccompProfile,omitempty"`
+}
+
+func (m *SecurityContext) Reset()                    { *m = SecurityContext{} }
+func (m *SecurityContext) String() string            { return proto.CompactTextString(m) }
+func (*SecurityContext) ProtoMessage()               {}
+func (*SecurityContext) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *SecurityContext) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *SecurityContext) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *SecurityContext) GetCapabilities() *Capabilities {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *SecurityContext) GetPrivileged() bool {
+	if m != nil {
+		return m.Privileged
+	}
+	return false
+}
+
+func (m *SecurityContext) GetReadOnlyRootFilesystem() bool {
+	if m != nil {
+		return m.ReadOnlyRootFilesystem
+	}
+	return false
+}
+
+func (m *SecurityContext) GetNoNewPrivileges() bool {
+	if m != nil {
+		return m.NoNewPrivileges
+	}
+	return false
+}
+
+func (m *SecurityContext) GetSeccompProfile() string {
+	if m != nil {
+		return m.SeccompProfile
+	}
+	return ""
+}
+
+type Capabilities struct {
+	Add  []string `protobuf:"bytes,1,rep,name=add" json:"add,omitempty"`
+	Drop []string `protobuf:"bytes,2,rep,name=drop" json:"drop,omitempty"`
+}
+
+func (m *Capabilities) Reset()                    { *m = Capabilities{} }
+func (m *Capabilities) String() string            { return proto.CompactTextString(m) }
+func (*Capabilities) ProtoMessage()               {}
+func (*Capabilities) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *Capabilities) GetAdd() []string {
+	if m != nil {
+		return m.Add
+	}
+	return nil
+}
+
+func (m *Capabilities) GetDrop() []string {
+	if m != nil {
+		return m.Drop
+	}
+	return nil
+}
+
 type PipeSet struct {
 	Stdout *PipeFromStdout `protobuf:"bytes,1,opt,name=stdout" json:"stdout,omitempty"`
 }
@@ -202,7 +611,7 @@ type PipeSet struct {
 func (m *PipeSet) Reset()                    { *m = PipeSet{} }
 func (m *PipeSet) String() string            { return proto.CompactTextString(m) }
 func (*PipeSet) ProtoMessage()               {}
-func (*PipeSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+func (*PipeSet) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *PipeSet) GetStdout() *PipeFromStdout {
 	if m != nil {
@@ -218,7 +627,7 @@ type PipeFromStdout struct {
 func (m *PipeFromStdout) Reset()                    { *m = PipeFromStdout{} }
 func (m *PipeFromStdout) String() string            { return proto.CompactTextString(m) }
 func (*PipeFromStdout) ProtoMessage()               {}
-func (*PipeFromStdout) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+func (*PipeFromStdout) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *PipeFromStdout) GetStdin() *PipeToStdin {
 	if m != nil {
@@ -234,7 +643,7 @@ type PipeToStdin struct {
 func (m *PipeToStdin) Reset()                    { *m = PipeToStdin{} }
 func (m *PipeToStdin) String() string            { return proto.CompactTextString(m) }
 func (*PipeToStdin) ProtoMessage()               {}
-func (*PipeToStdin) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+func (*PipeToStdin) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *PipeToStdin) GetName() string {
 	if m != nil {
@@ -253,7 +662,7 @@ type Mount struct {
 func (m *Mount) Reset()                    { *m = Mount{} }
 func (m *Mount) String() string            { return proto.CompactTextString(m) }
 func (*Mount) ProtoMessage()               {}
-func (*Mount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*Mount) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *Mount) GetType() string {
 	if m != nil {
@@ -283,18 +692,63 @@ func (m *Mount) GetOptions() []string {
 	return nil
 }
 
+type Device struct {
+	// Path to the device in the host, can contain glob pattern. E.g. /dev/ttyUSB*
+	HostPath string `protobuf:"bytes,1,opt,name=hostPath" json:"hostPath,omitempty"`
+	// Path in the container, defaults to hostPath
+	ContainerPath string `protobuf:"bytes,2,opt,name=containerPath" json:"containerPath,omitempty"`
+	// Cgroup permissions to the device, any combination of r(ead), w(rite) and m(knod). Defaults to rwm
+	Permissions string `protobuf:"bytes,3,opt,name=permissions" json:"permissions,omitempty"`
+}
+
+func (m *Device) Reset()                    { *m = Device{} }
+func (m *Device) String() string            { return proto.CompactTextString(m) }
+func (*Device) ProtoMessage()               {}
+func (*Device) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *Device) GetHostPath() string {
+	if m != nil {
+		return m.HostPath
+	}
+	return ""
+}
+
+func (m *Device) GetContainerPath() string {
+	if m != nil {
+		return m.ContainerPath
+	}
+	return ""
+}
+
+func (m *Device) GetPermissions() string {
+	if m != nil {
+		return m.Permissions
+	}
+	return ""
+}
+
 type ContainerStatus struct {
 	ContainerID  string `protobuf:"bytes,1,opt,name=containerID" json:"containerID,omitempty"`
 	Name         string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
 	Image        string `protobuf:"bytes,3,opt,name=image" json:"image,omitempty"`
 	State        string `protobuf:"bytes,4,opt,name=state" json:"state,omitempty"`
 	RestartCount int32  `protobuf:"varint,5,opt,name=restartCount" json:"restartCount,omitempty"`
+	// Exit code of the process, only set when the container is stopped
+	ExitCode int32 `protobuf:"varint,6,opt,name=exitCode" json:"exitCode,omitempty"`
+	// Unix timestamp (in seconds) when the container process were last time started
+	StartedAt int64 `protobuf:"varint,7,opt,name=startedAt" json:"startedAt,omitempty"`
+	// Unix timestamp (in seconds) when the container process exited
+	FinishedAt int64 `protobuf:"varint,8,opt,name=finishedAt" json:"finishedAt,omitempty"`
+	// Short explanation why the container is in current state. E.g. "Completed" or "Error"
+	Reason string `protobuf:"bytes,9,opt,name=reason" json:"reason,omitempty"`
+	// Details of the previous container process termination
+	LastTerminationState *ContainerTerminated `protobuf:"bytes,10,opt,name=lastTerminationState" json:"lastTerminationState,omitempty"`
 }
 
 func (m *ContainerStatus) Reset()                    { *m = ContainerStatus{} }
 func (m *ContainerStatus) String() string            { return proto.CompactTextString(m) }
 func (*ContainerStatus) ProtoMessage()               {}
-func (*ContainerStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*ContainerStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 func (m *ContainerStatus) GetContainerID() string {
 	if m != nil {
@@ -331,17 +785,103 @@ func (m *ContainerStatus) GetRestartCount() int32 {
 	return 0
 }
 
+func (m *ContainerStatus) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *ContainerStatus) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *ContainerStatus) GetFinishedAt() int64 {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return 0
+}
+
+func (m *ContainerStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ContainerStatus) GetLastTerminationState() *ContainerTerminated {
+	if m != nil {
+		return m.LastTerminationState
+	}
+	return nil
+}
+
+type ContainerTerminated struct {
+	ExitCode   int32  `protobuf:"varint,1,opt,name=exitCode" json:"exitCode,omitempty"`
+	StartedAt  int64  `protobuf:"varint,2,opt,name=startedAt" json:"startedAt,omitempty"`
+	FinishedAt int64  `protobuf:"varint,3,opt,name=finishedAt" json:"finishedAt,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason" json:"reason,omitempty"`
+}
+
+func (m *ContainerTerminated) Reset()                    { *m = ContainerTerminated{} }
+func (m *ContainerTerminated) String() string            { return proto.CompactTextString(m) }
+func (*ContainerTerminated) ProtoMessage()               {}
+func (*ContainerTerminated) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *ContainerTerminated) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *ContainerTerminated) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *ContainerTerminated) GetFinishedAt() int64 {
+	if m != nil {
+		return m.FinishedAt
+	}
+	return 0
+}
+
+func (m *ContainerTerminated) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*StdinStreamRequest)(nil), "eliot.services.containers.v1.StdinStreamRequest")
+	proto.RegisterType((*TerminalSize)(nil), "eliot.services.containers.v1.TerminalSize")
 	proto.RegisterType((*StdoutStreamResponse)(nil), "eliot.services.containers.v1.StdoutStreamResponse")
+	proto.RegisterType((*ExecStart)(nil), "eliot.services.containers.v1.ExecStart")
+	proto.RegisterType((*ExecExit)(nil), "eliot.services.containers.v1.ExecExit")
 	proto.RegisterType((*SignalRequest)(nil), "eliot.services.containers.v1.SignalRequest")
 	proto.RegisterType((*SignalResponse)(nil), "eliot.services.containers.v1.SignalResponse")
 	proto.RegisterType((*Container)(nil), "eliot.services.containers.v1.Container")
+	proto.RegisterType((*EnvVar)(nil), "eliot.services.containers.v1.EnvVar")
+	proto.RegisterType((*EnvVarSource)(nil), "eliot.services.containers.v1.EnvVarSource")
+	proto.RegisterType((*KeySelector)(nil), "eliot.services.containers.v1.KeySelector")
+	proto.RegisterType((*ContainerPort)(nil), "eliot.services.containers.v1.ContainerPort")
+	proto.RegisterType((*SecurityContext)(nil), "eliot.services.containers.v1.SecurityContext")
+	proto.RegisterType((*Capabilities)(nil), "eliot.services.containers.v1.Capabilities")
 	proto.RegisterType((*PipeSet)(nil), "eliot.services.containers.v1.PipeSet")
 	proto.RegisterType((*PipeFromStdout)(nil), "eliot.services.containers.v1.PipeFromStdout")
 	proto.RegisterType((*PipeToStdin)(nil), "eliot.services.containers.v1.PipeToStdin")
 	proto.RegisterType((*Mount)(nil), "eliot.services.containers.v1.Mount")
+	proto.RegisterType((*Device)(nil), "eliot.services.containers.v1.Device")
 	proto.RegisterType((*ContainerStatus)(nil), "eliot.services.containers.v1.ContainerStatus")
+	proto.RegisterType((*ContainerTerminated)(nil), "eliot.services.containers.v1.ContainerTerminated")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -550,43 +1090,87 @@ var _Containers_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("services/containers/v1/containers.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 596 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xdd, 0x6e, 0xd4, 0x3c,
-	0x10, 0x55, 0xf6, 0xaf, 0xdd, 0xd9, 0x7e, 0xfd, 0x2a, 0xab, 0x42, 0x51, 0x55, 0xa1, 0x10, 0x84,
-	0x58, 0xa0, 0xda, 0xb4, 0xcb, 0x15, 0xea, 0x05, 0x82, 0xfe, 0x48, 0x5c, 0x20, 0xc0, 0xcb, 0x15,
-	0x77, 0x6e, 0xd6, 0x4a, 0xad, 0x36, 0xb6, 0xb1, 0x27, 0x0b, 0x7d, 0x00, 0x9e, 0x81, 0x47, 0xe3,
-	0x75, 0x90, 0x1d, 0x67, 0x9b, 0xd2, 0xaa, 0xcb, 0x15, 0x77, 0x73, 0x8e, 0x67, 0xce, 0x38, 0x9e,
-	0x93, 0x81, 0xa7, 0x96, 0x9b, 0x85, 0xc8, 0xb9, 0xcd, 0x72, 0x25, 0x91, 0x09, 0xc9, 0x8d, 0xcd,
-	0x16, 0x07, 0x2d, 0x34, 0xd1, 0x46, 0xa1, 0x22, 0xbb, 0xfc, 0x52, 0x28, 0x9c, 0x34, 0xe9, 0x93,
-	0x56, 0xc2, 0xe2, 0x20, 0x7d, 0x0e, 0x64, 0x86, 0x73, 0x21, 0x67, 0x68, 0x38, 0x2b, 0x29, 0xff,
-	0x5a, 0x71, 0x8b, 0x64, 0x1b, 0xfa, 0x42, 0xea, 0x0a, 0xe3, 0x28, 0x89, 0xc6, 0x1b, 0xb4, 0x06,
-	0xe9, 0x29, 0x6c, 0xcf, 0x70, 0xae, 0x2a, 0x6c, 0x92, 0xad, 0x56, 0xd2, 0x72, 0xf2, 0x00, 0x06,
-	0xaa, 0xc2, 0xeb, 0xf4, 0x80, 0x1c, 0x6f, 0x71, 0xce, 0x8d, 0x89, 0x3b, 0x49, 0x34, 0x5e, 0xa7,
-	0x01, 0xa5, 0x05, 0xfc, 0x37, 0x13, 0x85, 0x64, 0x97, 0x4d, 0xbb, 0x5d, 0x18, 0x4a, 0x56, 0x72,
-	0xab, 0x59, 0xce, 0xbd, 0xc6, 0x90, 0x5e, 0x13, 0x24, 0x81, 0xd1, 0xf2, 0xce, 0xef, 0x8e, 0xbd,
-	0xd6, 0x90, 0xb6, 0x29, 0xdf, 0xc8, 0x0b, 0xc6, 0xdd, 0x24, 0x1a, 0xf7, 0x69, 0x40, 0xe9, 0x16,
-	0x6c, 0x36, 0x8d, 0xea, 0xab, 0xa6, 0x3f, 0x3a, 0x30, 0x3c, 0x6a, 0x2a, 0x09, 0x81, 0x9e, 0x6b,
-	0x13, 0x5a, 0xfa, 0xd8, 0x7f, 0x7a, 0xc9, 0x0a, 0x1e, 0xfa, 0xd4, 0x80, 0x6c, 0x41, 0x17, 0xf1,
-	0xca, 0xcb, 0xaf, 0x53, 0x17, 0x92, 0x87, 0x00, 0xdf, 0x94, 0xb9, 0x10, 0xb2, 0x38, 0x16, 0x26,
-	0xee, 0xf9, 0xe4, 0x16, 0xe3, 0xb4, 0x99, 0x29, 0x6c, 0xdc, 0x4f, 0xba, 0x4e, 0xdb, 0xc5, 0x4e,
-	0x85, 0xcb, 0x45, 0x3c, 0xf0, 0x94, 0x0b, 0xc9, 0x21, 0x0c, 0x4a, 0x55, 0x49, 0xb4, 0xf1, 0x5a,
-	0xd2, 0x1d, 0x8f, 0xa6, 0x8f, 0x27, 0xf7, 0x4d, 0x6b, 0xf2, 0xde, 0xe5, 0xd2, 0x50, 0x42, 0x5e,
-	0x41, 0x4f, 0x0b, 0xcd, 0xe3, 0xf5, 0x24, 0x1a, 0x8f, 0xa6, 0x4f, 0xee, 0x2f, 0xfd, 0x28, 0x34,
-	0x9f, 0x71, 0xa4, 0xbe, 0x24, 0xfd, 0x00, 0x6b, 0x81, 0x20, 0xc7, 0x7e, 0x4a, 0x2a, 0x4c, 0x6f,
-	0x34, 0xdd, 0x5b, 0xad, 0x73, 0x6a, 0x54, 0x59, 0x3b, 0x81, 0x86, 0xda, 0xf4, 0x13, 0x6c, 0xde,
-	0x3c, 0x21, 0xaf, 0xa1, 0x6f, 0x9d, 0xb3, 0x82, 0xec, 0xb3, 0xd5, 0xb2, 0x9f, 0x95, 0xb7, 0x22,
-	0xad, 0xeb, 0xd2, 0x47, 0x30, 0x6a, 0xb1, 0x77, 0x0d, 0x2b, 0x55, 0xd0, 0xf7, 0x4f, 0xe2, 0x0e,
-	0xf1, 0x4a, 0x2f, 0x0f, 0x5d, 0xec, 0x5d, 0xa1, 0x2a, 0x93, 0x37, 0xa3, 0x0c, 0xc8, 0xf9, 0x69,
-	0xce, 0x2d, 0x0a, 0xc9, 0x50, 0x28, 0xe9, 0x67, 0x3a, 0xa4, 0x6d, 0x8a, 0xc4, 0xb0, 0xa6, 0xb4,
-	0x8b, 0x6c, 0xdc, 0xf3, 0xb3, 0x6a, 0x60, 0xfa, 0x33, 0x82, 0xff, 0x97, 0xfe, 0x99, 0x21, 0xc3,
-	0xca, 0xfe, 0xe9, 0xcf, 0xe8, 0xb6, 0x3f, 0x9b, 0xab, 0x77, 0xee, 0xf2, 0x59, 0xb7, 0xed, 0xb3,
-	0x6d, 0xf7, 0x68, 0x0c, 0x79, 0x30, 0x54, 0x0d, 0x48, 0x0a, 0x1b, 0x86, 0x5b, 0x64, 0x06, 0x8f,
-	0xdc, 0xd7, 0xc6, 0x7d, 0xef, 0xf2, 0x1b, 0xdc, 0xf4, 0x57, 0x07, 0x60, 0x79, 0x33, 0x4b, 0x0c,
-	0x0c, 0xde, 0x20, 0xb2, 0xfc, 0x9c, 0xec, 0xdf, 0xff, 0xf0, 0xb7, 0xff, 0xfe, 0x9d, 0xe9, 0xca,
-	0x8a, 0x5b, 0x3b, 0x60, 0x1c, 0xed, 0x47, 0x44, 0x43, 0xef, 0xe4, 0x3b, 0xcf, 0xff, 0x61, 0xc7,
-	0x1c, 0x06, 0xf5, 0x0f, 0x4e, 0x5e, 0xac, 0x50, 0x68, 0xef, 0x9b, 0x9d, 0xbd, 0xbf, 0x4b, 0xae,
-	0x1b, 0xbd, 0x3d, 0xf9, 0x72, 0x54, 0x08, 0x3c, 0xaf, 0xce, 0x26, 0xb9, 0x2a, 0x33, 0x6e, 0xa4,
-	0x62, 0x4c, 0xb3, 0xcc, 0x4b, 0x64, 0xfa, 0xa2, 0xc8, 0x98, 0x16, 0xd9, 0xdd, 0xdb, 0xf8, 0xf0,
-	0x1a, 0x9d, 0x0d, 0xfc, 0x3a, 0x7e, 0xf9, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x5f, 0x21, 0xd8, 0x14,
-	0xb9, 0x05, 0x00, 0x00,
+	// 1306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xdf, 0x6f, 0x1b, 0xc5,
+	0x13, 0xd7, 0xd9, 0xb1, 0x13, 0x8f, 0x9d, 0xa4, 0xda, 0x6f, 0x54, 0x9d, 0xa2, 0xea, 0xab, 0x70,
+	0x94, 0x36, 0x40, 0xb1, 0x5b, 0x17, 0x55, 0xd0, 0x16, 0x50, 0x9b, 0xa4, 0xa2, 0xaa, 0xda, 0x86,
+	0x75, 0x05, 0x12, 0x6f, 0xd7, 0xbb, 0x89, 0xbd, 0xea, 0xf9, 0xf6, 0xd8, 0x5d, 0xbb, 0x71, 0x1f,
+	0x79, 0xe1, 0xaf, 0xe1, 0xb1, 0xff, 0x06, 0x3c, 0xf2, 0x6f, 0xf0, 0x27, 0xa0, 0x9d, 0xfb, 0xe1,
+	0x3b, 0xc7, 0x38, 0x46, 0x42, 0xbc, 0xed, 0xcc, 0xce, 0x67, 0x7e, 0xed, 0xdc, 0xcc, 0x1c, 0xdc,
+	0xd4, 0xa8, 0xa6, 0x22, 0x40, 0xdd, 0x0b, 0x64, 0x6c, 0x7c, 0x11, 0xa3, 0xd2, 0xbd, 0xe9, 0x9d,
+	0x12, 0xd5, 0x4d, 0x94, 0x34, 0x92, 0x5d, 0xc3, 0x48, 0x48, 0xd3, 0xcd, 0xc5, 0xbb, 0x25, 0x81,
+	0xe9, 0x1d, 0xef, 0x57, 0x07, 0xd8, 0xc0, 0x84, 0x22, 0x1e, 0x18, 0x85, 0xfe, 0x98, 0xe3, 0x4f,
+	0x13, 0xd4, 0x86, 0xed, 0x41, 0x43, 0xc4, 0xc9, 0xc4, 0xb8, 0xce, 0x81, 0x73, 0xd8, 0xe1, 0x29,
+	0xc1, 0xbe, 0x82, 0x86, 0x36, 0xbe, 0x32, 0x6e, 0xed, 0xc0, 0x39, 0x6c, 0xf7, 0x6f, 0x76, 0x57,
+	0xa9, 0xee, 0x9e, 0x9c, 0x63, 0x30, 0xb0, 0xe2, 0x3c, 0x45, 0xb1, 0xc7, 0xd0, 0x54, 0xa8, 0xc5,
+	0x3b, 0x74, 0xeb, 0x84, 0xff, 0x64, 0x35, 0xfe, 0x15, 0xaa, 0xb1, 0x88, 0xfd, 0x68, 0x20, 0xde,
+	0x21, 0xcf, 0x90, 0xde, 0x43, 0xe8, 0x94, 0xf9, 0xd6, 0xd1, 0xb7, 0x22, 0x34, 0x23, 0x72, 0x74,
+	0x9b, 0xa7, 0x04, 0xbb, 0x0a, 0xcd, 0x11, 0x8a, 0xe1, 0x28, 0xf5, 0x74, 0x9b, 0x67, 0x94, 0xf7,
+	0xb3, 0x03, 0x7b, 0x03, 0x13, 0xca, 0x89, 0xc9, 0xc3, 0xd5, 0x89, 0x8c, 0x35, 0x5a, 0x80, 0x9c,
+	0x98, 0x79, 0xc0, 0x19, 0x65, 0xf9, 0xda, 0x84, 0xa8, 0x14, 0x29, 0xda, 0xe2, 0x19, 0xc5, 0xee,
+	0xc3, 0x06, 0x9e, 0x0b, 0x93, 0x05, 0x72, 0xe3, 0xf2, 0x44, 0x9c, 0x9c, 0x0b, 0xc3, 0x09, 0xe3,
+	0xfd, 0xee, 0x40, 0xab, 0xc8, 0x0d, 0xbb, 0x06, 0xad, 0xd8, 0x1f, 0xa3, 0x4e, 0xfc, 0x00, 0xc9,
+	0x78, 0x8b, 0xcf, 0x19, 0xec, 0x00, 0xda, 0x85, 0xae, 0xa7, 0xc7, 0xe4, 0x44, 0x8b, 0x97, 0x59,
+	0xd6, 0x43, 0x3c, 0xc7, 0xe0, 0xe9, 0x31, 0xf9, 0xd2, 0xe2, 0x19, 0xc5, 0x18, 0x6c, 0xf8, 0x6a,
+	0xa8, 0xdd, 0x8d, 0x83, 0xfa, 0x61, 0x8b, 0xd3, 0x99, 0x5d, 0x81, 0x3a, 0xc6, 0x53, 0xb7, 0x41,
+	0x2c, 0x7b, 0xb4, 0x52, 0x13, 0x8d, 0xca, 0x6d, 0x12, 0x96, 0xce, 0xec, 0xff, 0x00, 0x6f, 0xa5,
+	0x7a, 0x23, 0xe2, 0xe1, 0xb1, 0x50, 0xee, 0x26, 0xdd, 0x94, 0x38, 0x56, 0x8b, 0x31, 0x33, 0x77,
+	0x8b, 0x12, 0x62, 0x8f, 0xde, 0x0d, 0xd8, 0xca, 0x63, 0x64, 0xfb, 0xb0, 0x65, 0xa3, 0x3c, 0x92,
+	0x61, 0x1a, 0x4e, 0x83, 0x17, 0xb4, 0x37, 0x84, 0xed, 0x81, 0x18, 0xc6, 0x7e, 0x94, 0x97, 0xd9,
+	0xbf, 0x10, 0xbc, 0x26, 0x85, 0x14, 0x7c, 0x83, 0x67, 0x94, 0x77, 0x05, 0x76, 0x72, 0x43, 0xe9,
+	0x03, 0x7b, 0xef, 0x1b, 0xd0, 0x3a, 0xca, 0x91, 0x36, 0x6c, 0x6b, 0x26, 0x33, 0x49, 0x67, 0x2a,
+	0xf9, 0xb1, 0x3f, 0xc4, 0xcc, 0x4e, 0x4a, 0xe4, 0xc1, 0xd6, 0x8b, 0x60, 0x17, 0xd2, 0xb3, 0x71,
+	0x21, 0x3d, 0x79, 0xe2, 0x1b, 0x17, 0x13, 0xdf, 0x9c, 0x27, 0xfe, 0x01, 0x34, 0xc7, 0x72, 0x12,
+	0x1b, 0xed, 0x6e, 0x1e, 0xd4, 0x0f, 0xdb, 0xfd, 0x0f, 0x57, 0x97, 0xd0, 0x73, 0x2b, 0xcb, 0x33,
+	0x08, 0xfb, 0x12, 0x36, 0x12, 0x91, 0x20, 0x3d, 0x41, 0xbb, 0xff, 0xd1, 0x6a, 0xe8, 0xa9, 0x48,
+	0x70, 0x80, 0x86, 0x13, 0x84, 0x7d, 0x0d, 0x9b, 0x21, 0x92, 0x98, 0xdb, 0x22, 0xc3, 0xd7, 0x57,
+	0xa3, 0x8f, 0x49, 0x98, 0xe7, 0x20, 0xf6, 0x0a, 0x5a, 0x0a, 0xb5, 0x9c, 0x28, 0xab, 0x01, 0x48,
+	0xc3, 0xbd, 0xd5, 0x1a, 0x8a, 0xac, 0x77, 0x79, 0x0e, 0x3c, 0x89, 0x8d, 0x9a, 0xf1, 0xb9, 0x22,
+	0xf6, 0x03, 0xec, 0x6a, 0x0c, 0x26, 0x4a, 0x98, 0x99, 0x15, 0xc7, 0x73, 0xe3, 0xb6, 0x29, 0xb6,
+	0xcf, 0x56, 0xeb, 0x1e, 0x54, 0x41, 0x7c, 0x51, 0x0b, 0x7b, 0x04, 0x8d, 0x44, 0x2a, 0xa3, 0xdd,
+	0x0e, 0xb9, 0xfa, 0xe9, 0x9a, 0xae, 0x9e, 0x4a, 0xdb, 0xb5, 0x08, 0x69, 0x33, 0x86, 0xf1, 0xf4,
+	0x7b, 0x5f, 0x69, 0x77, 0x7b, 0x9d, 0x8c, 0x9d, 0x90, 0x30, 0xcf, 0x41, 0xfb, 0x0f, 0x61, 0xa7,
+	0x1a, 0xb8, 0xad, 0x86, 0x37, 0x38, 0xcb, 0x8a, 0xcf, 0x1e, 0x6d, 0xed, 0x4d, 0xfd, 0x68, 0x92,
+	0xd6, 0x5e, 0x83, 0xa7, 0xc4, 0xfd, 0xda, 0x17, 0x8e, 0x77, 0x06, 0xcd, 0x54, 0xe1, 0xd2, 0x9a,
+	0xfd, 0x16, 0x5a, 0x24, 0xfa, 0x44, 0xc9, 0xb1, 0x5b, 0x5b, 0xa7, 0xa9, 0xa6, 0xca, 0x06, 0xe4,
+	0x0e, 0x9f, 0x83, 0xbd, 0xdf, 0x1c, 0xe8, 0x94, 0xef, 0xd8, 0x73, 0xe8, 0x68, 0x0c, 0x14, 0x9a,
+	0x67, 0x38, 0xe3, 0x78, 0x46, 0x66, 0xdb, 0xfd, 0x8f, 0x57, 0x6b, 0x7f, 0x86, 0xb3, 0x01, 0x46,
+	0x18, 0x18, 0xa9, 0x78, 0x05, 0xce, 0x06, 0xb0, 0x1b, 0xc8, 0xf8, 0x4c, 0x0c, 0x9f, 0xfb, 0x49,
+	0xa6, 0xb1, 0xf6, 0x4f, 0x35, 0x2e, 0x6a, 0xb0, 0xbd, 0xe6, 0x4c, 0x60, 0x14, 0x5a, 0x6d, 0x69,
+	0xf7, 0x2b, 0x68, 0xef, 0x2e, 0xb4, 0x4b, 0xd8, 0xa5, 0xd9, 0xcb, 0xde, 0xa1, 0x56, 0xbc, 0x83,
+	0x37, 0x86, 0xed, 0x4a, 0x0d, 0xb0, 0xeb, 0xb0, 0x1d, 0x94, 0x19, 0x59, 0x4b, 0xab, 0x32, 0xad,
+	0x1f, 0x23, 0xa9, 0x0d, 0x09, 0xa4, 0x2f, 0x58, 0xd0, 0xf6, 0x8e, 0xe6, 0x70, 0x20, 0xa3, 0xdc,
+	0xc7, 0x9c, 0xf6, 0xde, 0xd7, 0x60, 0x77, 0xa1, 0x84, 0x8b, 0x8e, 0xec, 0x94, 0x3a, 0xf2, 0x1e,
+	0x34, 0x86, 0x4a, 0x4e, 0x92, 0xbc, 0x35, 0x11, 0xc1, 0x5e, 0x40, 0x27, 0xf0, 0x13, 0xff, 0xb5,
+	0x88, 0x84, 0x11, 0xa8, 0xd7, 0x1b, 0xaa, 0x47, 0x25, 0x04, 0xaf, 0xe0, 0x6d, 0x63, 0x4b, 0x94,
+	0x98, 0x8a, 0x08, 0x87, 0x18, 0x52, 0x63, 0xdb, 0xe2, 0x25, 0x0e, 0xbb, 0x07, 0x57, 0x15, 0xfa,
+	0xe1, 0xcb, 0x38, 0x9a, 0x71, 0x29, 0xcd, 0x13, 0x11, 0xa1, 0x9e, 0x69, 0x83, 0x63, 0xb7, 0x41,
+	0xb2, 0x7f, 0x73, 0xcb, 0x0e, 0x61, 0x37, 0x96, 0x2f, 0xf0, 0xed, 0x69, 0xae, 0x4a, 0xd3, 0xb8,
+	0xd9, 0xe2, 0x8b, 0x6c, 0x76, 0x03, 0x76, 0x34, 0x06, 0x81, 0x1c, 0x27, 0xa7, 0x4a, 0x9e, 0x89,
+	0x08, 0xb3, 0xe9, 0xb3, 0xc0, 0xf5, 0x3e, 0x87, 0x4e, 0x39, 0x0e, 0xfb, 0x90, 0x7e, 0x18, 0xba,
+	0x4e, 0xda, 0x5e, 0xfd, 0x30, 0xb4, 0x59, 0x0c, 0x95, 0xb4, 0x09, 0xa3, 0x26, 0x6c, 0xcf, 0xde,
+	0x4b, 0xd8, 0xcc, 0x7a, 0x21, 0x3b, 0xa6, 0xb1, 0x2e, 0xb3, 0x71, 0xdf, 0xee, 0xdf, 0xba, 0xbc,
+	0x85, 0xda, 0xaf, 0x24, 0x5d, 0x1d, 0x78, 0x86, 0xf5, 0xbe, 0x83, 0x9d, 0xea, 0x0d, 0xfb, 0xc6,
+	0x2e, 0x48, 0xa1, 0x88, 0xd7, 0xfb, 0x5a, 0x2c, 0xf8, 0x95, 0xa4, 0xed, 0x8b, 0xa7, 0x38, 0xef,
+	0x03, 0x68, 0x97, 0xb8, 0xcb, 0xaa, 0xd6, 0x93, 0xd0, 0xa0, 0x69, 0x60, 0x2f, 0xcd, 0x2c, 0x29,
+	0x2e, 0xed, 0x99, 0x06, 0x22, 0x7d, 0xbf, 0x59, 0xa9, 0x64, 0x94, 0x1d, 0xa5, 0x21, 0x6a, 0x23,
+	0x62, 0xdf, 0x08, 0x19, 0x67, 0x85, 0x58, 0x66, 0x31, 0x17, 0x36, 0x65, 0x62, 0x4f, 0xf9, 0xca,
+	0x90, 0x93, 0x5e, 0x04, 0xcd, 0x74, 0x0a, 0x14, 0x75, 0xee, 0x67, 0xfb, 0x56, 0x8b, 0x17, 0x74,
+	0xf5, 0x4b, 0xb1, 0x02, 0xa9, 0x03, 0x55, 0xa6, 0xf5, 0x23, 0xb1, 0xeb, 0x9b, 0xd6, 0x64, 0x29,
+	0xf3, 0xa3, 0xc4, 0xf2, 0xfe, 0xac, 0xc1, 0x6e, 0xf1, 0x0d, 0x0e, 0x8c, 0x6f, 0x26, 0x7a, 0x71,
+	0x11, 0x70, 0x2e, 0x2e, 0x02, 0x79, 0xa2, 0x6a, 0xcb, 0x06, 0x7a, 0xbd, 0x3c, 0xd0, 0xf7, 0x68,
+	0x87, 0x35, 0x98, 0x4d, 0xee, 0x94, 0x60, 0x1e, 0x74, 0x14, 0xd2, 0x96, 0x7a, 0x64, 0x73, 0x4b,
+	0x15, 0xdd, 0xe0, 0x15, 0x5e, 0x65, 0xb3, 0x69, 0x56, 0x37, 0x1b, 0xbb, 0xc8, 0x90, 0x24, 0x86,
+	0x8f, 0x0c, 0x15, 0x6d, 0x9d, 0xcf, 0x19, 0xf6, 0xcb, 0x3a, 0x13, 0xb1, 0xd0, 0x23, 0xba, 0xde,
+	0xa2, 0xeb, 0x12, 0xc7, 0xbe, 0x9a, 0x42, 0x5f, 0xcb, 0xd8, 0x6d, 0xa5, 0xaf, 0x96, 0x52, 0x0c,
+	0x61, 0x2f, 0xf2, 0xb5, 0xc9, 0x16, 0x5e, 0xfb, 0x1a, 0x03, 0x72, 0x1d, 0xa8, 0xba, 0xee, 0xac,
+	0x39, 0xcc, 0x72, 0x38, 0x86, 0x7c, 0xa9, 0x3a, 0xef, 0x17, 0x07, 0xfe, 0xb7, 0x44, 0x7a, 0xd5,
+	0x2a, 0x57, 0x0d, 0xb8, 0xb6, 0x3a, 0xe0, 0xfa, 0x8a, 0x80, 0x37, 0xca, 0x01, 0xf7, 0xff, 0xa8,
+	0x01, 0x14, 0x9e, 0x68, 0xa6, 0xa0, 0xf9, 0xc8, 0x18, 0x3f, 0x18, 0xb1, 0xdb, 0x97, 0xec, 0x01,
+	0x17, 0xfe, 0x60, 0xf6, 0xfb, 0x97, 0x22, 0x2e, 0xfc, 0x05, 0x1c, 0x3a, 0xb7, 0x1d, 0x96, 0xc0,
+	0x86, 0xdd, 0x65, 0xff, 0x43, 0x8b, 0x01, 0x34, 0xd3, 0x65, 0x95, 0x5d, 0xb2, 0x9e, 0x54, 0x76,
+	0xe7, 0xfd, 0x5b, 0xeb, 0x09, 0xa7, 0x86, 0x1e, 0x9f, 0xfc, 0x78, 0x34, 0x14, 0x66, 0x34, 0x79,
+	0xdd, 0x0d, 0xe4, 0xb8, 0x87, 0x2a, 0x96, 0xbe, 0x9f, 0xf8, 0x3d, 0x52, 0xd1, 0x4b, 0xde, 0x0c,
+	0x7b, 0x7e, 0x22, 0x7a, 0xcb, 0x7f, 0x29, 0x1f, 0xcc, 0xa9, 0xd7, 0x4d, 0x9a, 0x5d, 0x77, 0xff,
+	0x1a, 0x00, 0x54, 0x98, 0xf1, 0x77, 0x7e, 0x0e, 0x00, 0x00,
 }
@@ -0,0 +1,50 @@
+package containers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// UnmarshalJSON allows defining the `env` list entries either in "KEY=value" format
+// or as object with name and value or valueFrom source. E.g. {name: TOKEN, valueFrom: {secretKeyRef: {name: api, key: token}}}
+func (c *Container) UnmarshalJSON(data []byte) error {
+	type container Container
+	target := struct {
+		*container
+		Env []json.RawMessage `json:"env,omitempty"`
+	}{container: (*container)(c)}
+
+	if err := json.Unmarshal(data, &target); err != nil {
+		return err
+	}
+
+	c.Env = nil
+	for _, raw := range target.Env {
+		var value string
+		if err := json.Unmarshal(raw, &value); err == nil {
+			c.Env = append(c.Env, value)
+			continue
+		}
+
+		entry := struct {
+			Name      string        `json:"name"`
+			Value     string        `json:"value"`
+			ValueFrom *EnvVarSource `json:"valueFrom"`
+		}{}
+		if err := json.Unmarshal(raw, &entry); err != nil {
+			return errors.Wrapf(err, "Invalid env entry %s", string(raw))
+		}
+
+		if entry.ValueFrom == nil {
+			c.Env = append(c.Env, fmt.Sprintf("%s=%s", entry.Name, entry.Value))
+			continue
+		}
+		c.EnvVars = append(c.EnvVars, &EnvVar{
+			Name:      entry.Name,
+			ValueFrom: entry.ValueFrom,
+		})
+	}
+	return nil
+}
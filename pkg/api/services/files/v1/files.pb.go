@@ -0,0 +1,345 @@
+// Code generated by protoc-gen-go.
+// source: services/files/v1/files.proto
+// DO NOT EDIT!
+
+/*
+Package files is a generated protocol buffer package.
+
+It is generated from these files:
+	services/files/v1/files.proto
+
+It has these top-level messages:
+	CopyFromRequest
+	FileData
+	CopyToRequest
+	CopyToResponse
+*/
+package files
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type CopyFromRequest struct {
+	Namespace   string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	ContainerID string `protobuf:"bytes,2,opt,name=containerID" json:"containerID,omitempty"`
+	// Path to the file or directory in the container
+	Path string `protobuf:"bytes,3,opt,name=path" json:"path,omitempty"`
+}
+
+func (m *CopyFromRequest) Reset()                    { *m = CopyFromRequest{} }
+func (m *CopyFromRequest) String() string            { return proto.CompactTextString(m) }
+func (*CopyFromRequest) ProtoMessage()               {}
+func (*CopyFromRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *CopyFromRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *CopyFromRequest) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *CopyFromRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type FileData struct {
+	// Approximate size of the whole tar stream in bytes, set in the first message
+	Size int64 `protobuf:"varint,1,opt,name=size" json:"size,omitempty"`
+	// Chunk of the tar stream
+	Data []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *FileData) Reset()                    { *m = FileData{} }
+func (m *FileData) String() string            { return proto.CompactTextString(m) }
+func (*FileData) ProtoMessage()               {}
+func (*FileData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *FileData) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *FileData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type CopyToRequest struct {
+	// Target container and path, required in the first message.
+	// If the path is existing directory, the files get extracted into it, otherwise as the path.
+	Namespace   string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	ContainerID string `protobuf:"bytes,2,opt,name=containerID" json:"containerID,omitempty"`
+	Path        string `protobuf:"bytes,3,opt,name=path" json:"path,omitempty"`
+	// Chunk of the tar stream
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *CopyToRequest) Reset()                    { *m = CopyToRequest{} }
+func (m *CopyToRequest) String() string            { return proto.CompactTextString(m) }
+func (*CopyToRequest) ProtoMessage()               {}
+func (*CopyToRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *CopyToRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *CopyToRequest) GetContainerID() string {
+	if m != nil {
+		return m.ContainerID
+	}
+	return ""
+}
+
+func (m *CopyToRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CopyToRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type CopyToResponse struct {
+}
+
+func (m *CopyToResponse) Reset()                    { *m = CopyToResponse{} }
+func (m *CopyToResponse) String() string            { return proto.CompactTextString(m) }
+func (*CopyToResponse) ProtoMessage()               {}
+func (*CopyToResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func init() {
+	proto.RegisterType((*CopyFromRequest)(nil), "eliot.services.files.v1.CopyFromRequest")
+	proto.RegisterType((*FileData)(nil), "eliot.services.files.v1.FileData")
+	proto.RegisterType((*CopyToRequest)(nil), "eliot.services.files.v1.CopyToRequest")
+	proto.RegisterType((*CopyToResponse)(nil), "eliot.services.files.v1.CopyToResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Files service
+
+type FilesClient interface {
+	CopyFrom(ctx context.Context, in *CopyFromRequest, opts ...grpc.CallOption) (Files_CopyFromClient, error)
+	CopyTo(ctx context.Context, opts ...grpc.CallOption) (Files_CopyToClient, error)
+}
+
+type filesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewFilesClient(cc *grpc.ClientConn) FilesClient {
+	return &filesClient{cc}
+}
+
+func (c *filesClient) CopyFrom(ctx context.Context, in *CopyFromRequest, opts ...grpc.CallOption) (Files_CopyFromClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Files_serviceDesc.Streams[0], c.cc, "/eliot.services.files.v1.Files/CopyFrom", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &filesCopyFromClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Files_CopyFromClient interface {
+	Recv() (*FileData, error)
+	grpc.ClientStream
+}
+
+type filesCopyFromClient struct {
+	grpc.ClientStream
+}
+
+func (x *filesCopyFromClient) Recv() (*FileData, error) {
+	m := new(FileData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *filesClient) CopyTo(ctx context.Context, opts ...grpc.CallOption) (Files_CopyToClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Files_serviceDesc.Streams[1], c.cc, "/eliot.services.files.v1.Files/CopyTo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &filesCopyToClient{stream}
+	return x, nil
+}
+
+type Files_CopyToClient interface {
+	Send(*CopyToRequest) error
+	CloseAndRecv() (*CopyToResponse, error)
+	grpc.ClientStream
+}
+
+type filesCopyToClient struct {
+	grpc.ClientStream
+}
+
+func (x *filesCopyToClient) Send(m *CopyToRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *filesCopyToClient) CloseAndRecv() (*CopyToResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(CopyToResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Files service
+
+type FilesServer interface {
+	CopyFrom(*CopyFromRequest, Files_CopyFromServer) error
+	CopyTo(Files_CopyToServer) error
+}
+
+func RegisterFilesServer(s *grpc.Server, srv FilesServer) {
+	s.RegisterService(&_Files_serviceDesc, srv)
+}
+
+func _Files_CopyFrom_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyFromRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FilesServer).CopyFrom(m, &filesCopyFromServer{stream})
+}
+
+type Files_CopyFromServer interface {
+	Send(*FileData) error
+	grpc.ServerStream
+}
+
+type filesCopyFromServer struct {
+	grpc.ServerStream
+}
+
+func (x *filesCopyFromServer) Send(m *FileData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Files_CopyTo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FilesServer).CopyTo(&filesCopyToServer{stream})
+}
+
+type Files_CopyToServer interface {
+	SendAndClose(*CopyToResponse) error
+	Recv() (*CopyToRequest, error)
+	grpc.ServerStream
+}
+
+type filesCopyToServer struct {
+	grpc.ServerStream
+}
+
+func (x *filesCopyToServer) SendAndClose(m *CopyToResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *filesCopyToServer) Recv() (*CopyToRequest, error) {
+	m := new(CopyToRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Files_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eliot.services.files.v1.Files",
+	HandlerType: (*FilesServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CopyFrom",
+			Handler:       _Files_CopyFrom_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CopyTo",
+			Handler:       _Files_CopyTo_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "services/files/v1/files.proto",
+}
+
+func init() { proto.RegisterFile("services/files/v1/files.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0x3f, 0x4f, 0xfb, 0x30,
+	0x14, 0x54, 0x7e, 0xed, 0xaf, 0x6a, 0x1f, 0x7f, 0xe5, 0x85, 0xa8, 0x02, 0xa9, 0x64, 0x80, 0x4c,
+	0x36, 0x2d, 0x13, 0xea, 0x06, 0x55, 0x25, 0xd6, 0x88, 0x05, 0x98, 0x5e, 0xc3, 0xa3, 0xb5, 0x48,
+	0x62, 0x13, 0xbb, 0x41, 0xf0, 0xe5, 0xf8, 0x6a, 0x28, 0xb6, 0x52, 0x2a, 0x50, 0x61, 0x62, 0x3b,
+	0xbd, 0x3b, 0xdf, 0x9d, 0x4e, 0x86, 0x23, 0x43, 0x65, 0x25, 0x53, 0x32, 0xe2, 0x51, 0x66, 0x64,
+	0x44, 0x35, 0xf4, 0x80, 0xeb, 0x52, 0x59, 0xc5, 0x0e, 0x28, 0x93, 0xca, 0xf2, 0x46, 0xc4, 0x3d,
+	0x57, 0x0d, 0x23, 0x82, 0xbd, 0x2b, 0xa5, 0x5f, 0xa7, 0xa5, 0xca, 0x13, 0x7a, 0x5e, 0x92, 0xb1,
+	0xec, 0x10, 0x7a, 0x05, 0xe6, 0x64, 0x34, 0xa6, 0x14, 0x06, 0x83, 0x20, 0xee, 0x25, 0x9f, 0x07,
+	0x36, 0x80, 0xad, 0x54, 0x15, 0x16, 0x65, 0x41, 0xe5, 0xf5, 0x24, 0xfc, 0xe7, 0xf8, 0xf5, 0x13,
+	0x63, 0xd0, 0xd6, 0x68, 0x17, 0x61, 0xcb, 0x51, 0x0e, 0x47, 0x23, 0xe8, 0x4e, 0x65, 0x46, 0x13,
+	0xb4, 0x58, 0xf3, 0x46, 0xbe, 0x79, 0xeb, 0x56, 0xe2, 0x70, 0x7d, 0x7b, 0x40, 0x8b, 0xce, 0x6e,
+	0x3b, 0x71, 0x38, 0x7a, 0x81, 0x9d, 0xba, 0xda, 0x8d, 0xfa, 0xc3, 0x62, 0xab, 0xe0, 0xf6, 0x5a,
+	0xf0, 0x3e, 0xec, 0x36, 0xc1, 0x46, 0xab, 0xc2, 0xd0, 0xe8, 0x3d, 0x80, 0xff, 0x75, 0x7f, 0xc3,
+	0x6e, 0xa1, 0xdb, 0xec, 0xc5, 0x62, 0xbe, 0x61, 0x55, 0xfe, 0x65, 0xd2, 0xfe, 0xf1, 0x46, 0x65,
+	0xb3, 0xca, 0x59, 0xc0, 0xee, 0xa1, 0xe3, 0x63, 0xd9, 0xc9, 0x8f, 0xc6, 0xab, 0x41, 0xfa, 0xa7,
+	0xbf, 0xea, 0x7c, 0xff, 0x38, 0xb8, 0x1c, 0xdf, 0x5d, 0xcc, 0xa5, 0x5d, 0x2c, 0x67, 0x3c, 0x55,
+	0xb9, 0xa0, 0xb2, 0x50, 0x88, 0x1a, 0x85, 0x7b, 0x2f, 0xf4, 0xd3, 0x5c, 0xa0, 0x96, 0xe2, 0xdb,
+	0x1f, 0x1a, 0x3b, 0x30, 0xeb, 0xb8, 0x4f, 0x74, 0xfe, 0x31, 0x00, 0xf9, 0x5d, 0x80, 0x6d, 0x65,
+	0x02, 0x00, 0x00,
+}
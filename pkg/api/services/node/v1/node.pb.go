@@ -11,9 +11,23 @@ It is generated from these files:
 It has these top-level messages:
 	InfoRequest
 	InfoResponse
+	SetLabelsRequest
+	RemoveLabelsRequest
+	LabelsResponse
+	CordonRequest
+	CordonResponse
+	DrainRequest
+	DrainResponse
+	UncordonRequest
+	UncordonResponse
+	RebootRequest
+	ShutdownRequest
+	PowerResponse
 	Info
 	Label
 	Filesystem
+	DeviceResource
+	NodeDevice
 */
 package node
 
@@ -61,6 +75,154 @@ func (m *InfoResponse) GetInfo() *Info {
 	return nil
 }
 
+type SetLabelsRequest struct {
+	Labels []*Label `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty"`
+}
+
+func (m *SetLabelsRequest) Reset()                    { *m = SetLabelsRequest{} }
+func (m *SetLabelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetLabelsRequest) ProtoMessage()               {}
+func (*SetLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *SetLabelsRequest) GetLabels() []*Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type RemoveLabelsRequest struct {
+	Keys []string `protobuf:"bytes,1,rep,name=keys" json:"keys,omitempty"`
+}
+
+func (m *RemoveLabelsRequest) Reset()                    { *m = RemoveLabelsRequest{} }
+func (m *RemoveLabelsRequest) String() string            { return proto.CompactTextString(m) }
+func (*RemoveLabelsRequest) ProtoMessage()               {}
+func (*RemoveLabelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *RemoveLabelsRequest) GetKeys() []string {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+// LabelsResponse contains all node labels after the change
+type LabelsResponse struct {
+	Labels []*Label `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty"`
+}
+
+func (m *LabelsResponse) Reset()                    { *m = LabelsResponse{} }
+func (m *LabelsResponse) String() string            { return proto.CompactTextString(m) }
+func (*LabelsResponse) ProtoMessage()               {}
+func (*LabelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *LabelsResponse) GetLabels() []*Label {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+type CordonRequest struct {
+}
+
+func (m *CordonRequest) Reset()                    { *m = CordonRequest{} }
+func (m *CordonRequest) String() string            { return proto.CompactTextString(m) }
+func (*CordonRequest) ProtoMessage()               {}
+func (*CordonRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+type CordonResponse struct {
+}
+
+func (m *CordonResponse) Reset()                    { *m = CordonResponse{} }
+func (m *CordonResponse) String() string            { return proto.CompactTextString(m) }
+func (*CordonResponse) ProtoMessage()               {}
+func (*CordonResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+type DrainRequest struct {
+}
+
+func (m *DrainRequest) Reset()                    { *m = DrainRequest{} }
+func (m *DrainRequest) String() string            { return proto.CompactTextString(m) }
+func (*DrainRequest) ProtoMessage()               {}
+func (*DrainRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+type DrainResponse struct {
+	// Drained pods in format namespace/name
+	Pods []string `protobuf:"bytes,1,rep,name=pods" json:"pods,omitempty"`
+}
+
+func (m *DrainResponse) Reset()                    { *m = DrainResponse{} }
+func (m *DrainResponse) String() string            { return proto.CompactTextString(m) }
+func (*DrainResponse) ProtoMessage()               {}
+func (*DrainResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *DrainResponse) GetPods() []string {
+	if m != nil {
+		return m.Pods
+	}
+	return nil
+}
+
+type UncordonRequest struct {
+}
+
+func (m *UncordonRequest) Reset()                    { *m = UncordonRequest{} }
+func (m *UncordonRequest) String() string            { return proto.CompactTextString(m) }
+func (*UncordonRequest) ProtoMessage()               {}
+func (*UncordonRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+type UncordonResponse struct {
+	// Restarted pods in format namespace/name
+	Pods []string `protobuf:"bytes,1,rep,name=pods" json:"pods,omitempty"`
+}
+
+func (m *UncordonResponse) Reset()                    { *m = UncordonResponse{} }
+func (m *UncordonResponse) String() string            { return proto.CompactTextString(m) }
+func (*UncordonResponse) ProtoMessage()               {}
+func (*UncordonResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *UncordonResponse) GetPods() []string {
+	if m != nil {
+		return m.Pods
+	}
+	return nil
+}
+
+type RebootRequest struct {
+}
+
+func (m *RebootRequest) Reset()                    { *m = RebootRequest{} }
+func (m *RebootRequest) String() string            { return proto.CompactTextString(m) }
+func (*RebootRequest) ProtoMessage()               {}
+func (*RebootRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+type ShutdownRequest struct {
+}
+
+func (m *ShutdownRequest) Reset()                    { *m = ShutdownRequest{} }
+func (m *ShutdownRequest) String() string            { return proto.CompactTextString(m) }
+func (*ShutdownRequest) ProtoMessage()               {}
+func (*ShutdownRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+type PowerResponse struct {
+	// Stopped pods in format namespace/name
+	Pods []string `protobuf:"bytes,1,rep,name=pods" json:"pods,omitempty"`
+}
+
+func (m *PowerResponse) Reset()                    { *m = PowerResponse{} }
+func (m *PowerResponse) String() string            { return proto.CompactTextString(m) }
+func (*PowerResponse) ProtoMessage()               {}
+func (*PowerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *PowerResponse) GetPods() []string {
+	if m != nil {
+		return m.Pods
+	}
+	return nil
+}
+
 type Info struct {
 	// Labels for the node
 	Labels []*Label `protobuf:"bytes,1,rep,name=labels" json:"labels,omitempty"`
@@ -88,12 +250,16 @@ type Info struct {
 	Filesystems []*Filesystem `protobuf:"bytes,11,rep,name=filesystems" json:"filesystems,omitempty"`
 	// Seconds since node boot up
 	Uptime uint64 `protobuf:"varint,12,opt,name=uptime" json:"uptime,omitempty"`
+	// Hardware devices in the node what pods can request, grouped by resource name
+	Devices []*DeviceResource `protobuf:"bytes,13,rep,name=devices" json:"devices,omitempty"`
+	// True if the node is cordoned and doesn't accept new pods
+	Unschedulable bool `protobuf:"varint,14,opt,name=unschedulable" json:"unschedulable,omitempty"`
 }
 
 func (m *Info) Reset()                    { *m = Info{} }
 func (m *Info) String() string            { return proto.CompactTextString(m) }
 func (*Info) ProtoMessage()               {}
-func (*Info) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*Info) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
 
 func (m *Info) GetLabels() []*Label {
 	if m != nil {
@@ -179,6 +345,20 @@ func (m *Info) GetUptime() uint64 {
 	return 0
 }
 
+func (m *Info) GetDevices() []*DeviceResource {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *Info) GetUnschedulable() bool {
+	if m != nil {
+		return m.Unschedulable
+	}
+	return false
+}
+
 type Label struct {
 	Key   string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
 	Value string `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
@@ -187,7 +367,7 @@ type Label struct {
 func (m *Label) Reset()                    { *m = Label{} }
 func (m *Label) String() string            { return proto.CompactTextString(m) }
 func (*Label) ProtoMessage()               {}
-func (*Label) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*Label) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 func (m *Label) GetKey() string {
 	if m != nil {
@@ -221,7 +401,7 @@ type Filesystem struct {
 func (m *Filesystem) Reset()                    { *m = Filesystem{} }
 func (m *Filesystem) String() string            { return proto.CompactTextString(m) }
 func (*Filesystem) ProtoMessage()               {}
-func (*Filesystem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*Filesystem) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *Filesystem) GetFilesystem() string {
 	if m != nil {
@@ -265,12 +445,87 @@ func (m *Filesystem) GetAvailable() uint64 {
 	return 0
 }
 
+type DeviceResource struct {
+	// Name of the resource what pods use to request the devices. E.g. camera, serial, i2c
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// If true, single device can be allocated only to one pod at the time
+	Exclusive bool `protobuf:"varint,2,opt,name=exclusive" json:"exclusive,omitempty"`
+	// Discovered devices
+	Devices []*NodeDevice `protobuf:"bytes,3,rep,name=devices" json:"devices,omitempty"`
+}
+
+func (m *DeviceResource) Reset()                    { *m = DeviceResource{} }
+func (m *DeviceResource) String() string            { return proto.CompactTextString(m) }
+func (*DeviceResource) ProtoMessage()               {}
+func (*DeviceResource) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *DeviceResource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeviceResource) GetExclusive() bool {
+	if m != nil {
+		return m.Exclusive
+	}
+	return false
+}
+
+func (m *DeviceResource) GetDevices() []*NodeDevice {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+type NodeDevice struct {
+	// Path to the device. E.g. /dev/video0
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	// Namespace/name of the pod what have the device allocated
+	AllocatedTo string `protobuf:"bytes,2,opt,name=allocatedTo" json:"allocatedTo,omitempty"`
+}
+
+func (m *NodeDevice) Reset()                    { *m = NodeDevice{} }
+func (m *NodeDevice) String() string            { return proto.CompactTextString(m) }
+func (*NodeDevice) ProtoMessage()               {}
+func (*NodeDevice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *NodeDevice) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *NodeDevice) GetAllocatedTo() string {
+	if m != nil {
+		return m.AllocatedTo
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InfoRequest)(nil), "eliot.services.containers.v1.InfoRequest")
 	proto.RegisterType((*InfoResponse)(nil), "eliot.services.containers.v1.InfoResponse")
+	proto.RegisterType((*SetLabelsRequest)(nil), "eliot.services.containers.v1.SetLabelsRequest")
+	proto.RegisterType((*RemoveLabelsRequest)(nil), "eliot.services.containers.v1.RemoveLabelsRequest")
+	proto.RegisterType((*LabelsResponse)(nil), "eliot.services.containers.v1.LabelsResponse")
+	proto.RegisterType((*CordonRequest)(nil), "eliot.services.containers.v1.CordonRequest")
+	proto.RegisterType((*CordonResponse)(nil), "eliot.services.containers.v1.CordonResponse")
+	proto.RegisterType((*DrainRequest)(nil), "eliot.services.containers.v1.DrainRequest")
+	proto.RegisterType((*DrainResponse)(nil), "eliot.services.containers.v1.DrainResponse")
+	proto.RegisterType((*UncordonRequest)(nil), "eliot.services.containers.v1.UncordonRequest")
+	proto.RegisterType((*UncordonResponse)(nil), "eliot.services.containers.v1.UncordonResponse")
+	proto.RegisterType((*RebootRequest)(nil), "eliot.services.containers.v1.RebootRequest")
+	proto.RegisterType((*ShutdownRequest)(nil), "eliot.services.containers.v1.ShutdownRequest")
+	proto.RegisterType((*PowerResponse)(nil), "eliot.services.containers.v1.PowerResponse")
 	proto.RegisterType((*Info)(nil), "eliot.services.containers.v1.Info")
 	proto.RegisterType((*Label)(nil), "eliot.services.containers.v1.Label")
 	proto.RegisterType((*Filesystem)(nil), "eliot.services.containers.v1.Filesystem")
+	proto.RegisterType((*DeviceResource)(nil), "eliot.services.containers.v1.DeviceResource")
+	proto.RegisterType((*NodeDevice)(nil), "eliot.services.containers.v1.NodeDevice")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -285,6 +540,20 @@ const _ = grpc.SupportPackageIsVersion4
 
 type NodeClient interface {
 	Info(ctx context.Context, in *InfoRequest, opts ...grpc.CallOption) (*InfoResponse, error)
+	// SetLabels adds or updates the node labels, the labels get persisted in the node
+	SetLabels(ctx context.Context, in *SetLabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error)
+	// RemoveLabels removes the node labels by the keys
+	RemoveLabels(ctx context.Context, in *RemoveLabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error)
+	// Cordon marks the node unschedulable so it doesn't accept new pods
+	Cordon(ctx context.Context, in *CordonRequest, opts ...grpc.CallOption) (*CordonResponse, error)
+	// Drain cordons the node and stops all pods gracefully
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+	// Uncordon restarts the drained pods and marks the node schedulable
+	Uncordon(ctx context.Context, in *UncordonRequest, opts ...grpc.CallOption) (*UncordonResponse, error)
+	// Reboot stops all pods gracefully and reboots the host
+	Reboot(ctx context.Context, in *RebootRequest, opts ...grpc.CallOption) (*PowerResponse, error)
+	// Shutdown stops all pods gracefully and powers off the host
+	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*PowerResponse, error)
 }
 
 type nodeClient struct {
@@ -304,10 +573,87 @@ func (c *nodeClient) Info(ctx context.Context, in *InfoRequest, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *nodeClient) SetLabels(ctx context.Context, in *SetLabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error) {
+	out := new(LabelsResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.containers.v1.Node/SetLabels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) RemoveLabels(ctx context.Context, in *RemoveLabelsRequest, opts ...grpc.CallOption) (*LabelsResponse, error) {
+	out := new(LabelsResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.containers.v1.Node/RemoveLabels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Cordon(ctx context.Context, in *CordonRequest, opts ...grpc.CallOption) (*CordonResponse, error) {
+	out := new(CordonResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.containers.v1.Node/Cordon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	out := new(DrainResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.containers.v1.Node/Drain", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Uncordon(ctx context.Context, in *UncordonRequest, opts ...grpc.CallOption) (*UncordonResponse, error) {
+	out := new(UncordonResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.containers.v1.Node/Uncordon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Reboot(ctx context.Context, in *RebootRequest, opts ...grpc.CallOption) (*PowerResponse, error) {
+	out := new(PowerResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.containers.v1.Node/Reboot", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodeClient) Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*PowerResponse, error) {
+	out := new(PowerResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.containers.v1.Node/Shutdown", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Node service
 
 type NodeServer interface {
 	Info(context.Context, *InfoRequest) (*InfoResponse, error)
+	// SetLabels adds or updates the node labels, the labels get persisted in the node
+	SetLabels(context.Context, *SetLabelsRequest) (*LabelsResponse, error)
+	// RemoveLabels removes the node labels by the keys
+	RemoveLabels(context.Context, *RemoveLabelsRequest) (*LabelsResponse, error)
+	// Cordon marks the node unschedulable so it doesn't accept new pods
+	Cordon(context.Context, *CordonRequest) (*CordonResponse, error)
+	// Drain cordons the node and stops all pods gracefully
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
+	// Uncordon restarts the drained pods and marks the node schedulable
+	Uncordon(context.Context, *UncordonRequest) (*UncordonResponse, error)
+	// Reboot stops all pods gracefully and reboots the host
+	Reboot(context.Context, *RebootRequest) (*PowerResponse, error)
+	// Shutdown stops all pods gracefully and powers off the host
+	Shutdown(context.Context, *ShutdownRequest) (*PowerResponse, error)
 }
 
 func RegisterNodeServer(s *grpc.Server, srv NodeServer) {
@@ -332,6 +678,132 @@ func _Node_Info_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Node_SetLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).SetLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.containers.v1.Node/SetLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).SetLabels(ctx, req.(*SetLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_RemoveLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).RemoveLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.containers.v1.Node/RemoveLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).RemoveLabels(ctx, req.(*RemoveLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Cordon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Cordon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.containers.v1.Node/Cordon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Cordon(ctx, req.(*CordonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Drain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Drain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.containers.v1.Node/Drain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Drain(ctx, req.(*DrainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Uncordon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Uncordon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.containers.v1.Node/Uncordon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Uncordon(ctx, req.(*UncordonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Reboot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Reboot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.containers.v1.Node/Reboot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Reboot(ctx, req.(*RebootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Node_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeServer).Shutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.containers.v1.Node/Shutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeServer).Shutdown(ctx, req.(*ShutdownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Node_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "eliot.services.containers.v1.Node",
 	HandlerType: (*NodeServer)(nil),
@@ -340,6 +812,34 @@ var _Node_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Info",
 			Handler:    _Node_Info_Handler,
 		},
+		{
+			MethodName: "SetLabels",
+			Handler:    _Node_SetLabels_Handler,
+		},
+		{
+			MethodName: "RemoveLabels",
+			Handler:    _Node_RemoveLabels_Handler,
+		},
+		{
+			MethodName: "Cordon",
+			Handler:    _Node_Cordon_Handler,
+		},
+		{
+			MethodName: "Drain",
+			Handler:    _Node_Drain_Handler,
+		},
+		{
+			MethodName: "Uncordon",
+			Handler:    _Node_Uncordon_Handler,
+		},
+		{
+			MethodName: "Reboot",
+			Handler:    _Node_Reboot_Handler,
+		},
+		{
+			MethodName: "Shutdown",
+			Handler:    _Node_Shutdown_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/node/v1/node.proto",
@@ -348,36 +848,58 @@ var _Node_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("services/node/v1/node.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x8b, 0xdb, 0x3c,
-	0x10, 0xc5, 0xb1, 0x93, 0xdd, 0x4c, 0xf6, 0xfb, 0x28, 0xa2, 0x14, 0xb1, 0x5d, 0x8a, 0x71, 0x2f,
-	0x6e, 0x0f, 0x36, 0x9b, 0x42, 0x4b, 0xd9, 0x5b, 0x09, 0x0b, 0x29, 0x65, 0x29, 0x82, 0xbd, 0x14,
-	0x7a, 0x50, 0x9c, 0x49, 0x22, 0xd6, 0x96, 0x5c, 0x49, 0x31, 0xe4, 0x67, 0xf4, 0x7f, 0xf4, 0x47,
-	0x16, 0xc9, 0x76, 0x1c, 0x7a, 0x08, 0x7b, 0xb2, 0xde, 0x9b, 0x79, 0x33, 0xe6, 0x3d, 0x09, 0x5e,
-	0x1b, 0xd4, 0x8d, 0x28, 0xd0, 0xe4, 0x52, 0xad, 0x31, 0x6f, 0x6e, 0xfd, 0x37, 0xab, 0xb5, 0xb2,
-	0x8a, 0xdc, 0x60, 0x29, 0x94, 0xcd, 0xfa, 0x96, 0xac, 0x50, 0xd2, 0x72, 0x21, 0x51, 0x9b, 0xac,
-	0xb9, 0x4d, 0xfe, 0x83, 0xd9, 0x52, 0x6e, 0x14, 0xc3, 0x5f, 0x7b, 0x34, 0x36, 0xb9, 0x87, 0xab,
-	0x16, 0x9a, 0x5a, 0x49, 0x83, 0xe4, 0x23, 0x44, 0x42, 0x6e, 0x14, 0x0d, 0xe2, 0x20, 0x9d, 0xcd,
-	0x93, 0xec, 0xdc, 0xac, 0xcc, 0x2b, 0x7d, 0x7f, 0xf2, 0x3b, 0x84, 0xc8, 0x41, 0x72, 0x07, 0x93,
-	0x92, 0xaf, 0xb0, 0x34, 0x34, 0x88, 0xc3, 0x74, 0x36, 0x7f, 0x7b, 0x7e, 0xc4, 0x37, 0xd7, 0xcb,
-	0x3a, 0x09, 0xb9, 0x86, 0xcb, 0x9d, 0x32, 0x56, 0xf2, 0x0a, 0xe9, 0x28, 0x0e, 0xd2, 0x29, 0x3b,
-	0x62, 0x72, 0x03, 0x53, 0xbe, 0x5e, 0x6b, 0x34, 0x06, 0x0d, 0x0d, 0xe3, 0x30, 0x9d, 0xb2, 0x81,
-	0x70, 0xca, 0xad, 0xae, 0x8b, 0xef, 0x4a, 0x5b, 0x1a, 0xc5, 0x41, 0x1a, 0xb2, 0x23, 0x76, 0xca,
-	0x8a, 0x17, 0x3b, 0x21, 0x71, 0xb9, 0xa0, 0x63, 0x3f, 0x76, 0x20, 0xc8, 0x1b, 0x00, 0x73, 0x30,
-	0x16, 0xab, 0xc7, 0xc7, 0xe5, 0x82, 0x4e, 0x7c, 0xf9, 0x84, 0x21, 0xaf, 0x60, 0xb2, 0x52, 0xca,
-	0x2e, 0x17, 0xf4, 0xc2, 0xd7, 0x3a, 0x44, 0x08, 0x44, 0x5c, 0x17, 0x3b, 0x7a, 0xe9, 0x59, 0x7f,
-	0x26, 0xff, 0xc3, 0x48, 0x19, 0x3a, 0xf5, 0xcc, 0x48, 0x19, 0x42, 0xe1, 0xa2, 0x41, 0x6d, 0x84,
-	0x92, 0x14, 0x3c, 0xd9, 0x43, 0xf2, 0x15, 0x66, 0x1b, 0x51, 0x62, 0xbb, 0xc7, 0xd0, 0x99, 0xf7,
-	0x2a, 0x3d, 0xef, 0xd5, 0xfd, 0x51, 0xc0, 0x4e, 0xc5, 0xee, 0x0f, 0xf7, 0xb5, 0x15, 0x15, 0xd2,
-	0xab, 0x38, 0x48, 0x23, 0xd6, 0xa1, 0x24, 0x87, 0xb1, 0xb7, 0x97, 0xbc, 0x80, 0xf0, 0x09, 0x0f,
-	0x3e, 0xd3, 0x29, 0x73, 0x47, 0xf2, 0x12, 0xc6, 0x0d, 0x2f, 0xf7, 0xbd, 0xcb, 0x2d, 0x48, 0xfe,
-	0x04, 0x00, 0xc3, 0x12, 0xe7, 0xcc, 0xb0, 0xa6, 0x53, 0x9f, 0x30, 0xce, 0x73, 0x7b, 0xa8, 0xf1,
-	0xe1, 0x24, 0xad, 0x1e, 0xbb, 0x5a, 0xa5, 0xf6, 0xd2, 0x2e, 0x84, 0xa6, 0x61, 0x5b, 0xeb, 0xb1,
-	0x5b, 0x6e, 0x95, 0xe5, 0xa5, 0x0f, 0x2a, 0x62, 0x2d, 0x70, 0x7e, 0x6e, 0x34, 0xa2, 0x0f, 0x28,
-	0x62, 0xfe, 0xec, 0x33, 0x6f, 0xb8, 0x28, 0xf9, 0xaa, 0x44, 0x1f, 0x4d, 0xc4, 0x06, 0x62, 0x8e,
-	0x10, 0x3d, 0xa8, 0x35, 0x92, 0x9f, 0xdd, 0xd5, 0x7b, 0xf7, 0x8c, 0xdb, 0xda, 0x5e, 0xfb, 0xeb,
-	0xf7, 0xcf, 0x69, 0x6d, 0x9f, 0xc4, 0x97, 0xcf, 0x3f, 0x3e, 0x6d, 0x85, 0xdd, 0xed, 0x57, 0x59,
-	0xa1, 0xaa, 0x1c, 0xb5, 0x54, 0x9c, 0xd7, 0x3c, 0xf7, 0x03, 0xf2, 0xfa, 0x69, 0x9b, 0xf3, 0x5a,
-	0xe4, 0xff, 0x3e, 0xc8, 0x3b, 0xf7, 0x5d, 0x4d, 0xfc, 0x8b, 0xfc, 0xf0, 0x37, 0x00, 0x00, 0xff,
-	0xff, 0x38, 0x85, 0x94, 0xff, 0xb0, 0x03, 0x00, 0x00,
+	// 835 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xe1, 0x6e, 0xe3, 0x44,
+	0x10, 0x96, 0x1b, 0xd7, 0x4d, 0x26, 0x4d, 0xda, 0x5b, 0x10, 0x5a, 0x85, 0x13, 0x8a, 0x7c, 0x08,
+	0xe5, 0xb8, 0x62, 0xab, 0x45, 0x02, 0xa1, 0xfb, 0x57, 0xa2, 0x4a, 0x45, 0x70, 0x9c, 0xf6, 0xe8,
+	0x1f, 0x24, 0x24, 0x36, 0xf6, 0xa4, 0xb1, 0xea, 0x78, 0x8d, 0x77, 0xed, 0xa3, 0x2f, 0xc0, 0xd3,
+	0xf0, 0x0e, 0x3c, 0x1a, 0x68, 0xd7, 0x76, 0xec, 0x44, 0xc8, 0xc9, 0xa9, 0xbf, 0xb2, 0xf3, 0xed,
+	0x7c, 0xdf, 0xec, 0xce, 0xac, 0x67, 0x02, 0x9f, 0x4a, 0xcc, 0x8a, 0x28, 0x40, 0xe9, 0x27, 0x22,
+	0x44, 0xbf, 0xb8, 0x34, 0xbf, 0x5e, 0x9a, 0x09, 0x25, 0xc8, 0x73, 0x8c, 0x23, 0xa1, 0xbc, 0xda,
+	0xc5, 0x0b, 0x44, 0xa2, 0x78, 0x94, 0x60, 0x26, 0xbd, 0xe2, 0xd2, 0x1d, 0xc1, 0xf0, 0x36, 0x59,
+	0x0a, 0x86, 0x7f, 0xe4, 0x28, 0x95, 0x7b, 0x03, 0xa7, 0xa5, 0x29, 0x53, 0x91, 0x48, 0x24, 0xdf,
+	0x80, 0x1d, 0x25, 0x4b, 0x41, 0xad, 0xa9, 0x35, 0x1b, 0x5e, 0xb9, 0x5e, 0x97, 0x96, 0x67, 0x98,
+	0xc6, 0xdf, 0xfd, 0x19, 0xce, 0xdf, 0xa1, 0xfa, 0x91, 0x2f, 0x30, 0x96, 0x95, 0x36, 0x79, 0x0d,
+	0x4e, 0x6c, 0x00, 0x6a, 0x4d, 0x7b, 0xb3, 0xe1, 0xd5, 0x8b, 0x6e, 0x35, 0x43, 0x66, 0x15, 0xc5,
+	0x7d, 0x09, 0x1f, 0x31, 0x5c, 0x8b, 0x02, 0xb7, 0x35, 0x09, 0xd8, 0x0f, 0xf8, 0x58, 0x2a, 0x0e,
+	0x98, 0x59, 0xbb, 0x3f, 0xc1, 0xb8, 0x76, 0xaa, 0x6e, 0xf1, 0xa4, 0xc8, 0x67, 0x30, 0xfa, 0x5e,
+	0x64, 0xa1, 0x48, 0xea, 0x1c, 0x9d, 0xc3, 0xb8, 0x06, 0x4a, 0x7d, 0x77, 0x0c, 0xa7, 0xf3, 0x8c,
+	0x47, 0x1b, 0x8f, 0x17, 0x30, 0xaa, 0xec, 0xea, 0x00, 0x04, 0xec, 0x54, 0x84, 0x9b, 0x63, 0xea,
+	0xb5, 0xfb, 0x0c, 0xce, 0xee, 0x92, 0x60, 0x4b, 0xf9, 0x0b, 0x38, 0x6f, 0xa0, 0x0e, 0xea, 0x19,
+	0x8c, 0x18, 0x2e, 0x84, 0x50, 0x35, 0xf1, 0x19, 0x9c, 0xbd, 0x5b, 0xe5, 0x2a, 0x14, 0xef, 0xdb,
+	0x67, 0x78, 0x2b, 0xde, 0x63, 0xd6, 0x29, 0xf4, 0x6f, 0x0f, 0x6c, 0x5d, 0xb5, 0x27, 0x65, 0x88,
+	0x4c, 0xa0, 0xbf, 0x12, 0x52, 0x25, 0x7c, 0x8d, 0xf4, 0x68, 0x6a, 0xcd, 0x06, 0x6c, 0x63, 0x93,
+	0xe7, 0x30, 0xe0, 0x61, 0x98, 0xa1, 0x94, 0x28, 0x69, 0xcf, 0x84, 0x6e, 0x00, 0xcd, 0xbc, 0xcf,
+	0xd2, 0xe0, 0xad, 0xc8, 0x14, 0xb5, 0xa7, 0xd6, 0xac, 0xc7, 0x36, 0xb6, 0x66, 0xae, 0x79, 0xb0,
+	0x8a, 0x12, 0xbc, 0x9d, 0xd3, 0x63, 0x23, 0xdb, 0x00, 0xe4, 0x33, 0x00, 0xf9, 0x28, 0x15, 0xae,
+	0xef, 0xee, 0x6e, 0xe7, 0xd4, 0x31, 0xdb, 0x2d, 0x84, 0x7c, 0x02, 0x8e, 0x4e, 0xd0, 0xed, 0x9c,
+	0x9e, 0x98, 0xbd, 0xca, 0xd2, 0x59, 0xe0, 0x59, 0xb0, 0xa2, 0x7d, 0x83, 0x9a, 0x35, 0x19, 0xc3,
+	0x91, 0x90, 0x74, 0x60, 0x90, 0x23, 0x21, 0x09, 0x85, 0x93, 0x02, 0x33, 0x19, 0x89, 0x84, 0x82,
+	0x01, 0x6b, 0x93, 0xfc, 0x00, 0xc3, 0x65, 0x14, 0x63, 0x19, 0x47, 0xd2, 0xa1, 0xc9, 0xd5, 0xac,
+	0x3b, 0x57, 0x37, 0x1b, 0x02, 0x6b, 0x93, 0xf5, 0x09, 0xf3, 0x54, 0x45, 0x6b, 0xa4, 0xa7, 0x53,
+	0x6b, 0x66, 0xb3, 0xca, 0x22, 0x37, 0x70, 0x12, 0xa2, 0x11, 0xa2, 0x23, 0xa3, 0x7f, 0xd1, 0xad,
+	0x3f, 0x37, 0xce, 0x0c, 0xa5, 0xc8, 0xb3, 0x00, 0x59, 0x4d, 0x26, 0x9f, 0xc3, 0x28, 0x4f, 0x64,
+	0xb0, 0xc2, 0x30, 0x8f, 0xf9, 0x22, 0x46, 0x3a, 0x9e, 0x5a, 0xb3, 0x3e, 0xdb, 0x06, 0x5d, 0x1f,
+	0x8e, 0x4d, 0x31, 0xc9, 0x39, 0xf4, 0x1e, 0xf0, 0xd1, 0x7c, 0xe8, 0x03, 0xa6, 0x97, 0xe4, 0x63,
+	0x38, 0x2e, 0x78, 0x9c, 0xd7, 0x35, 0x2d, 0x0d, 0xf7, 0x6f, 0x0b, 0xa0, 0xb9, 0x92, 0xae, 0x43,
+	0x73, 0xa9, 0x8a, 0xdd, 0x42, 0x74, 0x85, 0xd5, 0x63, 0x8a, 0x6f, 0x5a, 0x6f, 0xa3, 0xb6, 0xf5,
+	0xde, 0x5a, 0xe4, 0x89, 0x9a, 0x47, 0x19, 0xed, 0x95, 0x7b, 0xb5, 0xad, 0x83, 0x2b, 0xa1, 0x78,
+	0x6c, 0x9e, 0x85, 0xcd, 0x4a, 0x43, 0x57, 0x6f, 0x99, 0x21, 0x9a, 0xe7, 0x60, 0x33, 0xb3, 0x36,
+	0x2f, 0xac, 0xe0, 0x51, 0x79, 0x47, 0xc7, 0x6c, 0x34, 0x80, 0xfb, 0x97, 0x05, 0xe3, 0xed, 0x0c,
+	0x69, 0x11, 0xf3, 0x54, 0xcb, 0xc3, 0xda, 0xf5, 0x33, 0xc5, 0x3f, 0x83, 0x38, 0x97, 0x51, 0x51,
+	0x9e, 0xb3, 0xcf, 0x1a, 0x80, 0x5c, 0x37, 0x25, 0xe9, 0x1d, 0x52, 0xf2, 0x37, 0x22, 0xc4, 0x2a,
+	0x68, 0x4d, 0x74, 0xaf, 0x01, 0x1a, 0xd8, 0x7c, 0x8c, 0x5c, 0xad, 0xea, 0x33, 0xe8, 0x35, 0x99,
+	0xc2, 0x90, 0xc7, 0xb1, 0x08, 0xb8, 0xc2, 0xf0, 0x17, 0x51, 0x65, 0xab, 0x0d, 0x5d, 0xfd, 0xe3,
+	0x80, 0xad, 0x45, 0xc8, 0x6f, 0xd5, 0x67, 0xfb, 0xf2, 0x80, 0x86, 0x5c, 0xf6, 0x83, 0xc9, 0x97,
+	0x87, 0xb8, 0x56, 0xad, 0x22, 0x82, 0xc1, 0xa6, 0x7b, 0x13, 0xaf, 0x9b, 0xb8, 0xdb, 0xe6, 0x27,
+	0x17, 0x07, 0xb4, 0x8e, 0xa6, 0x35, 0x0b, 0x38, 0x6d, 0xf7, 0x75, 0x72, 0xd9, 0xcd, 0xfe, 0x9f,
+	0x19, 0xf0, 0x81, 0x01, 0x03, 0x70, 0xca, 0xee, 0x4d, 0x5e, 0x75, 0xf3, 0xb6, 0x9a, 0xfe, 0xe4,
+	0xe2, 0x30, 0xe7, 0x2a, 0xc8, 0xef, 0x70, 0x6c, 0x06, 0x00, 0xd9, 0x93, 0xf5, 0xf6, 0xd4, 0x98,
+	0xbc, 0x3a, 0xc8, 0x77, 0x53, 0xa2, 0x7e, 0x3d, 0x2a, 0xc8, 0x57, 0xdd, 0xc4, 0x9d, 0x29, 0x33,
+	0xf1, 0x0e, 0x75, 0xaf, 0x42, 0x2d, 0xc0, 0x29, 0xa7, 0xcd, 0xbe, 0x8c, 0x6d, 0xcd, 0xa4, 0x7d,
+	0xd7, 0xd9, 0x1e, 0x4e, 0x4b, 0xe8, 0xd7, 0x03, 0x6c, 0xdf, 0x75, 0x76, 0x06, 0xdd, 0x07, 0xc5,
+	0xb9, 0xfe, 0xee, 0xd7, 0x6f, 0xef, 0x23, 0xb5, 0xca, 0x17, 0x5e, 0x20, 0xd6, 0x3e, 0x66, 0x89,
+	0xe0, 0x3c, 0xe5, 0xbe, 0x51, 0xf0, 0xd3, 0x87, 0x7b, 0x9f, 0xa7, 0x91, 0xbf, 0xfb, 0x6f, 0xea,
+	0xb5, 0xfe, 0x5d, 0x38, 0xe6, 0xef, 0xd4, 0xd7, 0xff, 0x0d, 0x00, 0x01, 0xc0, 0xc0, 0x19, 0x6d,
+	0x09, 0x00, 0x00,
 }
@@ -19,8 +19,11 @@ It has these top-level messages:
 	DeletePodResponse
 	ListPodsRequest
 	ListPodsResponse
+	PortForwardRequest
+	PortForwardResponse
 	Pod
 	PodSpec
+	PodVolume
 	PodStatus
 */
 package pods
@@ -28,8 +31,8 @@ package pods
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
-import cand_core "github.com/ernoaapa/eliot/pkg/api/core"
-import cand_services_containers_v1 "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+import eliot_core "github.com/ernoaapa/eliot/pkg/api/core"
+import eliot_services_containers_v1 "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
 
 import (
 	context "golang.org/x/net/context"
@@ -265,6 +268,8 @@ func (m *DeletePodResponse) GetPod() *Pod {
 
 type ListPodsRequest struct {
 	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	// Label selector to filter the pods, e.g. app=sensor,env!=dev
+	Selector string `protobuf:"bytes,2,opt,name=selector" json:"selector,omitempty"`
 }
 
 func (m *ListPodsRequest) Reset()                    { *m = ListPodsRequest{} }
@@ -279,6 +284,13 @@ func (m *ListPodsRequest) GetNamespace() string {
 	return ""
 }
 
+func (m *ListPodsRequest) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
 type ListPodsResponse struct {
 	Pods []*Pod `protobuf:"bytes,1,rep,name=pods" json:"pods,omitempty"`
 }
@@ -295,18 +307,77 @@ func (m *ListPodsResponse) GetPods() []*Pod {
 	return nil
 }
 
+type PortForwardRequest struct {
+	// Target pod and TCP port, required in the first message
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	PodName   string `protobuf:"bytes,2,opt,name=podName" json:"podName,omitempty"`
+	Port      int32  `protobuf:"varint,3,opt,name=port" json:"port,omitempty"`
+	// Data to send to the pod port
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *PortForwardRequest) Reset()                    { *m = PortForwardRequest{} }
+func (m *PortForwardRequest) String() string            { return proto.CompactTextString(m) }
+func (*PortForwardRequest) ProtoMessage()               {}
+func (*PortForwardRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *PortForwardRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PortForwardRequest) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *PortForwardRequest) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *PortForwardRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type PortForwardResponse struct {
+	// Data received from the pod port
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *PortForwardResponse) Reset()                    { *m = PortForwardResponse{} }
+func (m *PortForwardResponse) String() string            { return proto.CompactTextString(m) }
+func (*PortForwardResponse) ProtoMessage()               {}
+func (*PortForwardResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *PortForwardResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type Pod struct {
-	Metadata *cand_core.ResourceMetadata `protobuf:"bytes,1,opt,name=metadata" json:"metadata,omitempty"`
-	Spec     *PodSpec                    `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
-	Status   *PodStatus                  `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
+	Metadata *eliot_core.ResourceMetadata `protobuf:"bytes,1,opt,name=metadata" json:"metadata,omitempty"`
+	Spec     *PodSpec                     `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
+	Status   *PodStatus                   `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
 }
 
 func (m *Pod) Reset()                    { *m = Pod{} }
 func (m *Pod) String() string            { return proto.CompactTextString(m) }
 func (*Pod) ProtoMessage()               {}
-func (*Pod) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*Pod) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
-func (m *Pod) GetMetadata() *cand_core.ResourceMetadata {
+func (m *Pod) GetMetadata() *eliot_core.ResourceMetadata {
 	if m != nil {
 		return m.Metadata
 	}
@@ -328,18 +399,28 @@ func (m *Pod) GetStatus() *PodStatus {
 }
 
 type PodSpec struct {
-	Containers    []*cand_services_containers_v1.Container `protobuf:"bytes,1,rep,name=containers" json:"containers,omitempty"`
-	HostNetwork   bool                                     `protobuf:"varint,2,opt,name=hostNetwork" json:"hostNetwork,omitempty"`
-	HostPID       bool                                     `protobuf:"varint,3,opt,name=hostPID" json:"hostPID,omitempty"`
-	RestartPolicy string                                   `protobuf:"bytes,4,opt,name=restartPolicy" json:"restartPolicy,omitempty"`
+	Containers    []*eliot_services_containers_v1.Container `protobuf:"bytes,1,rep,name=containers" json:"containers,omitempty"`
+	HostNetwork   bool                                      `protobuf:"varint,2,opt,name=hostNetwork" json:"hostNetwork,omitempty"`
+	HostPID       bool                                      `protobuf:"varint,3,opt,name=hostPID" json:"hostPID,omitempty"`
+	RestartPolicy string                                    `protobuf:"bytes,4,opt,name=restartPolicy" json:"restartPolicy,omitempty"`
+	// Share single process namespace between all containers in the pod
+	ShareProcessNamespace bool `protobuf:"varint,5,opt,name=shareProcessNamespace" json:"shareProcessNamespace,omitempty"`
+	// Hostname of the pod, get set to the UTS namespace shared by all containers in the pod
+	Hostname string `protobuf:"bytes,6,opt,name=hostname" json:"hostname,omitempty"`
+	// Named volumes what containers can mount by name
+	Volumes []*PodVolume `protobuf:"bytes,7,rep,name=volumes" json:"volumes,omitempty"`
+	// Node labels what the node must have to run the pod
+	NodeSelector map[string]string `protobuf:"bytes,8,rep,name=nodeSelector" json:"nodeSelector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Seconds the containers get to stop after SIGTERM before they get killed, zero means the default
+	TerminationGracePeriodSeconds int64 `protobuf:"varint,9,opt,name=terminationGracePeriodSeconds" json:"terminationGracePeriodSeconds,omitempty"`
 }
 
 func (m *PodSpec) Reset()                    { *m = PodSpec{} }
 func (m *PodSpec) String() string            { return proto.CompactTextString(m) }
 func (*PodSpec) ProtoMessage()               {}
-func (*PodSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*PodSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
 
-func (m *PodSpec) GetContainers() []*cand_services_containers_v1.Container {
+func (m *PodSpec) GetContainers() []*eliot_services_containers_v1.Container {
 	if m != nil {
 		return m.Containers
 	}
@@ -367,17 +448,79 @@ func (m *PodSpec) GetRestartPolicy() string {
 	return ""
 }
 
+func (m *PodSpec) GetShareProcessNamespace() bool {
+	if m != nil {
+		return m.ShareProcessNamespace
+	}
+	return false
+}
+
+func (m *PodSpec) GetHostname() string {
+	if m != nil {
+		return m.Hostname
+	}
+	return ""
+}
+
+func (m *PodSpec) GetVolumes() []*PodVolume {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+func (m *PodSpec) GetNodeSelector() map[string]string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return nil
+}
+
+func (m *PodSpec) GetTerminationGracePeriodSeconds() int64 {
+	if m != nil {
+		return m.TerminationGracePeriodSeconds
+	}
+	return 0
+}
+
+type PodVolume struct {
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// Size limit, e.g. "100MB", empty if unlimited
+	Size string `protobuf:"bytes,2,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *PodVolume) Reset()                    { *m = PodVolume{} }
+func (m *PodVolume) String() string            { return proto.CompactTextString(m) }
+func (*PodVolume) ProtoMessage()               {}
+func (*PodVolume) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *PodVolume) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PodVolume) GetSize() string {
+	if m != nil {
+		return m.Size
+	}
+	return ""
+}
+
 type PodStatus struct {
-	ContainerStatuses []*cand_services_containers_v1.ContainerStatus `protobuf:"bytes,1,rep,name=containerStatuses" json:"containerStatuses,omitempty"`
-	Hostname          string                                         `protobuf:"bytes,2,opt,name=hostname" json:"hostname,omitempty"`
+	ContainerStatuses []*eliot_services_containers_v1.ContainerStatus `protobuf:"bytes,1,rep,name=containerStatuses" json:"containerStatuses,omitempty"`
+	Hostname          string                                          `protobuf:"bytes,2,opt,name=hostname" json:"hostname,omitempty"`
+	// IP address of the pod in the bridge network, empty if the pod uses host network
+	PodIP string `protobuf:"bytes,3,opt,name=podIP" json:"podIP,omitempty"`
 }
 
 func (m *PodStatus) Reset()                    { *m = PodStatus{} }
 func (m *PodStatus) String() string            { return proto.CompactTextString(m) }
 func (*PodStatus) ProtoMessage()               {}
-func (*PodStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*PodStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
-func (m *PodStatus) GetContainerStatuses() []*cand_services_containers_v1.ContainerStatus {
+func (m *PodStatus) GetContainerStatuses() []*eliot_services_containers_v1.ContainerStatus {
 	if m != nil {
 		return m.ContainerStatuses
 	}
@@ -391,20 +534,30 @@ func (m *PodStatus) GetHostname() string {
 	return ""
 }
 
+func (m *PodStatus) GetPodIP() string {
+	if m != nil {
+		return m.PodIP
+	}
+	return ""
+}
+
 func init() {
-	proto.RegisterType((*CreatePodRequest)(nil), "cand.services.pods.v1.CreatePodRequest")
-	proto.RegisterType((*CreatePodStreamResponse)(nil), "cand.services.pods.v1.CreatePodStreamResponse")
-	proto.RegisterType((*ImageFetch)(nil), "cand.services.pods.v1.ImageFetch")
-	proto.RegisterType((*ImageLayerStatus)(nil), "cand.services.pods.v1.ImageLayerStatus")
-	proto.RegisterType((*StartPodRequest)(nil), "cand.services.pods.v1.StartPodRequest")
-	proto.RegisterType((*StartPodResponse)(nil), "cand.services.pods.v1.StartPodResponse")
-	proto.RegisterType((*DeletePodRequest)(nil), "cand.services.pods.v1.DeletePodRequest")
-	proto.RegisterType((*DeletePodResponse)(nil), "cand.services.pods.v1.DeletePodResponse")
-	proto.RegisterType((*ListPodsRequest)(nil), "cand.services.pods.v1.ListPodsRequest")
-	proto.RegisterType((*ListPodsResponse)(nil), "cand.services.pods.v1.ListPodsResponse")
-	proto.RegisterType((*Pod)(nil), "cand.services.pods.v1.Pod")
-	proto.RegisterType((*PodSpec)(nil), "cand.services.pods.v1.PodSpec")
-	proto.RegisterType((*PodStatus)(nil), "cand.services.pods.v1.PodStatus")
+	proto.RegisterType((*CreatePodRequest)(nil), "eliot.services.pods.v1.CreatePodRequest")
+	proto.RegisterType((*CreatePodStreamResponse)(nil), "eliot.services.pods.v1.CreatePodStreamResponse")
+	proto.RegisterType((*ImageFetch)(nil), "eliot.services.pods.v1.ImageFetch")
+	proto.RegisterType((*ImageLayerStatus)(nil), "eliot.services.pods.v1.ImageLayerStatus")
+	proto.RegisterType((*StartPodRequest)(nil), "eliot.services.pods.v1.StartPodRequest")
+	proto.RegisterType((*StartPodResponse)(nil), "eliot.services.pods.v1.StartPodResponse")
+	proto.RegisterType((*DeletePodRequest)(nil), "eliot.services.pods.v1.DeletePodRequest")
+	proto.RegisterType((*DeletePodResponse)(nil), "eliot.services.pods.v1.DeletePodResponse")
+	proto.RegisterType((*ListPodsRequest)(nil), "eliot.services.pods.v1.ListPodsRequest")
+	proto.RegisterType((*ListPodsResponse)(nil), "eliot.services.pods.v1.ListPodsResponse")
+	proto.RegisterType((*PortForwardRequest)(nil), "eliot.services.pods.v1.PortForwardRequest")
+	proto.RegisterType((*PortForwardResponse)(nil), "eliot.services.pods.v1.PortForwardResponse")
+	proto.RegisterType((*Pod)(nil), "eliot.services.pods.v1.Pod")
+	proto.RegisterType((*PodSpec)(nil), "eliot.services.pods.v1.PodSpec")
+	proto.RegisterType((*PodVolume)(nil), "eliot.services.pods.v1.PodVolume")
+	proto.RegisterType((*PodStatus)(nil), "eliot.services.pods.v1.PodStatus")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -422,6 +575,7 @@ type PodsClient interface {
 	Start(ctx context.Context, in *StartPodRequest, opts ...grpc.CallOption) (*StartPodResponse, error)
 	Delete(ctx context.Context, in *DeletePodRequest, opts ...grpc.CallOption) (*DeletePodResponse, error)
 	List(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error)
+	PortForward(ctx context.Context, opts ...grpc.CallOption) (Pods_PortForwardClient, error)
 }
 
 type podsClient struct {
@@ -433,7 +587,7 @@ func NewPodsClient(cc *grpc.ClientConn) PodsClient {
 }
 
 func (c *podsClient) Create(ctx context.Context, in *CreatePodRequest, opts ...grpc.CallOption) (Pods_CreateClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Pods_serviceDesc.Streams[0], c.cc, "/cand.services.pods.v1.Pods/Create", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Pods_serviceDesc.Streams[0], c.cc, "/eliot.services.pods.v1.Pods/Create", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -466,7 +620,7 @@ func (x *podsCreateClient) Recv() (*CreatePodStreamResponse, error) {
 
 func (c *podsClient) Start(ctx context.Context, in *StartPodRequest, opts ...grpc.CallOption) (*StartPodResponse, error) {
 	out := new(StartPodResponse)
-	err := grpc.Invoke(ctx, "/cand.services.pods.v1.Pods/Start", in, out, c.cc, opts...)
+	err := grpc.Invoke(ctx, "/eliot.services.pods.v1.Pods/Start", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -475,7 +629,7 @@ func (c *podsClient) Start(ctx context.Context, in *StartPodRequest, opts ...grp
 
 func (c *podsClient) Delete(ctx context.Context, in *DeletePodRequest, opts ...grpc.CallOption) (*DeletePodResponse, error) {
 	out := new(DeletePodResponse)
-	err := grpc.Invoke(ctx, "/cand.services.pods.v1.Pods/Delete", in, out, c.cc, opts...)
+	err := grpc.Invoke(ctx, "/eliot.services.pods.v1.Pods/Delete", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -484,13 +638,44 @@ func (c *podsClient) Delete(ctx context.Context, in *DeletePodRequest, opts ...g
 
 func (c *podsClient) List(ctx context.Context, in *ListPodsRequest, opts ...grpc.CallOption) (*ListPodsResponse, error) {
 	out := new(ListPodsResponse)
-	err := grpc.Invoke(ctx, "/cand.services.pods.v1.Pods/List", in, out, c.cc, opts...)
+	err := grpc.Invoke(ctx, "/eliot.services.pods.v1.Pods/List", in, out, c.cc, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
+func (c *podsClient) PortForward(ctx context.Context, opts ...grpc.CallOption) (Pods_PortForwardClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Pods_serviceDesc.Streams[1], c.cc, "/eliot.services.pods.v1.Pods/PortForward", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &podsPortForwardClient{stream}
+	return x, nil
+}
+
+type Pods_PortForwardClient interface {
+	Send(*PortForwardRequest) error
+	Recv() (*PortForwardResponse, error)
+	grpc.ClientStream
+}
+
+type podsPortForwardClient struct {
+	grpc.ClientStream
+}
+
+func (x *podsPortForwardClient) Send(m *PortForwardRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *podsPortForwardClient) Recv() (*PortForwardResponse, error) {
+	m := new(PortForwardResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Pods service
 
 type PodsServer interface {
@@ -498,6 +683,7 @@ type PodsServer interface {
 	Start(context.Context, *StartPodRequest) (*StartPodResponse, error)
 	Delete(context.Context, *DeletePodRequest) (*DeletePodResponse, error)
 	List(context.Context, *ListPodsRequest) (*ListPodsResponse, error)
+	PortForward(Pods_PortForwardServer) error
 }
 
 func RegisterPodsServer(s *grpc.Server, srv PodsServer) {
@@ -535,7 +721,7 @@ func _Pods_Start_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cand.services.pods.v1.Pods/Start",
+		FullMethod: "/eliot.services.pods.v1.Pods/Start",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(PodsServer).Start(ctx, req.(*StartPodRequest))
@@ -553,7 +739,7 @@ func _Pods_Delete_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cand.services.pods.v1.Pods/Delete",
+		FullMethod: "/eliot.services.pods.v1.Pods/Delete",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(PodsServer).Delete(ctx, req.(*DeletePodRequest))
@@ -571,7 +757,7 @@ func _Pods_List_Handler(srv interface{}, ctx context.Context, dec func(interface
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/cand.services.pods.v1.Pods/List",
+		FullMethod: "/eliot.services.pods.v1.Pods/List",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(PodsServer).List(ctx, req.(*ListPodsRequest))
@@ -579,8 +765,34 @@ func _Pods_List_Handler(srv interface{}, ctx context.Context, dec func(interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Pods_PortForward_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(PodsServer).PortForward(&podsPortForwardServer{stream})
+}
+
+type Pods_PortForwardServer interface {
+	Send(*PortForwardResponse) error
+	Recv() (*PortForwardRequest, error)
+	grpc.ServerStream
+}
+
+type podsPortForwardServer struct {
+	grpc.ServerStream
+}
+
+func (x *podsPortForwardServer) Send(m *PortForwardResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *podsPortForwardServer) Recv() (*PortForwardRequest, error) {
+	m := new(PortForwardRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Pods_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cand.services.pods.v1.Pods",
+	ServiceName: "eliot.services.pods.v1.Pods",
 	HandlerType: (*PodsServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -602,6 +814,12 @@ var _Pods_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Pods_Create_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "PortForward",
+			Handler:       _Pods_PortForward_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "services/pods/v1/pods.proto",
 }
@@ -609,50 +827,66 @@ var _Pods_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("services/pods/v1/pods.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 719 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcd, 0x6f, 0xd3, 0x4e,
-	0x10, 0x95, 0x9b, 0x8f, 0x36, 0x13, 0xfd, 0xd4, 0x74, 0x7f, 0x7c, 0x58, 0x29, 0x42, 0xc1, 0x42,
-	0x6d, 0x0e, 0x95, 0x4d, 0x83, 0x50, 0x41, 0x1c, 0x80, 0x36, 0xaa, 0x54, 0xa9, 0xa0, 0x6a, 0x83,
-	0x04, 0x2a, 0xa7, 0xad, 0x3d, 0x49, 0xad, 0x26, 0x59, 0xb3, 0xbb, 0x09, 0xea, 0x15, 0xfe, 0x1d,
-	0x6e, 0x9c, 0xf8, 0xdb, 0xb8, 0xa0, 0x5d, 0x6f, 0x9c, 0xc4, 0xe0, 0x7e, 0x70, 0xb2, 0xdf, 0xf8,
-	0xcd, 0xcc, 0x5b, 0x7b, 0xde, 0x18, 0x36, 0x25, 0x8a, 0x69, 0x1c, 0xa2, 0x0c, 0x12, 0x1e, 0xc9,
-	0x60, 0xba, 0x6b, 0xae, 0x7e, 0x22, 0xb8, 0xe2, 0xe4, 0x6e, 0xc8, 0xc6, 0x91, 0x3f, 0x63, 0xf8,
-	0xe6, 0xc9, 0x74, 0xb7, 0xf9, 0x7f, 0xc8, 0x05, 0x06, 0x23, 0x54, 0x2c, 0x62, 0x8a, 0xa5, 0xdc,
-	0xe6, 0x76, 0x56, 0x28, 0xe4, 0x63, 0xc5, 0xe2, 0x31, 0x0a, 0x53, 0x6e, 0x8e, 0x52, 0xa2, 0x47,
-	0xa1, 0x71, 0x20, 0x90, 0x29, 0x3c, 0xe1, 0x11, 0xc5, 0xcf, 0x13, 0x94, 0x8a, 0xec, 0x40, 0x29,
-	0xe1, 0x91, 0xeb, 0xb4, 0x9c, 0x76, 0xbd, 0xd3, 0xf4, 0xff, 0xda, 0xd6, 0xd7, 0x7c, 0x4d, 0x23,
-	0x0d, 0x28, 0x29, 0x75, 0xe9, 0xae, 0xb4, 0x9c, 0xf6, 0x1a, 0xd5, 0xb7, 0xde, 0x7b, 0xb8, 0x9f,
-	0xd5, 0xec, 0x29, 0x81, 0x6c, 0x44, 0x51, 0x26, 0x7c, 0x2c, 0x91, 0xbc, 0x80, 0x6a, 0x3c, 0x62,
-	0x03, 0x94, 0xae, 0xd3, 0x2a, 0xb5, 0xeb, 0x9d, 0x47, 0x05, 0xd5, 0x8f, 0x34, 0xe9, 0x10, 0x55,
-	0x78, 0x4e, 0x6d, 0x82, 0xf7, 0xd3, 0x01, 0x98, 0x87, 0x49, 0x0b, 0xea, 0xd9, 0x61, 0x8e, 0xba,
-	0x46, 0x6c, 0x8d, 0x2e, 0x86, 0xc8, 0x1d, 0xa8, 0x98, 0x54, 0x23, 0xad, 0x46, 0x53, 0x40, 0x9a,
-	0xb0, 0x26, 0x50, 0xf2, 0xe1, 0x14, 0x23, 0xb7, 0x64, 0x34, 0x67, 0x98, 0xdc, 0x83, 0x6a, 0x9f,
-	0xc5, 0x43, 0x8c, 0xdc, 0xb2, 0x79, 0x62, 0x11, 0x79, 0x05, 0xd5, 0x21, 0xbb, 0x44, 0x21, 0xdd,
-	0x8a, 0x51, 0xbd, 0x7d, 0x95, 0xea, 0x63, 0xcd, 0xec, 0x29, 0xa6, 0x26, 0x92, 0xda, 0x34, 0xef,
-	0xab, 0x03, 0x8d, 0xfc, 0x43, 0xfd, 0xe2, 0x04, 0xf6, 0xad, 0x72, 0x7d, 0xab, 0xfb, 0x47, 0xf1,
-	0x00, 0xa5, 0xb2, 0x92, 0x2d, 0xd2, 0x71, 0x69, 0x72, 0x8c, 0xe2, 0x1a, 0xb5, 0x48, 0xc7, 0x79,
-	0xbf, 0x2f, 0x51, 0x19, 0xbd, 0x25, 0x6a, 0x91, 0x3e, 0xb9, 0xe2, 0x8a, 0x0d, 0xdd, 0x8a, 0x09,
-	0xa7, 0xc0, 0x3b, 0x80, 0xf5, 0x9e, 0x62, 0x42, 0x2d, 0x7c, 0xe9, 0x07, 0x50, 0x1b, 0xb3, 0x11,
-	0xca, 0x84, 0x85, 0x68, 0x85, 0xcc, 0x03, 0x84, 0x40, 0x59, 0x03, 0x2b, 0xc6, 0xdc, 0x7b, 0xaf,
-	0xa1, 0x31, 0x2f, 0x62, 0x3f, 0xea, 0xad, 0xe6, 0xc5, 0xeb, 0x42, 0xa3, 0x8b, 0x43, 0x5c, 0x9a,
-	0xb8, 0xdb, 0xeb, 0x78, 0x03, 0x1b, 0x0b, 0x55, 0xfe, 0x49, 0x48, 0x00, 0xeb, 0xc7, 0xb1, 0xd4,
-	0x27, 0x91, 0x37, 0xd2, 0xe1, 0xed, 0x43, 0x63, 0x9e, 0x60, 0x5b, 0xfa, 0x50, 0xd6, 0x85, 0xed,
-	0x38, 0x5f, 0xd5, 0xd3, 0xf0, 0xbc, 0xef, 0x0e, 0x94, 0x4e, 0x78, 0x44, 0xf6, 0x60, 0x6d, 0x66,
-	0x59, 0xab, 0x77, 0x33, 0xcd, 0xd5, 0x6e, 0xf6, 0x29, 0x4a, 0x3e, 0x11, 0x21, 0xbe, 0xb5, 0x14,
-	0x9a, 0x91, 0x49, 0x07, 0xca, 0x32, 0xc1, 0xd0, 0xbc, 0x8c, 0x7a, 0xe7, 0x61, 0x71, 0xc3, 0x5e,
-	0x82, 0x21, 0x35, 0x5c, 0xf2, 0x7c, 0x69, 0x7e, 0xea, 0x9d, 0xd6, 0x15, 0x59, 0x76, 0x70, 0x53,
-	0xbe, 0xf7, 0xc3, 0x81, 0x55, 0x5b, 0x8b, 0x1c, 0x02, 0xcc, 0xd7, 0x87, 0x3d, 0xf0, 0x56, 0xae,
-	0xd2, 0xc2, 0x7e, 0x99, 0xee, 0xfa, 0x07, 0x33, 0x44, 0x17, 0x32, 0xb5, 0x73, 0xcf, 0xb9, 0x54,
-	0xef, 0x50, 0x7d, 0xe1, 0xe2, 0xc2, 0x2e, 0x8e, 0xc5, 0x10, 0x71, 0x61, 0x55, 0xc3, 0x93, 0xa3,
-	0xae, 0xb5, 0xe8, 0x0c, 0x92, 0xc7, 0xf0, 0x9f, 0x40, 0x99, 0x0e, 0xe0, 0x30, 0x0e, 0x2f, 0xcd,
-	0xe0, 0xd7, 0xe8, 0x72, 0xd0, 0xfb, 0xe6, 0x40, 0x2d, 0x3b, 0x0b, 0x39, 0x85, 0x8d, 0xac, 0x7b,
-	0x1a, 0xca, 0xd6, 0xcf, 0xce, 0xcd, 0xe4, 0xdb, 0x97, 0xf2, 0x67, 0x19, 0xbd, 0x4d, 0xb4, 0xb4,
-	0x85, 0xf1, 0xcc, 0x70, 0xe7, 0xd7, 0x0a, 0x94, 0xf5, 0xac, 0x90, 0x10, 0xaa, 0xe9, 0x3e, 0x24,
-	0x45, 0x8b, 0x23, 0xbf, 0x82, 0x9b, 0xfe, 0x75, 0xc4, 0xe5, 0xbd, 0xfa, 0xc4, 0x21, 0x1f, 0xa1,
-	0x62, 0x8c, 0x49, 0xb6, 0x0a, 0x52, 0x73, 0xde, 0x6f, 0x6e, 0x5f, 0xcb, 0xb3, 0x23, 0xfe, 0x09,
-	0xaa, 0xa9, 0xd5, 0x0a, 0xe5, 0xe7, 0xfd, 0xdc, 0x6c, 0x5f, 0x4f, 0xb4, 0xc5, 0x3f, 0x40, 0x59,
-	0x7b, 0xaa, 0x50, 0x75, 0xce, 0xa1, 0x85, 0xaa, 0xf3, 0xc6, 0xdc, 0xdf, 0x3b, 0x7d, 0x36, 0x88,
-	0xd5, 0xf9, 0xe4, 0xcc, 0x0f, 0xf9, 0x28, 0x40, 0x31, 0xe6, 0x8c, 0x25, 0x2c, 0x08, 0xd9, 0x38,
-	0x48, 0x2e, 0x06, 0x01, 0x4b, 0xe2, 0x20, 0xff, 0xb3, 0x7d, 0xa9, 0xaf, 0x67, 0x55, 0xf3, 0x63,
-	0x7c, 0xfa, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xd8, 0xc6, 0x22, 0x3c, 0x8c, 0x07, 0x00, 0x00,
+	// 974 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdb, 0x8e, 0x1b, 0x45,
+	0x10, 0xd5, 0xac, 0x2f, 0x6b, 0x97, 0x83, 0xe2, 0xed, 0x84, 0x30, 0x9a, 0x04, 0x61, 0x46, 0x48,
+	0xeb, 0x80, 0xe2, 0xc9, 0x7a, 0x91, 0xc8, 0xe5, 0x01, 0xc8, 0x9a, 0x44, 0x2b, 0xc2, 0xca, 0x6a,
+	0x2b, 0x48, 0x04, 0xf1, 0xd0, 0x99, 0xa9, 0xdd, 0x1d, 0x76, 0x3c, 0x3d, 0x74, 0xb7, 0x1d, 0x99,
+	0x47, 0x3e, 0x83, 0x37, 0xbe, 0x80, 0x77, 0x7e, 0x8a, 0x5f, 0x40, 0xdd, 0xd3, 0x33, 0xbe, 0x64,
+	0x7d, 0x01, 0x9e, 0xdc, 0x55, 0x73, 0xaa, 0xfa, 0x74, 0x57, 0xf5, 0x29, 0xc3, 0x5d, 0x89, 0x62,
+	0x1a, 0x87, 0x28, 0x83, 0x8c, 0x47, 0x32, 0x98, 0x1e, 0x99, 0xdf, 0x5e, 0x26, 0xb8, 0xe2, 0xe4,
+	0x0e, 0x26, 0x31, 0x57, 0xbd, 0x02, 0xd2, 0x33, 0x9f, 0xa6, 0x47, 0xde, 0xad, 0x90, 0x0b, 0x0c,
+	0xc6, 0xa8, 0x58, 0xc4, 0x14, 0xcb, 0xc1, 0xde, 0x61, 0x99, 0x29, 0xe4, 0xa9, 0x62, 0x71, 0x8a,
+	0xc2, 0xe4, 0x9b, 0x5b, 0x39, 0xd0, 0x1f, 0x41, 0xfb, 0x44, 0x20, 0x53, 0x38, 0xe4, 0x11, 0xc5,
+	0x5f, 0x26, 0x28, 0x15, 0x79, 0x00, 0x95, 0x8c, 0x47, 0xae, 0xd3, 0x71, 0xba, 0xad, 0xfe, 0xdd,
+	0xde, 0xf5, 0xfb, 0xf6, 0x74, 0x80, 0xc6, 0x91, 0x36, 0x54, 0x94, 0x9a, 0xb9, 0x7b, 0x1d, 0xa7,
+	0xdb, 0xa0, 0x7a, 0xe9, 0xbf, 0x82, 0x0f, 0xca, 0xa4, 0x23, 0x25, 0x90, 0x8d, 0x29, 0xca, 0x8c,
+	0xa7, 0x12, 0xc9, 0x13, 0xa8, 0xc7, 0x63, 0x76, 0x81, 0xd2, 0x75, 0x3a, 0x95, 0x6e, 0xab, 0xef,
+	0xaf, 0x4b, 0x7f, 0xaa, 0x51, 0xcf, 0x51, 0x85, 0x97, 0xd4, 0x46, 0xf8, 0x7f, 0x39, 0x00, 0x73,
+	0x37, 0xe9, 0x40, 0xab, 0x3c, 0xce, 0xe9, 0xc0, 0xd0, 0x6d, 0xd2, 0x45, 0x17, 0xb9, 0x0d, 0x35,
+	0x13, 0x6a, 0xb8, 0x35, 0x69, 0x6e, 0x10, 0x0f, 0x1a, 0x02, 0x25, 0x4f, 0xa6, 0x18, 0xb9, 0x15,
+	0x43, 0xba, 0xb4, 0xc9, 0x1d, 0xa8, 0x9f, 0xb3, 0x38, 0xc1, 0xc8, 0xad, 0x9a, 0x2f, 0xd6, 0x22,
+	0x5f, 0x41, 0x3d, 0x61, 0x33, 0x14, 0xd2, 0xad, 0x19, 0xda, 0xdd, 0x8d, 0xb4, 0x5f, 0x6a, 0xe8,
+	0x48, 0x31, 0x35, 0x91, 0xd4, 0xc6, 0xf9, 0xbf, 0x39, 0xd0, 0x5e, 0xfd, 0xa8, 0xaf, 0x4e, 0xe0,
+	0xb9, 0xa5, 0xae, 0x97, 0x9a, 0x40, 0x14, 0x5f, 0xa0, 0x54, 0x96, 0xb3, 0xb5, 0xb4, 0x5f, 0x9a,
+	0x18, 0x43, 0xb9, 0x49, 0xad, 0xa5, 0xfd, 0xfc, 0xfc, 0x5c, 0xa2, 0x32, 0x84, 0x2b, 0xd4, 0x5a,
+	0xfa, 0xe8, 0x8a, 0x2b, 0x96, 0xb8, 0x35, 0xe3, 0xce, 0x0d, 0xff, 0x04, 0x6e, 0x8e, 0x14, 0x13,
+	0x6a, 0xa1, 0xd8, 0xf7, 0xa0, 0x99, 0xb2, 0x31, 0xca, 0x8c, 0x85, 0x68, 0x89, 0xcc, 0x1d, 0x84,
+	0x40, 0x55, 0x1b, 0x96, 0x8c, 0x59, 0xfb, 0x5f, 0x43, 0x7b, 0x9e, 0xc4, 0x96, 0xf5, 0xdf, 0xb5,
+	0x8c, 0x3f, 0x80, 0xf6, 0x00, 0x13, 0x54, 0xf8, 0xbf, 0x88, 0x3c, 0x83, 0x83, 0x85, 0x2c, 0xff,
+	0x8d, 0xc9, 0xb7, 0x70, 0xf3, 0x65, 0x2c, 0xf5, 0x59, 0xe4, 0x6e, 0x44, 0x3c, 0x68, 0x48, 0x4c,
+	0x30, 0x54, 0x5c, 0x58, 0x32, 0xa5, 0xed, 0x9f, 0x40, 0x7b, 0x9e, 0xcc, 0xf2, 0x09, 0xa0, 0xaa,
+	0x37, 0xb5, 0xed, 0xbe, 0x91, 0x90, 0x01, 0xfa, 0x0a, 0xc8, 0x90, 0x0b, 0xf5, 0x9c, 0x8b, 0xb7,
+	0x4c, 0xec, 0x78, 0x3b, 0x2e, 0xec, 0x67, 0x3c, 0x3a, 0x9b, 0x5f, 0x50, 0x61, 0xea, 0x7b, 0xcb,
+	0xb8, 0x50, 0xa6, 0x6b, 0x6a, 0xd4, 0xac, 0xb5, 0x4f, 0x4b, 0x85, 0xe9, 0x98, 0x1b, 0xd4, 0xac,
+	0xfd, 0xfb, 0x70, 0x6b, 0x69, 0x57, 0xcb, 0xbe, 0x80, 0x3a, 0x0b, 0xd0, 0x3f, 0x1d, 0xa8, 0x0c,
+	0x79, 0x44, 0x1e, 0x41, 0xa3, 0x50, 0x1d, 0x7b, 0xdd, 0xf7, 0xec, 0xe9, 0xb4, 0x22, 0xf5, 0x28,
+	0x4a, 0x3e, 0x11, 0x21, 0x7e, 0x67, 0x31, 0xb4, 0x44, 0x93, 0x63, 0xa8, 0xca, 0x0c, 0x43, 0xc3,
+	0xb5, 0xd5, 0xff, 0x68, 0xc3, 0x9d, 0x8c, 0x32, 0x0c, 0xa9, 0x01, 0x93, 0xc7, 0x4b, 0x2f, 0xa0,
+	0xd5, 0xff, 0x78, 0x53, 0x98, 0x7d, 0x7b, 0x79, 0x80, 0xff, 0x47, 0x15, 0xf6, 0x6d, 0x32, 0xf2,
+	0x02, 0x60, 0x2e, 0x82, 0xb6, 0x2a, 0x87, 0xab, 0xa9, 0xe6, 0x08, 0x9d, 0xf0, 0xa4, 0xb0, 0xe8,
+	0x42, 0xa8, 0x96, 0x9f, 0x4b, 0x2e, 0xd5, 0x19, 0xaa, 0xb7, 0x5c, 0x5c, 0x59, 0xf9, 0x5b, 0x74,
+	0xe9, 0xaa, 0x68, 0x73, 0x78, 0x3a, 0xb0, 0x3a, 0x53, 0x98, 0xe4, 0x13, 0x78, 0x4f, 0xa0, 0xcc,
+	0x1f, 0x51, 0x12, 0x87, 0x33, 0x53, 0x8a, 0x26, 0x5d, 0x76, 0x92, 0xcf, 0xe1, 0x7d, 0x79, 0xc9,
+	0x04, 0x0e, 0x05, 0x0f, 0x51, 0xca, 0xb3, 0xb2, 0xfe, 0x35, 0x93, 0xed, 0xfa, 0x8f, 0xba, 0x41,
+	0xf5, 0x36, 0xe6, 0xb5, 0xd4, 0xf3, 0x06, 0x2d, 0x6c, 0xf2, 0x14, 0xf6, 0xa7, 0x3c, 0x99, 0x8c,
+	0x51, 0xba, 0xfb, 0x9d, 0xca, 0x96, 0x4b, 0xfc, 0xde, 0x20, 0x69, 0x11, 0x41, 0x5e, 0xc1, 0x8d,
+	0x94, 0x47, 0x38, 0x2a, 0xba, 0xbf, 0x61, 0x32, 0x1c, 0x6d, 0xa9, 0x5e, 0xef, 0x6c, 0x21, 0xe6,
+	0x9b, 0x54, 0x89, 0x19, 0x5d, 0x4a, 0x43, 0x06, 0xf0, 0xa1, 0x42, 0x31, 0x8e, 0x53, 0xa6, 0x62,
+	0x9e, 0xbe, 0x10, 0x2c, 0xc4, 0x21, 0x8a, 0x98, 0x47, 0x23, 0x0c, 0x79, 0x1a, 0x49, 0xb7, 0x69,
+	0x14, 0x6c, 0x33, 0xc8, 0xfb, 0x12, 0x0e, 0xde, 0xd9, 0x48, 0xcb, 0xeb, 0x15, 0xce, 0x0a, 0x79,
+	0xbd, 0xc2, 0x99, 0x96, 0xc5, 0x29, 0x4b, 0x26, 0xe5, 0x44, 0x30, 0xc6, 0x93, 0xbd, 0x47, 0x8e,
+	0x7f, 0x0c, 0xcd, 0xf2, 0xcc, 0xa5, 0xda, 0x38, 0x73, 0xb5, 0xd1, 0x3e, 0x19, 0xff, 0x5a, 0x2a,
+	0x90, 0x5e, 0xfb, 0xbf, 0x3b, 0xd0, 0x2c, 0xdb, 0x8d, 0xfc, 0x08, 0x07, 0x65, 0x7f, 0xe4, 0xae,
+	0x72, 0xcc, 0x3d, 0xd8, 0xb1, 0xc3, 0x6c, 0xe3, 0xbe, 0x9b, 0x67, 0xa9, 0xac, 0x7b, 0x2b, 0x65,
+	0xbd, 0x0d, 0xb5, 0x8c, 0x47, 0xa7, 0x43, 0x3b, 0x1b, 0x72, 0xa3, 0xff, 0x77, 0x05, 0xaa, 0x5a,
+	0x8a, 0x08, 0x42, 0x3d, 0x1f, 0xc7, 0x64, 0xed, 0xd8, 0x5a, 0xfd, 0x0f, 0xe0, 0x05, 0x5b, 0x91,
+	0xcb, 0x83, 0xfd, 0xa1, 0x43, 0x5e, 0x43, 0xcd, 0xcc, 0x05, 0x72, 0xb8, 0x2e, 0x76, 0x65, 0xf6,
+	0x78, 0xdd, 0xed, 0x40, 0xab, 0x43, 0x3f, 0x41, 0x3d, 0x97, 0xfa, 0xf5, 0x47, 0x58, 0x1d, 0x28,
+	0xde, 0xfd, 0x1d, 0x90, 0x36, 0xfd, 0x0f, 0x50, 0xd5, 0xc2, 0xbd, 0x9e, 0xf9, 0xca, 0x8c, 0xf0,
+	0xba, 0xdb, 0x81, 0x36, 0xf5, 0xcf, 0xd0, 0x5a, 0x10, 0x56, 0xf2, 0xe9, 0xfa, 0xe7, 0xb2, 0xaa,
+	0xf9, 0xde, 0x67, 0x3b, 0x61, 0xf3, 0x7d, 0xba, 0xce, 0x43, 0xe7, 0xd9, 0xe3, 0xd7, 0x5f, 0x5c,
+	0xc4, 0xea, 0x72, 0xf2, 0xa6, 0x17, 0xf2, 0x71, 0x80, 0x22, 0xe5, 0x8c, 0x65, 0x2c, 0x30, 0x59,
+	0x82, 0xec, 0xea, 0x22, 0x60, 0x59, 0x1c, 0xac, 0xfe, 0xc7, 0x7c, 0xaa, 0x7f, 0xdf, 0xd4, 0xcd,
+	0xdf, 0xc1, 0xe3, 0x7f, 0x06, 0x00, 0xd9, 0xc2, 0xd4, 0x16, 0x83, 0x0a, 0x00, 0x00,
 }
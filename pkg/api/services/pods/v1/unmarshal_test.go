@@ -29,6 +29,35 @@ spec:
 	assert.True(t, pods[0].Spec.HostNetwork, "Should have host network")
 }
 
+func TestUnmarshalYamlEnv(t *testing.T) {
+	pods, err := UnmarshalYaml([]byte(`
+metadata:
+  name: "foo"
+spec:
+  containers:
+    - name: "foo"
+      image: "docker.io/library/hello-world:latest"
+      env:
+        - FOO=bar
+        - name: LEVEL
+          value: debug
+        - name: TOKEN
+          valueFrom:
+            secretKeyRef:
+              name: api
+              key: token
+`))
+
+	assert.NoError(t, err, "Unable unmarshal test yaml")
+
+	container := pods[0].Spec.Containers[0]
+	assert.Equal(t, []string{"FOO=bar", "LEVEL=debug"}, container.Env)
+	assert.Len(t, container.EnvVars, 1)
+	assert.Equal(t, "TOKEN", container.EnvVars[0].Name)
+	assert.Equal(t, "api", container.EnvVars[0].ValueFrom.SecretKeyRef.Name)
+	assert.Equal(t, "token", container.EnvVars[0].ValueFrom.SecretKeyRef.Key)
+}
+
 func TestUnmarshalMultiDocumentYaml(t *testing.T) {
 	pods, err := UnmarshalYaml([]byte(`
 metadata:
@@ -0,0 +1,1133 @@
+// Code generated by protoc-gen-go.
+// source: services/volumes/v1/volumes.proto
+// DO NOT EDIT!
+
+/*
+Package volumes is a generated protocol buffer package.
+
+It is generated from these files:
+	services/volumes/v1/volumes.proto
+
+It has these top-level messages:
+	CreateVolumeRequest
+	CreateVolumeResponse
+	GetVolumeRequest
+	GetVolumeResponse
+	ListVolumesRequest
+	ListVolumesResponse
+	DeleteVolumeRequest
+	DeleteVolumeResponse
+	VolumeUsageRequest
+	VolumeUsageResponse
+	ExportVolumeRequest
+	VolumeData
+	ImportVolumeRequest
+	ImportVolumeResponse
+	SyncVolumeRequest
+	SyncVolumeResponse
+	SyncFile
+	SyncResult
+	Volume
+	VolumeSpec
+	VolumeStatus
+*/
+package volumes
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import eliot_core "github.com/ernoaapa/eliot/pkg/api/core"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type CreateVolumeRequest struct {
+	Volume *Volume `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
+}
+
+func (m *CreateVolumeRequest) Reset()                    { *m = CreateVolumeRequest{} }
+func (m *CreateVolumeRequest) String() string            { return proto.CompactTextString(m) }
+func (*CreateVolumeRequest) ProtoMessage()               {}
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{0} }
+
+func (m *CreateVolumeRequest) GetVolume() *Volume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type CreateVolumeResponse struct {
+	Volume *Volume `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
+}
+
+func (m *CreateVolumeResponse) Reset()                    { *m = CreateVolumeResponse{} }
+func (m *CreateVolumeResponse) String() string            { return proto.CompactTextString(m) }
+func (*CreateVolumeResponse) ProtoMessage()               {}
+func (*CreateVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *CreateVolumeResponse) GetVolume() *Volume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type GetVolumeRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *GetVolumeRequest) Reset()                    { *m = GetVolumeRequest{} }
+func (m *GetVolumeRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetVolumeRequest) ProtoMessage()               {}
+func (*GetVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *GetVolumeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *GetVolumeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetVolumeResponse struct {
+	Volume *Volume `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
+}
+
+func (m *GetVolumeResponse) Reset()                    { *m = GetVolumeResponse{} }
+func (m *GetVolumeResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetVolumeResponse) ProtoMessage()               {}
+func (*GetVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *GetVolumeResponse) GetVolume() *Volume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type ListVolumesRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+}
+
+func (m *ListVolumesRequest) Reset()                    { *m = ListVolumesRequest{} }
+func (m *ListVolumesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListVolumesRequest) ProtoMessage()               {}
+func (*ListVolumesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *ListVolumesRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type ListVolumesResponse struct {
+	Volumes []*Volume `protobuf:"bytes,1,rep,name=volumes" json:"volumes,omitempty"`
+}
+
+func (m *ListVolumesResponse) Reset()                    { *m = ListVolumesResponse{} }
+func (m *ListVolumesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListVolumesResponse) ProtoMessage()               {}
+func (*ListVolumesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *ListVolumesResponse) GetVolumes() []*Volume {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+type DeleteVolumeRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *DeleteVolumeRequest) Reset()                    { *m = DeleteVolumeRequest{} }
+func (m *DeleteVolumeRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteVolumeRequest) ProtoMessage()               {}
+func (*DeleteVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *DeleteVolumeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *DeleteVolumeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteVolumeResponse struct {
+	Volume *Volume `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
+}
+
+func (m *DeleteVolumeResponse) Reset()                    { *m = DeleteVolumeResponse{} }
+func (m *DeleteVolumeResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteVolumeResponse) ProtoMessage()               {}
+func (*DeleteVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *DeleteVolumeResponse) GetVolume() *Volume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type VolumeUsageRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+}
+
+func (m *VolumeUsageRequest) Reset()                    { *m = VolumeUsageRequest{} }
+func (m *VolumeUsageRequest) String() string            { return proto.CompactTextString(m) }
+func (*VolumeUsageRequest) ProtoMessage()               {}
+func (*VolumeUsageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *VolumeUsageRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *VolumeUsageRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type VolumeUsageResponse struct {
+	// Bytes used in the volume
+	Used uint64 `protobuf:"varint,1,opt,name=used" json:"used,omitempty"`
+	// Size limit of the volume in bytes, zero if unlimited
+	Size uint64 `protobuf:"varint,2,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *VolumeUsageResponse) Reset()                    { *m = VolumeUsageResponse{} }
+func (m *VolumeUsageResponse) String() string            { return proto.CompactTextString(m) }
+func (*VolumeUsageResponse) ProtoMessage()               {}
+func (*VolumeUsageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *VolumeUsageResponse) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func (m *VolumeUsageResponse) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type ExportVolumeRequest struct {
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Compress the tar stream with gzip
+	Gzip bool `protobuf:"varint,3,opt,name=gzip" json:"gzip,omitempty"`
+	// Export only these paths, relative to the volume root. Empty exports everything
+	Paths []string `protobuf:"bytes,4,rep,name=paths" json:"paths,omitempty"`
+}
+
+func (m *ExportVolumeRequest) Reset()                    { *m = ExportVolumeRequest{} }
+func (m *ExportVolumeRequest) String() string            { return proto.CompactTextString(m) }
+func (*ExportVolumeRequest) ProtoMessage()               {}
+func (*ExportVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *ExportVolumeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ExportVolumeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ExportVolumeRequest) GetGzip() bool {
+	if m != nil {
+		return m.Gzip
+	}
+	return false
+}
+
+func (m *ExportVolumeRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+type VolumeData struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *VolumeData) Reset()                    { *m = VolumeData{} }
+func (m *VolumeData) String() string            { return proto.CompactTextString(m) }
+func (*VolumeData) ProtoMessage()               {}
+func (*VolumeData) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *VolumeData) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImportVolumeRequest struct {
+	// Target volume namespace and name, required in the first message.
+	// The volume get created if it doesn't exist.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Chunk of the tar stream, optionally gzip compressed
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *ImportVolumeRequest) Reset()                    { *m = ImportVolumeRequest{} }
+func (m *ImportVolumeRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImportVolumeRequest) ProtoMessage()               {}
+func (*ImportVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *ImportVolumeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ImportVolumeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportVolumeRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ImportVolumeResponse struct {
+	Volume *Volume `protobuf:"bytes,1,opt,name=volume" json:"volume,omitempty"`
+}
+
+func (m *ImportVolumeResponse) Reset()                    { *m = ImportVolumeResponse{} }
+func (m *ImportVolumeResponse) String() string            { return proto.CompactTextString(m) }
+func (*ImportVolumeResponse) ProtoMessage()               {}
+func (*ImportVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *ImportVolumeResponse) GetVolume() *Volume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+type SyncVolumeRequest struct {
+	// Target volume namespace and name, required in the first message.
+	// The volume get created if it doesn't exist.
+	Namespace string `protobuf:"bytes,1,opt,name=namespace" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// Starts writing file, directory or symlink. The file content follows in the data fields.
+	File *SyncFile `protobuf:"bytes,3,opt,name=file" json:"file,omitempty"`
+	// Chunk of the file content
+	Data []byte `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	// Path to delete, relative to the volume root
+	Delete string `protobuf:"bytes,5,opt,name=delete" json:"delete,omitempty"`
+	// Ends the set of changes, the server responds with the result
+	Commit bool `protobuf:"varint,6,opt,name=commit" json:"commit,omitempty"`
+}
+
+func (m *SyncVolumeRequest) Reset()                    { *m = SyncVolumeRequest{} }
+func (m *SyncVolumeRequest) String() string            { return proto.CompactTextString(m) }
+func (*SyncVolumeRequest) ProtoMessage()               {}
+func (*SyncVolumeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *SyncVolumeRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *SyncVolumeRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SyncVolumeRequest) GetFile() *SyncFile {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (m *SyncVolumeRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *SyncVolumeRequest) GetDelete() string {
+	if m != nil {
+		return m.Delete
+	}
+	return ""
+}
+
+func (m *SyncVolumeRequest) GetCommit() bool {
+	if m != nil {
+		return m.Commit
+	}
+	return false
+}
+
+type SyncVolumeResponse struct {
+	// Current volume content, sent in batches when the sync starts
+	Files []*SyncFile `protobuf:"bytes,1,rep,name=files" json:"files,omitempty"`
+	// All volume content is sent, the server is ready to receive changes
+	Ready bool `protobuf:"varint,2,opt,name=ready" json:"ready,omitempty"`
+	// Result of the committed changes
+	Result *SyncResult `protobuf:"bytes,3,opt,name=result" json:"result,omitempty"`
+}
+
+func (m *SyncVolumeResponse) Reset()                    { *m = SyncVolumeResponse{} }
+func (m *SyncVolumeResponse) String() string            { return proto.CompactTextString(m) }
+func (*SyncVolumeResponse) ProtoMessage()               {}
+func (*SyncVolumeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+
+func (m *SyncVolumeResponse) GetFiles() []*SyncFile {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *SyncVolumeResponse) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *SyncVolumeResponse) GetResult() *SyncResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+type SyncFile struct {
+	// Path relative to the volume root, slash separated
+	Path string `protobuf:"bytes,1,opt,name=path" json:"path,omitempty"`
+	// File mode and type bits
+	Mode uint32 `protobuf:"varint,2,opt,name=mode" json:"mode,omitempty"`
+	// Modification time as unix timestamp in nanoseconds
+	ModTime int64 `protobuf:"varint,3,opt,name=modTime" json:"modTime,omitempty"`
+	Size    int64 `protobuf:"varint,4,opt,name=size" json:"size,omitempty"`
+	// SHA-256 checksum of the file content, hex encoded
+	Hash string `protobuf:"bytes,5,opt,name=hash" json:"hash,omitempty"`
+	// Symlink target
+	Link string `protobuf:"bytes,6,opt,name=link" json:"link,omitempty"`
+}
+
+func (m *SyncFile) Reset()                    { *m = SyncFile{} }
+func (m *SyncFile) String() string            { return proto.CompactTextString(m) }
+func (*SyncFile) ProtoMessage()               {}
+func (*SyncFile) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+
+func (m *SyncFile) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SyncFile) GetMode() uint32 {
+	if m != nil {
+		return m.Mode
+	}
+	return 0
+}
+
+func (m *SyncFile) GetModTime() int64 {
+	if m != nil {
+		return m.ModTime
+	}
+	return 0
+}
+
+func (m *SyncFile) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *SyncFile) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+func (m *SyncFile) GetLink() string {
+	if m != nil {
+		return m.Link
+	}
+	return ""
+}
+
+type SyncResult struct {
+	// Count of written files, directories and symlinks
+	Written int32 `protobuf:"varint,1,opt,name=written" json:"written,omitempty"`
+	Deleted int32 `protobuf:"varint,2,opt,name=deleted" json:"deleted,omitempty"`
+	// Count of written file content bytes
+	Bytes  int64    `protobuf:"varint,3,opt,name=bytes" json:"bytes,omitempty"`
+	Errors []string `protobuf:"bytes,4,rep,name=errors" json:"errors,omitempty"`
+}
+
+func (m *SyncResult) Reset()                    { *m = SyncResult{} }
+func (m *SyncResult) String() string            { return proto.CompactTextString(m) }
+func (*SyncResult) ProtoMessage()               {}
+func (*SyncResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+
+func (m *SyncResult) GetWritten() int32 {
+	if m != nil {
+		return m.Written
+	}
+	return 0
+}
+
+func (m *SyncResult) GetDeleted() int32 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
+func (m *SyncResult) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *SyncResult) GetErrors() []string {
+	if m != nil {
+		return m.Errors
+	}
+	return nil
+}
+
+type Volume struct {
+	Metadata *eliot_core.ResourceMetadata `protobuf:"bytes,1,opt,name=metadata" json:"metadata,omitempty"`
+	Spec     *VolumeSpec                  `protobuf:"bytes,2,opt,name=spec" json:"spec,omitempty"`
+	Status   *VolumeStatus                `protobuf:"bytes,3,opt,name=status" json:"status,omitempty"`
+}
+
+func (m *Volume) Reset()                    { *m = Volume{} }
+func (m *Volume) String() string            { return proto.CompactTextString(m) }
+func (*Volume) ProtoMessage()               {}
+func (*Volume) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+
+func (m *Volume) GetMetadata() *eliot_core.ResourceMetadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *Volume) GetSpec() *VolumeSpec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *Volume) GetStatus() *VolumeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type VolumeSpec struct {
+	// Size limit in bytes, zero if unlimited
+	Size uint64 `protobuf:"varint,1,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *VolumeSpec) Reset()                    { *m = VolumeSpec{} }
+func (m *VolumeSpec) String() string            { return proto.CompactTextString(m) }
+func (*VolumeSpec) ProtoMessage()               {}
+func (*VolumeSpec) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+
+func (m *VolumeSpec) GetSize() uint64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type VolumeStatus struct {
+	// Creation time as unix timestamp
+	CreatedAt int64 `protobuf:"varint,1,opt,name=createdAt" json:"createdAt,omitempty"`
+	// Path to the volume data in the node
+	Path string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	// Bytes used in the volume
+	Usage uint64 `protobuf:"varint,3,opt,name=usage" json:"usage,omitempty"`
+	// Names of the pods what use the volume
+	UsedBy []string `protobuf:"bytes,4,rep,name=usedBy" json:"usedBy,omitempty"`
+}
+
+func (m *VolumeStatus) Reset()                    { *m = VolumeStatus{} }
+func (m *VolumeStatus) String() string            { return proto.CompactTextString(m) }
+func (*VolumeStatus) ProtoMessage()               {}
+func (*VolumeStatus) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *VolumeStatus) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *VolumeStatus) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *VolumeStatus) GetUsage() uint64 {
+	if m != nil {
+		return m.Usage
+	}
+	return 0
+}
+
+func (m *VolumeStatus) GetUsedBy() []string {
+	if m != nil {
+		return m.UsedBy
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*CreateVolumeRequest)(nil), "eliot.services.volumes.v1.CreateVolumeRequest")
+	proto.RegisterType((*CreateVolumeResponse)(nil), "eliot.services.volumes.v1.CreateVolumeResponse")
+	proto.RegisterType((*GetVolumeRequest)(nil), "eliot.services.volumes.v1.GetVolumeRequest")
+	proto.RegisterType((*GetVolumeResponse)(nil), "eliot.services.volumes.v1.GetVolumeResponse")
+	proto.RegisterType((*ListVolumesRequest)(nil), "eliot.services.volumes.v1.ListVolumesRequest")
+	proto.RegisterType((*ListVolumesResponse)(nil), "eliot.services.volumes.v1.ListVolumesResponse")
+	proto.RegisterType((*DeleteVolumeRequest)(nil), "eliot.services.volumes.v1.DeleteVolumeRequest")
+	proto.RegisterType((*DeleteVolumeResponse)(nil), "eliot.services.volumes.v1.DeleteVolumeResponse")
+	proto.RegisterType((*VolumeUsageRequest)(nil), "eliot.services.volumes.v1.VolumeUsageRequest")
+	proto.RegisterType((*VolumeUsageResponse)(nil), "eliot.services.volumes.v1.VolumeUsageResponse")
+	proto.RegisterType((*ExportVolumeRequest)(nil), "eliot.services.volumes.v1.ExportVolumeRequest")
+	proto.RegisterType((*VolumeData)(nil), "eliot.services.volumes.v1.VolumeData")
+	proto.RegisterType((*ImportVolumeRequest)(nil), "eliot.services.volumes.v1.ImportVolumeRequest")
+	proto.RegisterType((*ImportVolumeResponse)(nil), "eliot.services.volumes.v1.ImportVolumeResponse")
+	proto.RegisterType((*SyncVolumeRequest)(nil), "eliot.services.volumes.v1.SyncVolumeRequest")
+	proto.RegisterType((*SyncVolumeResponse)(nil), "eliot.services.volumes.v1.SyncVolumeResponse")
+	proto.RegisterType((*SyncFile)(nil), "eliot.services.volumes.v1.SyncFile")
+	proto.RegisterType((*SyncResult)(nil), "eliot.services.volumes.v1.SyncResult")
+	proto.RegisterType((*Volume)(nil), "eliot.services.volumes.v1.Volume")
+	proto.RegisterType((*VolumeSpec)(nil), "eliot.services.volumes.v1.VolumeSpec")
+	proto.RegisterType((*VolumeStatus)(nil), "eliot.services.volumes.v1.VolumeStatus")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for Volumes service
+
+type VolumesClient interface {
+	Create(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error)
+	Get(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*GetVolumeResponse, error)
+	List(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error)
+	Delete(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error)
+	Usage(ctx context.Context, in *VolumeUsageRequest, opts ...grpc.CallOption) (*VolumeUsageResponse, error)
+	Export(ctx context.Context, in *ExportVolumeRequest, opts ...grpc.CallOption) (Volumes_ExportClient, error)
+	Import(ctx context.Context, opts ...grpc.CallOption) (Volumes_ImportClient, error)
+	Sync(ctx context.Context, opts ...grpc.CallOption) (Volumes_SyncClient, error)
+}
+
+type volumesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewVolumesClient(cc *grpc.ClientConn) VolumesClient {
+	return &volumesClient{cc}
+}
+
+func (c *volumesClient) Create(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*CreateVolumeResponse, error) {
+	out := new(CreateVolumeResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.volumes.v1.Volumes/Create", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) Get(ctx context.Context, in *GetVolumeRequest, opts ...grpc.CallOption) (*GetVolumeResponse, error) {
+	out := new(GetVolumeResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.volumes.v1.Volumes/Get", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) List(ctx context.Context, in *ListVolumesRequest, opts ...grpc.CallOption) (*ListVolumesResponse, error) {
+	out := new(ListVolumesResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.volumes.v1.Volumes/List", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) Delete(ctx context.Context, in *DeleteVolumeRequest, opts ...grpc.CallOption) (*DeleteVolumeResponse, error) {
+	out := new(DeleteVolumeResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.volumes.v1.Volumes/Delete", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) Usage(ctx context.Context, in *VolumeUsageRequest, opts ...grpc.CallOption) (*VolumeUsageResponse, error) {
+	out := new(VolumeUsageResponse)
+	err := grpc.Invoke(ctx, "/eliot.services.volumes.v1.Volumes/Usage", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) Export(ctx context.Context, in *ExportVolumeRequest, opts ...grpc.CallOption) (Volumes_ExportClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Volumes_serviceDesc.Streams[0], c.cc, "/eliot.services.volumes.v1.Volumes/Export", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volumesExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Volumes_ExportClient interface {
+	Recv() (*VolumeData, error)
+	grpc.ClientStream
+}
+
+type volumesExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *volumesExportClient) Recv() (*VolumeData, error) {
+	m := new(VolumeData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *volumesClient) Import(ctx context.Context, opts ...grpc.CallOption) (Volumes_ImportClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Volumes_serviceDesc.Streams[1], c.cc, "/eliot.services.volumes.v1.Volumes/Import", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volumesImportClient{stream}
+	return x, nil
+}
+
+type Volumes_ImportClient interface {
+	Send(*ImportVolumeRequest) error
+	CloseAndRecv() (*ImportVolumeResponse, error)
+	grpc.ClientStream
+}
+
+type volumesImportClient struct {
+	grpc.ClientStream
+}
+
+func (x *volumesImportClient) Send(m *ImportVolumeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *volumesImportClient) CloseAndRecv() (*ImportVolumeResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportVolumeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *volumesClient) Sync(ctx context.Context, opts ...grpc.CallOption) (Volumes_SyncClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Volumes_serviceDesc.Streams[2], c.cc, "/eliot.services.volumes.v1.Volumes/Sync", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &volumesSyncClient{stream}
+	return x, nil
+}
+
+type Volumes_SyncClient interface {
+	Send(*SyncVolumeRequest) error
+	Recv() (*SyncVolumeResponse, error)
+	grpc.ClientStream
+}
+
+type volumesSyncClient struct {
+	grpc.ClientStream
+}
+
+func (x *volumesSyncClient) Send(m *SyncVolumeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *volumesSyncClient) Recv() (*SyncVolumeResponse, error) {
+	m := new(SyncVolumeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Server API for Volumes service
+
+type VolumesServer interface {
+	Create(context.Context, *CreateVolumeRequest) (*CreateVolumeResponse, error)
+	Get(context.Context, *GetVolumeRequest) (*GetVolumeResponse, error)
+	List(context.Context, *ListVolumesRequest) (*ListVolumesResponse, error)
+	Delete(context.Context, *DeleteVolumeRequest) (*DeleteVolumeResponse, error)
+	Usage(context.Context, *VolumeUsageRequest) (*VolumeUsageResponse, error)
+	Export(*ExportVolumeRequest, Volumes_ExportServer) error
+	Import(Volumes_ImportServer) error
+	Sync(Volumes_SyncServer) error
+}
+
+func RegisterVolumesServer(s *grpc.Server, srv VolumesServer) {
+	s.RegisterService(&_Volumes_serviceDesc, srv)
+}
+
+func _Volumes_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.volumes.v1.Volumes/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).Create(ctx, req.(*CreateVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.volumes.v1.Volumes/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).Get(ctx, req.(*GetVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.volumes.v1.Volumes/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).List(ctx, req.(*ListVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.volumes.v1.Volumes/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).Delete(ctx, req.(*DeleteVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_Usage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).Usage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/eliot.services.volumes.v1.Volumes/Usage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).Usage(ctx, req.(*VolumeUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportVolumeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VolumesServer).Export(m, &volumesExportServer{stream})
+}
+
+type Volumes_ExportServer interface {
+	Send(*VolumeData) error
+	grpc.ServerStream
+}
+
+type volumesExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *volumesExportServer) Send(m *VolumeData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Volumes_Import_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VolumesServer).Import(&volumesImportServer{stream})
+}
+
+type Volumes_ImportServer interface {
+	SendAndClose(*ImportVolumeResponse) error
+	Recv() (*ImportVolumeRequest, error)
+	grpc.ServerStream
+}
+
+type volumesImportServer struct {
+	grpc.ServerStream
+}
+
+func (x *volumesImportServer) SendAndClose(m *ImportVolumeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *volumesImportServer) Recv() (*ImportVolumeRequest, error) {
+	m := new(ImportVolumeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Volumes_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(VolumesServer).Sync(&volumesSyncServer{stream})
+}
+
+type Volumes_SyncServer interface {
+	Send(*SyncVolumeResponse) error
+	Recv() (*SyncVolumeRequest, error)
+	grpc.ServerStream
+}
+
+type volumesSyncServer struct {
+	grpc.ServerStream
+}
+
+func (x *volumesSyncServer) Send(m *SyncVolumeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *volumesSyncServer) Recv() (*SyncVolumeRequest, error) {
+	m := new(SyncVolumeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _Volumes_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "eliot.services.volumes.v1.Volumes",
+	HandlerType: (*VolumesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Volumes_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Volumes_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Volumes_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Volumes_Delete_Handler,
+		},
+		{
+			MethodName: "Usage",
+			Handler:    _Volumes_Usage_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _Volumes_Export_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Import",
+			Handler:       _Volumes_Import_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Sync",
+			Handler:       _Volumes_Sync_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "services/volumes/v1/volumes.proto",
+}
+
+func init() { proto.RegisterFile("services/volumes/v1/volumes.proto", fileDescriptor0) }
+
+var fileDescriptor0 = []byte{
+	// 875 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5f, 0x6f, 0xe3, 0x44,
+	0x10, 0x97, 0x1b, 0xc7, 0x4d, 0xe6, 0x0e, 0x89, 0xdb, 0x44, 0xc8, 0x58, 0xf7, 0x90, 0x33, 0x42,
+	0x44, 0xe2, 0xce, 0xbe, 0x0b, 0x0f, 0x70, 0xaa, 0xaa, 0x8a, 0x52, 0x5a, 0x55, 0x02, 0x44, 0xb7,
+	0xc0, 0x03, 0xbc, 0xb0, 0xb5, 0xb7, 0x89, 0xd5, 0xf8, 0x4f, 0xbd, 0xeb, 0x42, 0xfa, 0x09, 0xf8,
+	0x20, 0x7c, 0x0e, 0x1e, 0x78, 0xe3, 0x5b, 0xa1, 0x9d, 0x5d, 0xe7, 0x4f, 0x1b, 0x12, 0xa3, 0xdc,
+	0x53, 0x66, 0x26, 0x33, 0xf3, 0xfb, 0xcd, 0xcc, 0xee, 0x8e, 0xe1, 0x85, 0xe0, 0xe5, 0x5d, 0x12,
+	0x71, 0x11, 0xde, 0xe5, 0xd3, 0x2a, 0x55, 0xbf, 0x6f, 0x6a, 0x31, 0x28, 0xca, 0x5c, 0xe6, 0xe4,
+	0x43, 0x3e, 0x4d, 0x72, 0x19, 0xd4, 0x8e, 0x41, 0xfd, 0xef, 0xdd, 0x1b, 0xaf, 0x17, 0xe5, 0x25,
+	0x0f, 0x53, 0x2e, 0x59, 0xcc, 0x24, 0xd3, 0xfe, 0xfe, 0xf7, 0xd0, 0xfb, 0xaa, 0xe4, 0x4c, 0xf2,
+	0x9f, 0xd0, 0x91, 0xf2, 0xdb, 0x8a, 0x0b, 0x49, 0xde, 0x82, 0xa3, 0x23, 0x5d, 0x6b, 0x60, 0x0d,
+	0x9f, 0x8c, 0x5e, 0x04, 0xff, 0x99, 0x37, 0x30, 0x91, 0x26, 0xc0, 0xbf, 0x80, 0xfe, 0x6a, 0x46,
+	0x51, 0xe4, 0x99, 0xe0, 0xbb, 0xa4, 0x3c, 0x81, 0xf7, 0xcf, 0xb8, 0x5c, 0x65, 0xf8, 0x1c, 0xba,
+	0x19, 0x4b, 0xb9, 0x28, 0x58, 0xa4, 0x33, 0x76, 0xe9, 0xc2, 0x40, 0x08, 0xd8, 0x4a, 0x71, 0xf7,
+	0xf0, 0x0f, 0x94, 0xfd, 0xef, 0xe0, 0xd9, 0x52, 0x96, 0xdd, 0x59, 0x8d, 0x80, 0x7c, 0x93, 0x08,
+	0x93, 0x50, 0x34, 0xe2, 0xe5, 0x53, 0xe8, 0xad, 0xc4, 0x18, 0x16, 0x07, 0xb0, 0x6f, 0x70, 0x5c,
+	0x6b, 0xd0, 0x6a, 0x46, 0xa3, 0x8e, 0xf0, 0xcf, 0xa0, 0x77, 0xc2, 0xa7, 0xfc, 0xe1, 0x08, 0xff,
+	0x7f, 0x83, 0x2e, 0xa0, 0xbf, 0x9a, 0x68, 0xf7, 0x1e, 0x9d, 0x02, 0xd1, 0x96, 0x1f, 0x05, 0x1b,
+	0xef, 0x40, 0xed, 0x10, 0x7a, 0x2b, 0x79, 0x0c, 0x33, 0x02, 0x76, 0x25, 0x78, 0x8c, 0x39, 0x6c,
+	0x8a, 0xb2, 0xb2, 0x89, 0xe4, 0x5e, 0x87, 0xdb, 0x14, 0x65, 0xff, 0x16, 0x7a, 0x5f, 0xff, 0x5e,
+	0xe4, 0xe5, 0xae, 0x67, 0x48, 0xd9, 0xc6, 0xf7, 0x49, 0xe1, 0xb6, 0x06, 0xd6, 0xb0, 0x43, 0x51,
+	0x26, 0x7d, 0x68, 0x17, 0x4c, 0x4e, 0x84, 0x6b, 0x0f, 0x5a, 0xc3, 0x2e, 0xd5, 0x8a, 0x3f, 0x00,
+	0xd0, 0x60, 0x27, 0x4c, 0x32, 0x15, 0xa7, 0x2e, 0x1d, 0x82, 0x3c, 0xa5, 0x28, 0xfb, 0xbf, 0x40,
+	0xef, 0x3c, 0x7d, 0x47, 0xa4, 0x30, 0x79, 0x6b, 0x29, 0xf9, 0x05, 0xf4, 0x57, 0x93, 0xef, 0x3e,
+	0xcb, 0xbf, 0x2d, 0x78, 0x76, 0x39, 0xcb, 0xa2, 0x5d, 0xe9, 0x7e, 0x0e, 0xf6, 0x75, 0x32, 0xe5,
+	0x48, 0xf7, 0xc9, 0xe8, 0xa3, 0x0d, 0x04, 0x14, 0xda, 0x69, 0x32, 0xe5, 0x14, 0x03, 0xe6, 0x75,
+	0xda, 0x8b, 0x3a, 0xc9, 0x07, 0xe0, 0xc4, 0x78, 0x66, 0xdd, 0x36, 0x42, 0x18, 0x4d, 0xd9, 0xa3,
+	0x3c, 0x4d, 0x13, 0xe9, 0x3a, 0x38, 0x2a, 0xa3, 0xf9, 0x7f, 0x5a, 0x40, 0x96, 0x8b, 0x98, 0xb7,
+	0xa5, 0xad, 0x20, 0xea, 0xeb, 0xd7, 0x88, 0x94, 0x8e, 0x50, 0xe3, 0x2f, 0x39, 0x8b, 0x67, 0x58,
+	0x63, 0x87, 0x6a, 0x85, 0x1c, 0x82, 0x53, 0x72, 0x51, 0x4d, 0xa5, 0x29, 0xf3, 0xe3, 0x2d, 0x19,
+	0x29, 0x3a, 0x53, 0x13, 0xe4, 0xff, 0x61, 0x41, 0xa7, 0x06, 0x52, 0x75, 0xab, 0x33, 0x65, 0xba,
+	0x8b, 0xb2, 0xb2, 0xa5, 0x79, 0xac, 0x1b, 0xfb, 0x1e, 0x45, 0x99, 0xb8, 0xb0, 0x9f, 0xe6, 0xf1,
+	0x0f, 0x49, 0xaa, 0x7b, 0xdb, 0xa2, 0xb5, 0x3a, 0xbf, 0x13, 0x36, 0x9a, 0x51, 0x56, 0xb6, 0x09,
+	0x13, 0x13, 0xd3, 0x37, 0x94, 0x95, 0x6d, 0x9a, 0x64, 0x37, 0xd8, 0xb3, 0x2e, 0x45, 0xd9, 0xcf,
+	0x00, 0x16, 0x04, 0x15, 0xc6, 0x6f, 0x65, 0x22, 0x25, 0xcf, 0x90, 0x4e, 0x9b, 0xd6, 0xaa, 0xfa,
+	0x47, 0xf7, 0x3e, 0x46, 0x52, 0x6d, 0x5a, 0xab, 0xaa, 0x43, 0x57, 0x33, 0xc9, 0x85, 0x61, 0xa5,
+	0x15, 0x35, 0x21, 0x5e, 0x96, 0x79, 0x59, 0xdf, 0x1b, 0xa3, 0xf9, 0x7f, 0x59, 0xe0, 0xe8, 0xe9,
+	0x90, 0x2f, 0xa0, 0x53, 0xaf, 0x2b, 0x73, 0x5c, 0x9f, 0x9b, 0x36, 0xaa, 0x55, 0x16, 0x50, 0x2e,
+	0xf2, 0xaa, 0x8c, 0xf8, 0xb7, 0xc6, 0x87, 0xce, 0xbd, 0xc9, 0x5b, 0xb0, 0x45, 0xc1, 0x23, 0x77,
+	0x6f, 0x6b, 0xf3, 0x35, 0xd4, 0x65, 0xc1, 0x23, 0x8a, 0x21, 0xe4, 0x08, 0x1c, 0x21, 0x99, 0xac,
+	0x84, 0x99, 0xdc, 0x27, 0xdb, 0x83, 0xd1, 0x9d, 0x9a, 0xb0, 0xc5, 0xcd, 0x57, 0x49, 0xe7, 0xad,
+	0xb7, 0x96, 0x9e, 0xa3, 0x0c, 0x9e, 0x2e, 0x47, 0xaa, 0x3b, 0x14, 0xe1, 0xca, 0x8c, 0xbf, 0x94,
+	0xe8, 0xd8, 0xa2, 0x0b, 0xc3, 0x7c, 0xfc, 0x7b, 0x4b, 0xe3, 0xef, 0x43, 0xbb, 0x52, 0x2f, 0x21,
+	0x72, 0xb4, 0xa9, 0x56, 0x54, 0x4b, 0xd5, 0x13, 0x78, 0x3c, 0xab, 0x5b, 0xaa, 0xb5, 0xd1, 0x3f,
+	0x0e, 0xec, 0x6b, 0x40, 0x41, 0x12, 0x70, 0xf4, 0x7a, 0x26, 0xc1, 0x86, 0xc2, 0xd6, 0x7c, 0x13,
+	0x78, 0x61, 0x63, 0x7f, 0x73, 0xa9, 0x7e, 0x85, 0xd6, 0x19, 0x97, 0xe4, 0xd3, 0x0d, 0x71, 0x0f,
+	0xd7, 0xba, 0xf7, 0xb2, 0x99, 0xb3, 0x41, 0xe0, 0x60, 0xab, 0x75, 0x4a, 0x5e, 0x6d, 0x88, 0x7a,
+	0xbc, 0xa3, 0xbd, 0xa0, 0xa9, 0xbb, 0x81, 0x49, 0xc0, 0xd1, 0x8b, 0x71, 0x63, 0xcf, 0xd6, 0x2c,
+	0x61, 0x2f, 0x6c, 0xec, 0x6f, 0xa0, 0xae, 0xa1, 0x8d, 0x2b, 0x6e, 0x63, 0x49, 0x8f, 0x57, 0xaa,
+	0x17, 0x34, 0x75, 0x37, 0x38, 0x0c, 0x1c, 0xbd, 0x11, 0x37, 0x96, 0xb4, 0x66, 0x69, 0x7a, 0xdb,
+	0x2f, 0x93, 0xda, 0x78, 0xaf, 0x2d, 0x72, 0x03, 0xce, 0x79, 0xba, 0x15, 0x62, 0xcd, 0x0a, 0xf4,
+	0xc2, 0xc6, 0xfe, 0xba, 0x9a, 0xa1, 0x45, 0xc6, 0x60, 0xab, 0x57, 0x8a, 0xbc, 0xdc, 0xf2, 0xce,
+	0xae, 0x02, 0xbd, 0x6a, 0xe8, 0x5d, 0xc3, 0xbc, 0xb6, 0x8e, 0x8f, 0x7e, 0x3e, 0x1c, 0x27, 0x72,
+	0x52, 0x5d, 0x05, 0x51, 0x9e, 0x86, 0xbc, 0xcc, 0x72, 0xc6, 0x0a, 0x16, 0x62, 0x9e, 0xb0, 0xb8,
+	0x19, 0x87, 0xac, 0x48, 0xc2, 0x35, 0xdf, 0xe9, 0x07, 0x46, 0xbc, 0x72, 0xf0, 0xc3, 0xfb, 0xb3,
+	0x7f, 0x07, 0x00, 0x3c, 0x3f, 0x41, 0xba, 0xcd, 0x0b, 0x00, 0x00,
+}
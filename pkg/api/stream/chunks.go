@@ -0,0 +1,46 @@
+package stream
+
+import (
+	"bytes"
+)
+
+// ChunkReader is io.Reader implementation what reads bytes from chunks received from RPC stream
+type ChunkReader struct {
+	buffer bytes.Buffer
+	recv   func() ([]byte, error)
+}
+
+// NewChunkReader creates new ChunkReader what receives the chunks with recv function
+func NewChunkReader(recv func() ([]byte, error)) *ChunkReader {
+	return &ChunkReader{recv: recv}
+}
+
+// Read reads bytes from the buffered chunk or receives next one from the stream
+func (r *ChunkReader) Read(p []byte) (n int, err error) {
+	for r.buffer.Len() == 0 {
+		chunk, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buffer.Write(chunk)
+	}
+	return r.buffer.Read(p)
+}
+
+// ChunkWriter is io.Writer implementation what sends each write as chunk to RPC stream
+type ChunkWriter struct {
+	send func([]byte) error
+}
+
+// NewChunkWriter creates new ChunkWriter what sends the chunks with send function
+func NewChunkWriter(send func([]byte) error) *ChunkWriter {
+	return &ChunkWriter{send: send}
+}
+
+// Write sends bytes to the RPC stream
+func (w *ChunkWriter) Write(p []byte) (n int, err error) {
+	if err := w.send(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -5,22 +5,41 @@ import (
 	"io"
 
 	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+	"github.com/ernoaapa/eliot/pkg/term"
 	"github.com/pkg/errors"
 )
 
 // PipeStdout reads stdout from grpc stream and writes it to stdout/stderr
 func PipeStdout(stream StdoutStreamClient, stdout, stderr io.Writer) error {
+	_, err := pipeStdout(stream, stdout, stderr)
+	return err
+}
+
+// PipeExecStdout reads stdout from grpc Exec stream and writes it to stdout/stderr.
+// Returns the process exit code what the server sends as the last message
+func PipeExecStdout(stream StdoutStreamClient, stdout, stderr io.Writer) (int32, error) {
+	exit, err := pipeStdout(stream, stdout, stderr)
+	if err != nil {
+		return 0, err
+	}
+	if exit == nil {
+		return 0, errors.New("Exec stream ended without the process exit status")
+	}
+	return exit.ExitCode, nil
+}
+
+func pipeStdout(stream StdoutStreamClient, stdout, stderr io.Writer) (exit *containers.ExecExit, err error) {
 	for {
 		resp, err := stream.Recv()
 		if err == io.EOF {
-			err = stream.CloseSend()
-			if err != nil {
-				return err
-			}
-			return nil
+			return exit, stream.CloseSend()
 		}
 		if err != nil {
-			return errors.Wrapf(err, "Received error while reading attach stream")
+			return nil, errors.Wrapf(err, "Received error while reading attach stream")
+		}
+
+		if resp.Exit != nil {
+			exit = resp.Exit
 		}
 
 		target := stdout
@@ -30,7 +49,7 @@ func PipeStdout(stream StdoutStreamClient, stdout, stderr io.Writer) error {
 
 		_, err = io.Copy(target, bytes.NewReader(resp.Output))
 		if err != nil {
-			return errors.Wrapf(err, "Error while copying data")
+			return nil, errors.Wrapf(err, "Error while copying data")
 		}
 	}
 }
@@ -52,3 +71,19 @@ func PipeStdin(stream StdinStreamClient, stdin io.Reader) error {
 		}
 	}
 }
+
+// PipeResize sends the terminal size changes to the grpc stream until the queue get stopped
+func PipeResize(stream StdinStreamClient, queue term.TerminalSizeQueue) error {
+	for size := queue.Next(); size != nil; size = queue.Next() {
+		err := stream.Send(&containers.StdinStreamRequest{
+			Resize: &containers.TerminalSize{
+				Width:  uint32(size.Width),
+				Height: uint32(size.Height),
+			},
+		})
+		if err != nil {
+			return errors.Wrapf(err, "Sending terminal size to stream returned error")
+		}
+	}
+	return nil
+}
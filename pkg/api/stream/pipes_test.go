@@ -0,0 +1,56 @@
+package stream
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStdoutStream struct {
+	responses []*containers.StdoutStreamResponse
+}
+
+func (s *fakeStdoutStream) Recv() (*containers.StdoutStreamResponse, error) {
+	if len(s.responses) == 0 {
+		return nil, io.EOF
+	}
+	resp := s.responses[0]
+	s.responses = s.responses[1:]
+	return resp, nil
+}
+
+func (s *fakeStdoutStream) CloseSend() error {
+	return nil
+}
+
+func TestPipeExecStdout(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	stream := &fakeStdoutStream{
+		responses: []*containers.StdoutStreamResponse{
+			{Output: []byte("out")},
+			{Output: []byte("err"), Stderr: true},
+			{Exit: &containers.ExecExit{ExitCode: 3}},
+		},
+	}
+
+	code, err := PipeExecStdout(stream, &stdout, &stderr)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), code)
+	assert.Equal(t, "out", stdout.String())
+	assert.Equal(t, "err", stderr.String())
+}
+
+func TestPipeExecStdoutWithoutExitStatus(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	stream := &fakeStdoutStream{
+		responses: []*containers.StdoutStreamResponse{
+			{Output: []byte("out")},
+		},
+	}
+
+	_, err := PipeExecStdout(stream, &stdout, &stderr)
+	assert.Error(t, err)
+}
@@ -8,8 +8,9 @@ import (
 
 // Reader is io.Reader implementation what reads bytes from RPC stream
 type Reader struct {
-	buffer bytes.Buffer
-	stream StdinStreamServer
+	buffer   bytes.Buffer
+	stream   StdinStreamServer
+	onResize func(width, height uint32)
 }
 
 // StdinStreamServer interface for the endpoint what takes stdin stream in
@@ -27,13 +28,21 @@ func NewReader(stream StdinStreamServer) *Reader {
 	return &Reader{stream: stream}
 }
 
+// NewResizeReader creates new Reader instance what calls onResize when receives terminal resize message
+func NewResizeReader(stream StdinStreamServer, onResize func(width, height uint32)) *Reader {
+	return &Reader{stream: stream, onResize: onResize}
+}
+
 // Write writes bytes to given RPC stream
 func (w *Reader) Read(p []byte) (n int, err error) {
-	if w.buffer.Len() == 0 {
+	for w.buffer.Len() == 0 {
 		req, err := w.stream.Recv()
 		if err != nil {
 			return 0, err
 		}
+		if size := req.GetResize(); size != nil && w.onResize != nil {
+			w.onResize(size.Width, size.Height)
+		}
 		w.buffer.Write(req.GetInput())
 	}
 	return w.buffer.Read(p)
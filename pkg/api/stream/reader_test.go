@@ -0,0 +1,42 @@
+package stream
+
+import (
+	"io"
+	"io/ioutil"
+	"testing"
+
+	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeStdinStream struct {
+	requests []*containers.StdinStreamRequest
+}
+
+func (s *fakeStdinStream) Recv() (*containers.StdinStreamRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func TestReaderResize(t *testing.T) {
+	sizes := [][]uint32{}
+	reader := NewResizeReader(&fakeStdinStream{
+		requests: []*containers.StdinStreamRequest{
+			{Resize: &containers.TerminalSize{Width: 80, Height: 24}},
+			{Input: []byte("foo")},
+			{Resize: &containers.TerminalSize{Width: 120, Height: 40}},
+			{Input: []byte("bar")},
+		},
+	}, func(width, height uint32) {
+		sizes = append(sizes, []uint32{width, height})
+	})
+
+	data, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "foobar", string(data))
+	assert.Equal(t, [][]uint32{{80, 24}, {120, 40}}, sizes)
+}
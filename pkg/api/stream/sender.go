@@ -0,0 +1,38 @@
+package stream
+
+import (
+	"sync"
+
+	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+)
+
+// StdinStreamCloser interface for the client what sends stdin stream messages and closes the stream
+type StdinStreamCloser interface {
+	StdinStreamClient
+	CloseSend() error
+}
+
+// SyncSender serialises the stdin stream sends because gRPC doesn't allow concurrent sends to the same stream
+type SyncSender struct {
+	stream StdinStreamCloser
+	mu     sync.Mutex
+}
+
+// NewSyncSender creates new SyncSender instance
+func NewSyncSender(stream StdinStreamCloser) *SyncSender {
+	return &SyncSender{stream: stream}
+}
+
+// Send sends the message to the stream
+func (s *SyncSender) Send(req *containers.StdinStreamRequest) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream.Send(req)
+}
+
+// CloseSend closes the sending direction of the stream
+func (s *SyncSender) CloseSend() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream.CloseSend()
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ernoaapa/eliot/pkg/api/mapping"
+	volumes "github.com/ernoaapa/eliot/pkg/api/services/volumes/v1"
+	"github.com/ernoaapa/eliot/pkg/api/stream"
+	"github.com/ernoaapa/eliot/pkg/sync"
+	"github.com/pkg/errors"
+)
+
+// VolumeSync is sync stream to the volume in the node, implements sync.Remote
+type VolumeSync struct {
+	stream volumes.Volumes_SyncClient
+	close  func()
+}
+
+// Manifest receives the current volume content, can be called only once right after opening the stream
+func (v *VolumeSync) Manifest() (map[string]sync.FileState, error) {
+	result := map[string]sync.FileState{}
+	for {
+		resp, err := v.stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range resp.Files {
+			result[file.Path] = mapping.MapAPIModelToSyncFile(file)
+		}
+		if resp.Ready {
+			return result, nil
+		}
+	}
+}
+
+// Apply sends the changes to the volume, the file content get read under the root
+func (v *VolumeSync) Apply(root string, changes []sync.Change) (sync.Result, error) {
+	for _, change := range changes {
+		if err := v.send(root, change); err != nil {
+			return sync.Result{}, err
+		}
+	}
+
+	if err := v.stream.Send(&volumes.SyncVolumeRequest{Commit: true}); err != nil {
+		return sync.Result{}, err
+	}
+	for {
+		resp, err := v.stream.Recv()
+		if err != nil {
+			return sync.Result{}, err
+		}
+		if resp.Result != nil {
+			return mapping.MapAPIModelToSyncResult(resp.Result), nil
+		}
+	}
+}
+
+// Close closes the sync stream
+func (v *VolumeSync) Close() {
+	v.close()
+}
+
+func (v *VolumeSync) send(root string, change sync.Change) error {
+	if change.Delete {
+		return v.stream.Send(&volumes.SyncVolumeRequest{Delete: change.State.Path})
+	}
+
+	if !change.State.IsRegular() {
+		return v.stream.Send(&volumes.SyncVolumeRequest{File: mapping.MapSyncFileToAPIModel(change.State)})
+	}
+
+	file, err := os.Open(filepath.Join(root, filepath.FromSlash(change.State.Path)))
+	if os.IsNotExist(err) {
+		// Removed after the scan, the removal get synced separately
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "Failed to read [%s]", change.State.Path)
+	}
+	defer file.Close()
+
+	if err := v.stream.Send(&volumes.SyncVolumeRequest{File: mapping.MapSyncFileToAPIModel(change.State)}); err != nil {
+		return err
+	}
+	writer := stream.NewChunkWriter(func(chunk []byte) error {
+		return v.stream.Send(&volumes.SyncVolumeRequest{Data: chunk})
+	})
+	_, err = io.Copy(writer, file)
+	return err
+}
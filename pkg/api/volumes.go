@@ -0,0 +1,336 @@
+package api
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+
+	"golang.org/x/net/context"
+
+	"github.com/ernoaapa/eliot/pkg/api/mapping"
+	volumes "github.com/ernoaapa/eliot/pkg/api/services/volumes/v1"
+	"github.com/ernoaapa/eliot/pkg/api/stream"
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/ernoaapa/eliot/pkg/sync"
+	"github.com/ernoaapa/eliot/pkg/volume"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// chunkSize is maximum size of the data in single volume export message
+const chunkSize = 32 * 1024
+
+// syncBatchSize is maximum count of files in single sync manifest message
+const syncBatchSize = 1000
+
+// VolumesServer implements the 'volumes' GRPC service
+type VolumesServer struct {
+	client  runtime.Client
+	volumes *volume.Manager
+}
+
+// Create is 'volumes' service Create implementation
+func (s *VolumesServer) Create(context context.Context, req *volumes.CreateVolumeRequest) (*volumes.CreateVolumeResponse, error) {
+	if req.Volume == nil || req.Volume.Metadata == nil {
+		return nil, fmt.Errorf("You must define volume metadata")
+	}
+	var (
+		namespace = req.Volume.Metadata.Namespace
+		name      = req.Volume.Metadata.Name
+		size      uint64
+	)
+	if req.Volume.Spec != nil {
+		size = req.Volume.Spec.Size
+	}
+
+	if err := validateVolumeRef(namespace, name); err != nil {
+		return nil, err
+	}
+
+	created, err := s.volumes.Create(namespace, name, size)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to create volume [%s]", name)
+	}
+
+	return &volumes.CreateVolumeResponse{
+		Volume: mapping.MapVolumeToAPIModel(created),
+	}, nil
+}
+
+// Get is 'volumes' service Get implementation
+func (s *VolumesServer) Get(context context.Context, req *volumes.GetVolumeRequest) (*volumes.GetVolumeResponse, error) {
+	if err := validateVolumeRef(req.Namespace, req.Name); err != nil {
+		return nil, err
+	}
+	result, err := s.getVolume(req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &volumes.GetVolumeResponse{
+		Volume: mapping.MapVolumeToAPIModel(result),
+	}, nil
+}
+
+// List is 'volumes' service List implementation
+func (s *VolumesServer) List(context context.Context, req *volumes.ListVolumesRequest) (*volumes.ListVolumesResponse, error) {
+	if err := model.ValidateNamespace(req.Namespace); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid namespace [%s]: %s", req.Namespace, err)
+	}
+	list, err := s.volumes.List(req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := s.client.GetPods(req.Namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to resolve pods what use the volumes")
+	}
+
+	for i := range list {
+		if err := s.resolveStatus(&list[i], pods); err != nil {
+			return nil, err
+		}
+	}
+
+	return &volumes.ListVolumesResponse{
+		Volumes: mapping.MapVolumesToAPIModel(list),
+	}, nil
+}
+
+// Delete is 'volumes' service Delete implementation
+func (s *VolumesServer) Delete(context context.Context, req *volumes.DeleteVolumeRequest) (*volumes.DeleteVolumeResponse, error) {
+	if err := validateVolumeRef(req.Namespace, req.Name); err != nil {
+		return nil, err
+	}
+	existing, err := s.getVolume(req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(existing.Status.UsedBy) > 0 {
+		return nil, fmt.Errorf("Cannot delete volume [%s], it's in use by pods %v", req.Name, existing.Status.UsedBy)
+	}
+
+	deleted, err := s.volumes.Delete(req.Namespace, req.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to delete volume [%s]", req.Name)
+	}
+
+	return &volumes.DeleteVolumeResponse{
+		Volume: mapping.MapVolumeToAPIModel(deleted),
+	}, nil
+}
+
+// Usage is 'volumes' service Usage implementation
+func (s *VolumesServer) Usage(context context.Context, req *volumes.VolumeUsageRequest) (*volumes.VolumeUsageResponse, error) {
+	if err := validateVolumeRef(req.Namespace, req.Name); err != nil {
+		return nil, err
+	}
+	result, err := s.volumes.Get(req.Namespace, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	used, err := s.volumes.Usage(req.Namespace, req.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to resolve volume [%s] usage", req.Name)
+	}
+
+	return &volumes.VolumeUsageResponse{
+		Used: used,
+		Size: result.Spec.Size,
+	}, nil
+}
+
+// Export is 'volumes' service Export implementation, streams the volume data as tar
+func (s *VolumesServer) Export(req *volumes.ExportVolumeRequest, server volumes.Volumes_ExportServer) error {
+	if err := validateVolumeRef(req.Namespace, req.Name); err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriterSize(stream.NewChunkWriter(func(chunk []byte) error {
+		return server.Send(&volumes.VolumeData{Data: chunk})
+	}), chunkSize)
+
+	opts := volume.ExportOpts{
+		Gzip:  req.Gzip,
+		Paths: req.Paths,
+	}
+	if err := s.volumes.Export(req.Namespace, req.Name, writer, opts); err != nil {
+		return errors.Wrapf(err, "Failed to export volume [%s]", req.Name)
+	}
+	return writer.Flush()
+}
+
+// Import is 'volumes' service Import implementation, extracts received tar stream to the volume
+func (s *VolumesServer) Import(server volumes.Volumes_ImportServer) error {
+	first, err := server.Recv()
+	if err != nil {
+		return errors.Wrapf(err, "Failed to receive volume import request")
+	}
+
+	if err := validateVolumeRef(first.Namespace, first.Name); err != nil {
+		return err
+	}
+
+	pending := first.Data
+	reader := stream.NewChunkReader(func() ([]byte, error) {
+		if pending != nil {
+			chunk := pending
+			pending = nil
+			return chunk, nil
+		}
+		req, err := server.Recv()
+		if err != nil {
+			return nil, err
+		}
+		return req.Data, nil
+	})
+
+	imported, err := s.volumes.Import(first.Namespace, first.Name, reader)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to import volume [%s]", first.Name)
+	}
+
+	return server.SendAndClose(&volumes.ImportVolumeResponse{
+		Volume: mapping.MapVolumeToAPIModel(imported),
+	})
+}
+
+// Sync is 'volumes' service Sync implementation.
+// Sends the current volume content and then applies the received changes until the client closes the stream
+func (s *VolumesServer) Sync(server volumes.Volumes_SyncServer) error {
+	first, err := server.Recv()
+	if err != nil {
+		return errors.Wrapf(err, "Failed to receive volume sync request")
+	}
+
+	if err := validateVolumeRef(first.Namespace, first.Name); err != nil {
+		return err
+	}
+
+	if _, err := s.volumes.Ensure(first.Namespace, first.Name, 0); err != nil {
+		return errors.Wrapf(err, "Failed to create volume [%s]", first.Name)
+	}
+	root := s.volumes.GetPath(first.Namespace, first.Name)
+
+	if err := sendManifest(server, root); err != nil {
+		return errors.Wrapf(err, "Failed to send volume [%s] content", first.Name)
+	}
+
+	receiver := sync.NewReceiver(root)
+	defer receiver.Close()
+
+	req := first
+	for {
+		switch {
+		case req.File != nil:
+			receiver.Begin(mapping.MapAPIModelToSyncFile(req.File))
+		case req.Data != nil:
+			receiver.Write(req.Data)
+		case req.Delete != "":
+			receiver.Delete(req.Delete)
+		case req.Commit:
+			result := receiver.Commit()
+			if err := server.Send(&volumes.SyncVolumeResponse{Result: mapping.MapSyncResultToAPIModel(result)}); err != nil {
+				return err
+			}
+		}
+
+		req, err = server.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func sendManifest(server volumes.Volumes_SyncServer, root string) error {
+	states, err := sync.Scan(root, func(path string, dir bool) bool {
+		return path == "lost+found"
+	})
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(states))
+	for path := range states {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	batch := []sync.FileState{}
+	for _, path := range paths {
+		batch = append(batch, states[path])
+		if len(batch) == syncBatchSize {
+			if err := server.Send(&volumes.SyncVolumeResponse{Files: mapping.MapSyncFilesToAPIModel(batch)}); err != nil {
+				return err
+			}
+			batch = []sync.FileState{}
+		}
+	}
+	return server.Send(&volumes.SyncVolumeResponse{
+		Files: mapping.MapSyncFilesToAPIModel(batch),
+		Ready: true,
+	})
+}
+
+// validateVolumeRef validates the volume namespace and name what get used in the volume path
+func validateVolumeRef(namespace, name string) error {
+	if err := model.ValidateNamespace(namespace); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid namespace [%s]: %s", namespace, err)
+	}
+	if err := model.ValidateVolumeName(name); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid volume name [%s]: %s", name, err)
+	}
+	return nil
+}
+
+func (s *VolumesServer) getVolume(namespace, name string) (model.Volume, error) {
+	result, err := s.volumes.Get(namespace, name)
+	if err != nil {
+		return result, err
+	}
+
+	pods, err := s.client.GetPods(namespace)
+	if err != nil {
+		return result, errors.Wrapf(err, "Failed to resolve pods what use the volume [%s]", name)
+	}
+
+	return result, s.resolveStatus(&result, pods)
+}
+
+func (s *VolumesServer) resolveStatus(target *model.Volume, pods []model.Pod) error {
+	usage, err := s.volumes.Usage(target.Metadata.Namespace, target.Metadata.Name)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to resolve volume [%s] usage", target.Metadata.Name)
+	}
+	target.Status.Usage = usage
+	target.Status.UsedBy = getPodsUsingPath(pods, target.Status.Path)
+	return nil
+}
+
+func getPodsUsingPath(pods []model.Pod, path string) (result []string) {
+	for _, pod := range pods {
+		if isPodUsingPath(pod, path) {
+			result = append(result, pod.Metadata.Name)
+		}
+	}
+	return result
+}
+
+func isPodUsingPath(pod model.Pod, path string) bool {
+	for _, container := range pod.Spec.Containers {
+		for _, mount := range container.Mounts {
+			if mount.Source == path {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+// Package archivetest provides test helpers for the code what reads and writes directory trees
+package archivetest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TempDir creates temporary directory with the files, name -> content.
+// Returns the directory and function what removes it
+func TempDir(t *testing.T, files map[string]string) (string, func()) {
+	dir, err := ioutil.TempDir("", "archivetest")
+	assert.NoError(t, err)
+	WriteFiles(t, dir, files)
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+// WriteFiles writes the files under the root, name -> content. Creates the parent directories
+func WriteFiles(t *testing.T, root string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(root, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	}
+}
+
+// ReadFile returns the file content as string
+func ReadFile(t *testing.T, path string) string {
+	content, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	return string(content)
+}
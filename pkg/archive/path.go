@@ -0,0 +1,48 @@
+package archive
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// blockSize is the tar header and data block size
+const blockSize = 512
+
+// WritePath writes single file or directory as tar stream to the writer.
+// The entry names start with the path base name, like with `tar -C dir name`
+func WritePath(path string, w io.Writer) error {
+	if _, err := os.Lstat(path); err != nil {
+		return err
+	}
+	return WriteTar(filepath.Dir(path), w, WriteOpts{Paths: []string{filepath.Base(path)}})
+}
+
+// Size returns approximate size of the tar stream what WritePath would write
+func Size(path string) (size int64, err error) {
+	err = walk(filepath.Dir(path), []string{filepath.Base(path)}, nil, func(path, rel string, info os.FileInfo) error {
+		size += blockSize
+		if info.Mode().IsRegular() {
+			size += (info.Size() + blockSize - 1) / blockSize * blockSize
+		}
+		return nil
+	})
+	// Two empty blocks mark the end of the archive
+	return size + 2*blockSize, err
+}
+
+// ExtractToPath extracts tar stream of single file or directory like cp(1) does, into the target
+// if it's existing directory, otherwise as the target
+func ExtractToPath(target string, r io.Reader) error {
+	info, err := os.Stat(target)
+	if err == nil && info.IsDir() {
+		return ExtractTar(target, r, ExtractOpts{})
+	}
+
+	dir := filepath.Dir(target)
+	if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+		return fmt.Errorf("Cannot extract to [%s], directory [%s] doesn't exist", target, dir)
+	}
+	return ExtractTar(dir, r, ExtractOpts{Rename: filepath.Base(target)})
+}
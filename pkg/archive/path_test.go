@@ -0,0 +1,58 @@
+package archive
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/archive/archivetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWritePathExtractToPath(t *testing.T) {
+	source, cleanup := archivetest.TempDir(t, map[string]string{
+		"app/config.yml":   "foo: bar",
+		"app/data/a.csv":   "1,2,3",
+		"other/ignore.txt": "ignore",
+	})
+	defer cleanup()
+	target, cleanup := archivetest.TempDir(t, nil)
+	defer cleanup()
+
+	var buffer bytes.Buffer
+	assert.NoError(t, WritePath(filepath.Join(source, "app"), &buffer))
+	assert.NoError(t, ExtractToPath(target, bytes.NewReader(buffer.Bytes())))
+	assert.Equal(t, "foo: bar", archivetest.ReadFile(t, filepath.Join(target, "app/config.yml")))
+	assert.Equal(t, "1,2,3", archivetest.ReadFile(t, filepath.Join(target, "app/data/a.csv")))
+	assert.False(t, fileExist(filepath.Join(target, "other")), "should write only the given path")
+
+	assert.NoError(t, ExtractToPath(filepath.Join(target, "renamed"), bytes.NewReader(buffer.Bytes())))
+	assert.Equal(t, "foo: bar", archivetest.ReadFile(t, filepath.Join(target, "renamed/config.yml")), "should extract as the target if it doesn't exist")
+
+	buffer.Reset()
+	assert.NoError(t, WritePath(filepath.Join(source, "app/config.yml"), &buffer))
+	assert.NoError(t, ExtractToPath(filepath.Join(target, "app.yml"), &buffer))
+	assert.Equal(t, "foo: bar", archivetest.ReadFile(t, filepath.Join(target, "app.yml")))
+
+	assert.Error(t, ExtractToPath(filepath.Join(target, "missing/app.yml"), &buffer), "should fail if the parent directory doesn't exist")
+}
+
+func TestSize(t *testing.T) {
+	source, cleanup := archivetest.TempDir(t, map[string]string{
+		"app/config.yml": "foo: bar",
+	})
+	defer cleanup()
+
+	var buffer bytes.Buffer
+	assert.NoError(t, WritePath(filepath.Join(source, "app"), &buffer))
+
+	size, err := Size(filepath.Join(source, "app"))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buffer.Len()), size)
+}
+
+func fileExist(path string) bool {
+	_, err := os.Lstat(path)
+	return err == nil
+}
@@ -0,0 +1,335 @@
+package archive
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// WriteOpts defines what get written to the tar stream
+type WriteOpts struct {
+	// Paths relative to the root to write, empty means everything
+	Paths []string
+	// Exclude directories relative to the root
+	Exclude []string
+}
+
+// ExtractOpts defines how the tar stream get extracted
+type ExtractOpts struct {
+	// Rename replaces the first path element of the entries, e.g. to extract a file with different name
+	Rename string
+}
+
+// WriteTar writes the files under the root as tar stream to the writer
+func WriteTar(root string, w io.Writer, opts WriteOpts) error {
+	var (
+		paths   = normalizePaths(opts.Paths)
+		exclude = normalizePaths(opts.Exclude)
+		tw      = tar.NewWriter(w)
+	)
+
+	err := walk(root, paths, exclude, func(path, rel string, info os.FileInfo) error {
+		link := ""
+		if info.Mode()&os.ModeSymlink != 0 {
+			var err error
+			if link, err = os.Readlink(path); err != nil {
+				return err
+			}
+		}
+
+		header, err := tar.FileInfoHeader(info, link)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to create tar header for [%s]", rel)
+		}
+		header.Name = rel
+		if info.IsDir() {
+			header.Name += "/"
+		}
+
+		if err := tw.WriteHeader(header); err != nil {
+			return errors.Wrapf(err, "Failed to write tar header for [%s]", rel)
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		return copyFileTo(tw, path)
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Failed to write data to tar stream")
+	}
+	return tw.Close()
+}
+
+// walk calls fn for each file and directory under the root what match to the paths
+func walk(root string, paths, exclude []string, fn func(path, rel string, info os.FileInfo) error) error {
+	return filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == "." {
+			return nil
+		}
+		if info.IsDir() && len(exclude) > 0 && isIncluded(rel, exclude) {
+			return filepath.SkipDir
+		}
+
+		if !isIncluded(rel, paths) {
+			if info.IsDir() && !isParentOfIncluded(rel, paths) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		return fn(path, rel, info)
+	})
+}
+
+func copyFileTo(w io.Writer, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(w, file)
+	return err
+}
+
+// ExtractTar extracts tar stream to the root. The stream can be gzip compressed.
+// Entries cannot be extracted outside of the root, not even through symlinks.
+func ExtractTar(root string, r io.Reader, opts ExtractOpts) error {
+	var (
+		buffered           = bufio.NewReader(r)
+		source   io.Reader = buffered
+	)
+	if magic, err := buffered.Peek(2); err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(buffered)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to read gzip stream")
+		}
+		defer gz.Close()
+		source = gz
+	}
+
+	tr := tar.NewReader(source)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrapf(err, "Failed to read tar stream")
+		}
+
+		if opts.Rename != "" {
+			header.Name = rename(header.Name, opts.Rename)
+			if header.Typeflag == tar.TypeLink {
+				header.Linkname = rename(header.Linkname, opts.Rename)
+			}
+		}
+
+		target, err := ResolveTarget(root, header.Name)
+		if err != nil {
+			return err
+		}
+		if target == root {
+			continue
+		}
+
+		if err := extractEntry(root, target, header, tr); err != nil {
+			return errors.Wrapf(err, "Failed to extract [%s]", header.Name)
+		}
+	}
+}
+
+func extractEntry(root, target string, header *tar.Header, r io.Reader) error {
+	mode := os.FileMode(header.Mode).Perm()
+
+	if header.Typeflag != tar.TypeDir {
+		if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+			return err
+		}
+		if err := removeIfNotDir(target); err != nil {
+			return err
+		}
+	}
+
+	switch header.Typeflag {
+	case tar.TypeDir:
+		if err := removeNonDir(target); err != nil {
+			return err
+		}
+		if err := os.MkdirAll(target, 0755); err != nil {
+			return err
+		}
+		if err := lchmodDir(target, mode); err != nil {
+			return err
+		}
+	case tar.TypeReg, tar.TypeRegA:
+		file, err := os.OpenFile(target, os.O_CREATE|os.O_TRUNC|os.O_WRONLY|syscall.O_NOFOLLOW, mode)
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(file, r)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	case tar.TypeSymlink:
+		if err := os.Symlink(header.Linkname, target); err != nil {
+			return err
+		}
+	case tar.TypeLink:
+		source, err := ResolveTarget(root, header.Linkname)
+		if err != nil {
+			return err
+		}
+		if err := os.Link(source, target); err != nil {
+			return err
+		}
+	default:
+		log.Debugf("Skip unsupported tar entry [%s] with type %c", header.Name, header.Typeflag)
+		return nil
+	}
+
+	if err := os.Lchown(target, header.Uid, header.Gid); err != nil {
+		log.Debugf("Failed to restore [%s] ownership: %s", header.Name, err)
+	}
+	return lchtimes(target, header.ModTime)
+}
+
+// lchmodDir changes the directory mode without following symlink at the path
+func lchmodDir(path string, mode os.FileMode) error {
+	dir, err := os.OpenFile(path, os.O_RDONLY|syscall.O_NOFOLLOW, 0)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	info, err := dir.Stat()
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("Cannot replace [%s] with directory", path)
+	}
+	return dir.Chmod(mode)
+}
+
+// lchtimes changes the access and modification times without following symlink at the path
+func lchtimes(path string, t time.Time) error {
+	ts := unix.NsecToTimespec(t.UnixNano())
+	return unix.UtimesNanoAt(unix.AT_FDCWD, path, []unix.Timespec{ts, ts}, unix.AT_SYMLINK_NOFOLLOW)
+}
+
+// ResolveTarget returns path inside the root where the entry with the name get written.
+// Returns error if the path would be resolved through symlink.
+func ResolveTarget(root, name string) (string, error) {
+	rel := filepath.Clean(string(filepath.Separator) + name)
+
+	dir := root
+	for _, part := range strings.Split(filepath.Dir(rel), string(filepath.Separator)) {
+		if part == "" {
+			continue
+		}
+		dir = filepath.Join(dir, part)
+		info, err := os.Lstat(dir)
+		if os.IsNotExist(err) {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink != 0 {
+			return "", fmt.Errorf("Invalid path [%s], cannot write through symlink", name)
+		}
+	}
+	return filepath.Join(root, rel), nil
+}
+
+// rename replaces the first path element of the tar entry name
+func rename(name, to string) string {
+	rel := strings.TrimPrefix(filepath.ToSlash(filepath.Clean("/"+name)), "/")
+	if i := strings.Index(rel, "/"); i >= 0 {
+		return to + rel[i:]
+	}
+	return to
+}
+
+func removeIfNotDir(path string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("Cannot replace directory [%s] with file", path)
+	}
+	return os.Remove(path)
+}
+
+// removeNonDir removes anything else than directory at the path, e.g. symlink, so it can be replaced with directory
+func removeNonDir(path string) error {
+	info, err := os.Lstat(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return nil
+	}
+	return os.Remove(path)
+}
+
+func normalizePaths(paths []string) (result []string) {
+	for _, path := range paths {
+		path = strings.TrimPrefix(filepath.ToSlash(filepath.Clean("/"+path)), "/")
+		if path == "" {
+			return nil
+		}
+		result = append(result, path)
+	}
+	return result
+}
+
+func isIncluded(rel string, paths []string) bool {
+	if len(paths) == 0 {
+		return true
+	}
+	for _, path := range paths {
+		if rel == path || strings.HasPrefix(rel, path+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func isParentOfIncluded(rel string, paths []string) bool {
+	for _, path := range paths {
+		if strings.HasPrefix(path, rel+"/") {
+			return true
+		}
+	}
+	return false
+}
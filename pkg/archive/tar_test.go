@@ -0,0 +1,76 @@
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ernoaapa/eliot/pkg/archive/archivetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteTarExclude(t *testing.T) {
+	root, cleanup := archivetest.TempDir(t, map[string]string{
+		"config.yml":           "foo: bar",
+		"lost+found/garbage":   "x",
+		"data/lost+found/keep": "y",
+	})
+	defer cleanup()
+
+	var buffer bytes.Buffer
+	assert.NoError(t, WriteTar(root, &buffer, WriteOpts{Exclude: []string{"lost+found"}}))
+
+	names := []string{}
+	tr := tar.NewReader(&buffer)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, header.Name)
+	}
+	assert.Equal(t, []string{"config.yml", "data/", "data/lost+found/", "data/lost+found/keep"}, names)
+}
+
+func TestRename(t *testing.T) {
+	assert.Equal(t, "target", rename("source", "target"))
+	assert.Equal(t, "target", rename("source/", "target"))
+	assert.Equal(t, "target/a/b.txt", rename("./source/a/b.txt", "target"))
+}
+
+func TestExtractTarDirOverSymlink(t *testing.T) {
+	outside, cleanup := archivetest.TempDir(t, nil)
+	defer cleanup()
+	assert.NoError(t, os.Chmod(outside, 0755))
+	before, err := os.Stat(outside)
+	assert.NoError(t, err)
+
+	root, cleanup := archivetest.TempDir(t, nil)
+	defer cleanup()
+	assert.NoError(t, os.Symlink(outside, filepath.Join(root, "x")))
+
+	var buffer bytes.Buffer
+	tw := tar.NewWriter(&buffer)
+	assert.NoError(t, tw.WriteHeader(&tar.Header{
+		Name:     "x/",
+		Typeflag: tar.TypeDir,
+		Mode:     0777,
+		ModTime:  time.Unix(0, 0),
+	}))
+	assert.NoError(t, tw.Close())
+
+	assert.NoError(t, ExtractTar(root, &buffer, ExtractOpts{}))
+
+	after, err := os.Stat(outside)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), after.Mode().Perm(), "should not change the symlink target mode")
+	assert.Equal(t, before.ModTime(), after.ModTime(), "should not change the symlink target times")
+
+	info, err := os.Lstat(filepath.Join(root, "x"))
+	assert.NoError(t, err)
+	assert.True(t, info.IsDir(), "should replace the symlink with directory")
+	assert.Equal(t, os.FileMode(0777), info.Mode().Perm())
+}
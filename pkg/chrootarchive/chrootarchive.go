@@ -0,0 +1,111 @@
+// Package chrootarchive writes and extracts the archives inside other root filesystem, e.g. running container.
+// The work happens in helper process what chroots to the root first, so the paths and symlinks
+// always resolve inside the root, even if the root content changes while copying.
+package chrootarchive
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/ernoaapa/eliot/pkg/archive"
+)
+
+// helperName is the process name what marks the process to be chrooted helper
+const helperName = "eliotd-chrootarchive"
+
+const (
+	sizeMode    = "size"
+	writeMode   = "write"
+	extractMode = "extract"
+)
+
+// Init runs the helper and exits if the process were started as chrooted helper.
+// Must be called at the beginning of the main before anything else
+func Init() {
+	if len(os.Args) != 4 || os.Args[0] != helperName {
+		return
+	}
+
+	if err := runHelper(os.Args[1], os.Args[2], os.Args[3]); err != nil {
+		fmt.Fprint(os.Stderr, err)
+		os.Exit(1)
+	}
+	os.Exit(0)
+}
+
+// Size returns approximate size of the tar stream what WritePath would write
+func Size(root, path string) (int64, error) {
+	var output bytes.Buffer
+	if err := runInRoot(sizeMode, root, path, nil, &output); err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(output.String()), 10, 64)
+}
+
+// WritePath writes the file or directory inside the root as tar stream to the writer.
+// Symlinks get followed like the root would be the filesystem root
+func WritePath(root, path string, w io.Writer) error {
+	return runInRoot(writeMode, root, path, nil, w)
+}
+
+// ExtractToPath extracts tar stream like cp(1) does to the target inside the root
+func ExtractToPath(root, target string, r io.Reader) error {
+	return runInRoot(extractMode, root, target, r, nil)
+}
+
+func runInRoot(mode, root, path string, stdin io.Reader, stdout io.Writer) error {
+	var stderr bytes.Buffer
+	cmd := &exec.Cmd{
+		Path:   "/proc/self/exe",
+		Args:   []string{helperName, mode, root, path},
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: &stderr,
+	}
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return fmt.Errorf("%s", message)
+		}
+		return err
+	}
+	return nil
+}
+
+func runHelper(mode, root, path string) error {
+	if err := syscall.Chroot(root); err != nil {
+		return fmt.Errorf("Failed to change root to [%s]: %s", root, err)
+	}
+	if err := os.Chdir("/"); err != nil {
+		return err
+	}
+
+	switch mode {
+	case sizeMode:
+		resolved, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			return err
+		}
+		size, err := archive.Size(resolved)
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprint(os.Stdout, size)
+		return err
+	case writeMode:
+		resolved, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			return err
+		}
+		return archive.WritePath(resolved, os.Stdout)
+	case extractMode:
+		return archive.ExtractToPath(path, os.Stdin)
+	}
+	return fmt.Errorf("Unknown chrootarchive mode [%s]", mode)
+}
@@ -0,0 +1,61 @@
+package chrootarchive
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/archive/archivetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMain(m *testing.M) {
+	// The helper re-executes the test binary
+	Init()
+	os.Exit(m.Run())
+}
+
+func newTestRoot(t *testing.T, files map[string]string) (string, func()) {
+	if os.Getuid() != 0 {
+		t.Skip("chroot requires root")
+	}
+	return archivetest.TempDir(t, files)
+}
+
+func TestWritePathExtractToPath(t *testing.T) {
+	source, cleanup := newTestRoot(t, map[string]string{
+		"app/config.yml": "foo: bar",
+	})
+	defer cleanup()
+	target, cleanup := newTestRoot(t, map[string]string{
+		"tmp/.keep": "",
+	})
+	defer cleanup()
+
+	size, err := Size(source, "/app")
+	assert.NoError(t, err)
+	assert.True(t, size > 0)
+
+	var buf bytes.Buffer
+	assert.NoError(t, WritePath(source, "/app", &buf))
+	assert.NoError(t, ExtractToPath(target, "/tmp/copy", &buf))
+
+	assert.Equal(t, "foo: bar", archivetest.ReadFile(t, filepath.Join(target, "tmp/copy/config.yml")))
+}
+
+func TestSymlinksResolveInsideRoot(t *testing.T) {
+	outside, cleanup := newTestRoot(t, map[string]string{
+		"secret.txt": "secret",
+	})
+	defer cleanup()
+	root, cleanup := newTestRoot(t, map[string]string{
+		"secret.txt": "inside",
+	})
+	defer cleanup()
+	assert.NoError(t, os.Symlink(filepath.Join(outside, "secret.txt"), filepath.Join(root, "link")))
+
+	var buf bytes.Buffer
+	assert.Error(t, WritePath(root, "/link", &buf), "Absolute link should resolve inside the root where the target doesn't exist")
+	assert.NotContains(t, buf.String(), "secret")
+}
@@ -3,7 +3,6 @@ package config
 import (
 	"io/ioutil"
 
-	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/ernoaapa/eliot/pkg/fs"
@@ -14,17 +13,29 @@ import (
 
 // ProjectConfig represents configuration in project directory
 type ProjectConfig struct {
-	path    string
-	Name    string   `yaml:"name,omitempty"`
-	Image   string   `yaml:"image,omitempty"`
-	Command []string `yaml:"command,omitempty"`
-	Env     []string `yaml:"env,omitempty"`
-	Binds   []string `yaml:"binds,omitempty"`
-	Mounts  []string `yaml:"mounts,omitempty"`
-	WorkDir string   `yaml:"workdir,omitempty"`
+	path       string
+	Name       string   `yaml:"name,omitempty"`
+	Image      string   `yaml:"image,omitempty"`
+	Command    []string `yaml:"command,omitempty"`
+	Env        []string `yaml:"env,omitempty"`
+	Binds      []string `yaml:"binds,omitempty"`
+	Mounts     []string `yaml:"mounts,omitempty"`
+	WorkDir    string   `yaml:"workdir,omitempty"`
+	Ports      []string `yaml:"ports,omitempty"`
+	Syncs      []string `yaml:"syncs,omitempty"`
+	SyncIgnore []string `yaml:"syncIgnore,omitempty"`
 
-	SyncContainer *containers.Container `yaml:"syncContainer,omitempty"`
-	Syncs         []string              `yaml:"syncs,omitempty"`
+	Watch *WatchConfig `yaml:"watch,omitempty"`
+}
+
+// WatchConfig defines how 'eli up' reloads the container when the synced files change
+type WatchConfig struct {
+	// Paths are patterns in .gitignore format, the reload happens when synced file match to any of them
+	Paths []string `yaml:"paths,omitempty"`
+	// Command to run in the container on change, e.g. to rebuild the code
+	Command []string `yaml:"command,omitempty"`
+	// Restart the main process after the command succeeds. Without command, the main process always get restarted
+	Restart bool `yaml:"restart,omitempty"`
 }
 
 // EnvWith return list of environment variable definitions from project configs
@@ -48,14 +59,6 @@ func ReadProjectConfig(path string) *ProjectConfig {
 	// Defaults
 	config := &ProjectConfig{
 		path: path,
-		SyncContainer: &containers.Container{
-			Name:  "sync",
-			Image: "docker.io/ernoaapa/rsync:ff1a0fa9bc12d051bae4300508d361cc65df338b",
-			Env: []string{
-				"USER=root",
-				"GROUP=root",
-			},
-		},
 		Syncs: []string{
 			".:/volume",
 		},
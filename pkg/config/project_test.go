@@ -22,7 +22,7 @@ image: someproject/foobar:latest
 
 	assert.Equal(t, "foobar", config.Name)
 	assert.Equal(t, "someproject/foobar:latest", config.Image)
-	assert.NotEmpty(t, config.SyncContainer.Image, "syncContainer.image should have default value")
+	assert.Equal(t, []string{".:/volume"}, config.Syncs, "syncs should have default value")
 }
 
 func TestGetProjectSyncConfig(t *testing.T) {
@@ -32,10 +32,10 @@ func TestGetProjectSyncConfig(t *testing.T) {
 	writeErr := ioutil.WriteFile(file.Name(), []byte(`
 name: foobar
 image: someproject/foobar:latest
-syncContainer:
-  image: "myproject/custom:v1"
 syncs:
     - out:/app
+syncIgnore:
+    - node_modules
 `), 0644)
 	assert.NoError(t, writeErr, "Error while writing temp file")
 
@@ -43,8 +43,8 @@ syncs:
 
 	assert.Equal(t, "foobar", config.Name)
 	assert.Equal(t, "someproject/foobar:latest", config.Image)
-	assert.Equal(t, "myproject/custom:v1", config.SyncContainer.Image)
 	assert.Equal(t, []string{"out:/app"}, config.Syncs)
+	assert.Equal(t, []string{"node_modules"}, config.SyncIgnore)
 }
 
 func TestGetProjectMountConfig(t *testing.T) {
@@ -82,3 +82,24 @@ command: ["foo", "bar"]
 	assert.Equal(t, "foobar", config.Name)
 	assert.Equal(t, []string{"foo", "bar"}, config.Command)
 }
+
+func TestGetProjectWatchConfig(t *testing.T) {
+	file, tempErr := ioutil.TempFile(os.TempDir(), "config-test")
+	assert.NoError(t, tempErr, "Failed to create temp file for test")
+	defer os.Remove(file.Name())
+	writeErr := ioutil.WriteFile(file.Name(), []byte(`
+name: foobar
+watch:
+  paths:
+    - "*.go"
+  command: ["go", "build", "-o", "/usr/local/bin/app"]
+  restart: true
+`), 0644)
+	assert.NoError(t, writeErr, "Error while writing temp file")
+
+	config := ReadProjectConfig(file.Name())
+
+	assert.Equal(t, []string{"*.go"}, config.Watch.Paths)
+	assert.Equal(t, []string{"go", "build", "-o", "/usr/local/bin/app"}, config.Watch.Command)
+	assert.True(t, config.Watch.Restart)
+}
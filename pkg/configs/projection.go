@@ -0,0 +1,240 @@
+package configs
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ernoaapa/eliot/pkg/fs"
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/pkg/errors"
+)
+
+const (
+	// SecretMountType is the container mount type what projects the Secret keys as files to the container
+	SecretMountType = "secret"
+	// ConfigMapMountType is the container mount type what projects the ConfigMap keys as files to the container
+	ConfigMapMountType = "configMap"
+	// DownwardAPIMountType is the container mount type what projects the node and pod fields as files to the container
+	DownwardAPIMountType = "downwardAPI"
+)
+
+// Prepare resolves the pod containers environment variables from ConfigMaps and node and pod fields
+// and writes the Secret, ConfigMap and downwardAPI mounts as files to tmpfs, what get bind mounted to the containers.
+// Environment variables from Secrets only get validated, see ResolveSecretEnv
+func (s *Store) Prepare(pod *model.Pod, info *model.NodeInfo) error {
+	for ci := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[ci]
+
+		for _, envVar := range container.EnvVars {
+			value, err := s.resolveEnvVar(*pod, info, envVar.ValueFrom)
+			if err != nil {
+				return errors.Wrapf(err, "Failed to resolve environment variable [%s] for container [%s]", envVar.Name, container.Name)
+			}
+			if envVar.ValueFrom.SecretKeyRef != nil {
+				continue
+			}
+			container.Env = append(container.Env, fmt.Sprintf("%s=%s", envVar.Name, value))
+		}
+
+		for mi, mount := range container.Mounts {
+			if mount.Type != SecretMountType && mount.Type != ConfigMapMountType && mount.Type != DownwardAPIMountType {
+				continue
+			}
+
+			dir, err := s.project(*pod, info, container.Name, mount)
+			if err != nil {
+				return errors.Wrapf(err, "Failed to project %s [%s] for container [%s]", mount.Type, mount.Source, container.Name)
+			}
+
+			container.Mounts[mi] = model.Mount{
+				Type:        "bind",
+				Source:      dir,
+				Destination: mount.Destination,
+				Options:     []string{"ro", "rbind"},
+			}
+		}
+	}
+	return nil
+}
+
+// EnsureProjected writes the container projected files again if they don't exist.
+// The files are in tmpfs so they disappear when the node restarts
+func (s *Store) EnsureProjected(pod model.Pod, info *model.NodeInfo, container model.Container) error {
+	podDir := filepath.Join(s.projected, pod.Metadata.Namespace, pod.Metadata.Name, container.Name)
+	for _, mount := range container.Mounts {
+		if mount.Type != "bind" || filepath.Dir(mount.Source) != podDir {
+			continue
+		}
+		if _, err := os.Stat(mount.Source); err == nil {
+			continue
+		}
+
+		source, ok := parseProjectedName(filepath.Base(mount.Source))
+		if !ok {
+			continue
+		}
+		source.Destination = mount.Destination
+		if _, err := s.project(pod, info, container.Name, source); err != nil {
+			return errors.Wrapf(err, "Failed to project %s [%s] for container [%s]", source.Type, source.Source, container.Name)
+		}
+	}
+	return nil
+}
+
+// ResolveSecretEnv returns the container environment variables what get the value from Secret, in format KEY=value.
+// The values get resolved when the container starts, so the plaintext values don't get stored to the container metadata
+func (s *Store) ResolveSecretEnv(namespace string, container model.Container) (env []string, err error) {
+	for _, envVar := range container.EnvVars {
+		if envVar.ValueFrom.SecretKeyRef == nil {
+			continue
+		}
+		value, err := s.resolveSecretKeyRef(namespace, *envVar.ValueFrom.SecretKeyRef)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to resolve environment variable [%s] for container [%s]", envVar.Name, container.Name)
+		}
+		env = append(env, fmt.Sprintf("%s=%s", envVar.Name, value))
+	}
+	return env, nil
+}
+
+// Cleanup removes the projected files of the pod
+func (s *Store) Cleanup(namespace, podName string) error {
+	return os.RemoveAll(filepath.Join(s.projected, namespace, podName))
+}
+
+func (s *Store) resolveEnvVar(pod model.Pod, info *model.NodeInfo, source model.EnvVarSource) (string, error) {
+	namespace := pod.Metadata.Namespace
+	switch {
+	case source.FieldRef != "":
+		return model.ResolveFieldRef(source.FieldRef, pod, info)
+	case source.SecretKeyRef != nil:
+		return s.resolveSecretKeyRef(namespace, *source.SecretKeyRef)
+	case source.ConfigMapKeyRef != nil:
+		configMap, err := s.GetConfigMap(namespace, source.ConfigMapKeyRef.Name)
+		if err != nil {
+			return "", err
+		}
+		value, ok := configMap.Data[source.ConfigMapKeyRef.Key]
+		if !ok {
+			return "", fmt.Errorf("ConfigMap [%s] doesn't have key [%s]", source.ConfigMapKeyRef.Name, source.ConfigMapKeyRef.Key)
+		}
+		return value, nil
+	default:
+		return "", fmt.Errorf("No value source defined")
+	}
+}
+
+func (s *Store) resolveSecretKeyRef(namespace string, ref model.KeySelector) (string, error) {
+	secret, err := s.GetSecret(namespace, ref.Name)
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return "", fmt.Errorf("Secret [%s] doesn't have key [%s]", ref.Name, ref.Key)
+	}
+	return string(value), nil
+}
+
+func (s *Store) getFiles(pod model.Pod, info *model.NodeInfo, mount model.Mount) (map[string][]byte, error) {
+	namespace := pod.Metadata.Namespace
+	files := map[string][]byte{}
+	switch mount.Type {
+	case DownwardAPIMountType:
+		for _, fieldRef := range model.FieldRefs {
+			value, err := model.ResolveFieldRef(fieldRef, pod, info)
+			if err != nil {
+				return nil, err
+			}
+			files[fieldRef] = []byte(value)
+		}
+		files["node.labels"] = []byte(model.FormatLabels(info.Labels))
+	case SecretMountType:
+		secret, err := s.GetSecret(namespace, mount.Source)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range secret.Data {
+			files[key] = value
+		}
+	case ConfigMapMountType:
+		configMap, err := s.GetConfigMap(namespace, mount.Source)
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range configMap.Data {
+			files[key] = []byte(value)
+		}
+	}
+	return files, nil
+}
+
+// project writes the mount source Secret or ConfigMap keys as files and returns the directory
+func (s *Store) project(pod model.Pod, info *model.NodeInfo, containerName string, mount model.Mount) (string, error) {
+	files, err := s.getFiles(pod, info, mount)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.ensureTmpfs(); err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(s.projected, pod.Metadata.Namespace, pod.Metadata.Name, containerName, projectedName(mount))
+	if err := os.RemoveAll(dir); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	for key, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, key), content, 0444); err != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+// projectedName returns the directory name for the projected mount
+func projectedName(mount model.Mount) string {
+	name := strings.ToLower(mount.Type)
+	if mount.Source != "" {
+		name = fmt.Sprintf("%s-%s", name, mount.Source)
+	}
+	return name
+}
+
+// parseProjectedName resolves the original mount type and source from the projected directory name
+func parseProjectedName(name string) (model.Mount, bool) {
+	for _, mountType := range []string{SecretMountType, ConfigMapMountType, DownwardAPIMountType} {
+		prefix := strings.ToLower(mountType)
+		if name == prefix {
+			return model.Mount{Type: mountType}, true
+		}
+		if strings.HasPrefix(name, prefix+"-") {
+			return model.Mount{Type: mountType, Source: strings.TrimPrefix(name, prefix+"-")}, true
+		}
+	}
+	return model.Mount{}, false
+}
+
+// ensureTmpfs mounts tmpfs to the projected directory so the secrets don't get written to the disk
+func (s *Store) ensureTmpfs() error {
+	if err := os.MkdirAll(s.projected, 0700); err != nil {
+		return errors.Wrapf(err, "Failed to create directory [%s]", s.projected)
+	}
+
+	mounted, err := fs.IsMountPoint(s.mountinfo, s.projected)
+	if err != nil || mounted {
+		return err
+	}
+
+	if out, err := s.run("mount", "-t", "tmpfs", "-o", "mode=0700", "tmpfs", s.projected); err != nil {
+		return errors.Wrapf(err, "Failed to mount tmpfs to [%s]: %s", s.projected, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,335 @@
+package configs
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultRoot is directory where the Secrets and ConfigMaps get stored in the node
+	DefaultRoot = "/var/lib/eliot/configs"
+	// DefaultProjectedRoot is directory where the files for the container mounts get written.
+	// Tmpfs get mounted to the directory so the secrets never get written to the disk
+	DefaultProjectedRoot = "/run/eliot/projected"
+)
+
+const (
+	secretsDirName    = "secrets"
+	configMapsDirName = "configmaps"
+	keyFileName       = "secret.key"
+)
+
+// Store persists Secrets and ConfigMaps in the node.
+// Secrets are encrypted with node-local key what get generated on first use.
+type Store struct {
+	root      string
+	projected string
+	mountinfo string
+	run       func(name string, args ...string) ([]byte, error)
+	mu        sync.Mutex
+}
+
+// NewStore creates new Store what saves the data under the root directory
+// and writes the projected container files under the projected directory
+func NewStore(root, projected string) *Store {
+	return &Store{
+		root:      root,
+		projected: projected,
+		mountinfo: "/proc/self/mountinfo",
+		run: func(name string, args ...string) ([]byte, error) {
+			return exec.Command(name, args...).CombinedOutput()
+		},
+	}
+}
+
+// CreateSecret encrypts and stores new Secret
+func (s *Store) CreateSecret(secret model.Secret) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validate(secretsDirName, secret.Metadata.Namespace, secret.Metadata.Name); err != nil {
+		return err
+	}
+
+	path := s.getPath(secretsDirName, secret.Metadata.Namespace, secret.Metadata.Name)
+	if fileExists(path) {
+		return runtime.ErrWithMessagef(runtime.ErrAlreadyExists, "Secret [%s] in namespace [%s] already exists", secret.Metadata.Name, secret.Metadata.Namespace)
+	}
+
+	data, err := json.Marshal(secret.Data)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to serialize secret [%s]", secret.Metadata.Name)
+	}
+
+	key, err := s.getKey()
+	if err != nil {
+		return err
+	}
+
+	encrypted, err := encrypt(key, data)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to encrypt secret [%s]", secret.Metadata.Name)
+	}
+	return writeFile(path, encrypted)
+}
+
+// GetSecret reads and decrypts the Secret
+func (s *Store) GetSecret(namespace, name string) (model.Secret, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validate(secretsDirName, namespace, name); err != nil {
+		return model.Secret{}, err
+	}
+	return s.getSecret(namespace, name)
+}
+
+// ListSecrets returns all Secrets in the namespace sorted by name
+func (s *Store) ListSecrets(namespace string) (result []model.Secret, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validate(secretsDirName, namespace, ""); err != nil {
+		return nil, err
+	}
+
+	names, err := listNames(filepath.Join(s.root, secretsDirName, namespace))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to list secrets in namespace [%s]", namespace)
+	}
+
+	for _, name := range names {
+		secret, err := s.getSecret(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, secret)
+	}
+	return result, nil
+}
+
+// DeleteSecret removes the Secret
+func (s *Store) DeleteSecret(namespace, name string) (model.Secret, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validate(secretsDirName, namespace, name); err != nil {
+		return model.Secret{}, err
+	}
+
+	secret, err := s.getSecret(namespace, name)
+	if err != nil {
+		return secret, err
+	}
+	return secret, os.Remove(s.getPath(secretsDirName, namespace, name))
+}
+
+// CreateConfigMap stores new ConfigMap
+func (s *Store) CreateConfigMap(configMap model.ConfigMap) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validate(configMapsDirName, configMap.Metadata.Namespace, configMap.Metadata.Name); err != nil {
+		return err
+	}
+
+	path := s.getPath(configMapsDirName, configMap.Metadata.Namespace, configMap.Metadata.Name)
+	if fileExists(path) {
+		return runtime.ErrWithMessagef(runtime.ErrAlreadyExists, "ConfigMap [%s] in namespace [%s] already exists", configMap.Metadata.Name, configMap.Metadata.Namespace)
+	}
+
+	data, err := json.Marshal(configMap.Data)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to serialize configmap [%s]", configMap.Metadata.Name)
+	}
+	return writeFile(path, data)
+}
+
+// GetConfigMap reads the ConfigMap
+func (s *Store) GetConfigMap(namespace, name string) (model.ConfigMap, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validate(configMapsDirName, namespace, name); err != nil {
+		return model.ConfigMap{}, err
+	}
+	return s.getConfigMap(namespace, name)
+}
+
+// ListConfigMaps returns all ConfigMaps in the namespace sorted by name
+func (s *Store) ListConfigMaps(namespace string) (result []model.ConfigMap, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validate(configMapsDirName, namespace, ""); err != nil {
+		return nil, err
+	}
+
+	names, err := listNames(filepath.Join(s.root, configMapsDirName, namespace))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to list configmaps in namespace [%s]", namespace)
+	}
+
+	for _, name := range names {
+		configMap, err := s.getConfigMap(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, configMap)
+	}
+	return result, nil
+}
+
+// DeleteConfigMap removes the ConfigMap
+func (s *Store) DeleteConfigMap(namespace, name string) (model.ConfigMap, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.validate(configMapsDirName, namespace, name); err != nil {
+		return model.ConfigMap{}, err
+	}
+
+	configMap, err := s.getConfigMap(namespace, name)
+	if err != nil {
+		return configMap, err
+	}
+	return configMap, os.Remove(s.getPath(configMapsDirName, namespace, name))
+}
+
+func (s *Store) getSecret(namespace, name string) (model.Secret, error) {
+	secret := model.Secret{
+		Metadata: model.NewMetadata(namespace, name),
+	}
+
+	encrypted, err := ioutil.ReadFile(s.getPath(secretsDirName, namespace, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return secret, runtime.ErrWithMessagef(runtime.ErrNotFound, "Secret [%s] in namespace [%s] not found", name, namespace)
+		}
+		return secret, errors.Wrapf(err, "Failed to read secret [%s]", name)
+	}
+
+	key, err := s.getKey()
+	if err != nil {
+		return secret, err
+	}
+
+	data, err := decrypt(key, encrypted)
+	if err != nil {
+		return secret, errors.Wrapf(err, "Failed to decrypt secret [%s]", name)
+	}
+
+	if err := json.Unmarshal(data, &secret.Data); err != nil {
+		return secret, errors.Wrapf(err, "Failed to parse secret [%s]", name)
+	}
+	return secret, nil
+}
+
+func (s *Store) getConfigMap(namespace, name string) (model.ConfigMap, error) {
+	configMap := model.ConfigMap{
+		Metadata: model.NewMetadata(namespace, name),
+	}
+
+	data, err := ioutil.ReadFile(s.getPath(configMapsDirName, namespace, name))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return configMap, runtime.ErrWithMessagef(runtime.ErrNotFound, "ConfigMap [%s] in namespace [%s] not found", name, namespace)
+		}
+		return configMap, errors.Wrapf(err, "Failed to read configmap [%s]", name)
+	}
+
+	if err := json.Unmarshal(data, &configMap.Data); err != nil {
+		return configMap, errors.Wrapf(err, "Failed to parse configmap [%s]", name)
+	}
+	return configMap, nil
+}
+
+// getKey returns the node-local encryption key, generates new one if not exist yet
+func (s *Store) getKey() ([]byte, error) {
+	path := filepath.Join(s.root, keyFileName)
+
+	key, err := ioutil.ReadFile(path)
+	if err == nil {
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, errors.Wrapf(err, "Failed to read secret encryption key")
+	}
+
+	key, err = generateKey()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to generate secret encryption key")
+	}
+	return key, writeFile(path, key)
+}
+
+// validate checks the namespace and name so the path cannot point outside of the store root.
+// Empty name validates only the namespace
+func (s *Store) validate(kind, namespace, name string) error {
+	if err := model.ValidateNamespace(namespace); err != nil {
+		return errors.Wrapf(err, "Invalid namespace [%s]", namespace)
+	}
+	if name != "" {
+		if err := model.ValidateConfigName(name); err != nil {
+			return errors.Wrapf(err, "Invalid name [%s]", name)
+		}
+	}
+
+	dir := filepath.Join(s.root, kind)
+	rel, err := filepath.Rel(dir, s.getPath(kind, namespace, name))
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return errors.Errorf("[%s] in namespace [%s] is outside of the store root", name, namespace)
+	}
+	return nil
+}
+
+func (s *Store) getPath(kind, namespace, name string) string {
+	return filepath.Join(s.root, kind, namespace, name)
+}
+
+func listNames(dir string) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		if entry.Mode().IsRegular() && !strings.HasPrefix(entry.Name(), ".") {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// writeFile writes the data atomically and readable only by the owner
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return errors.Wrapf(err, "Failed to create directory for [%s]", path)
+	}
+
+	tmp := filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return errors.Wrapf(err, "Failed to write [%s]", path)
+	}
+	return os.Rename(tmp, path)
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
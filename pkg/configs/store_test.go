@@ -0,0 +1,273 @@
+package configs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestStore(t *testing.T) (*Store, *[]string, func()) {
+	dir, err := ioutil.TempDir("", "configs-test")
+	assert.NoError(t, err)
+
+	mountinfo := filepath.Join(dir, "mountinfo")
+	assert.NoError(t, ioutil.WriteFile(mountinfo, []byte{}, 0644))
+
+	commands := []string{}
+	store := NewStore(filepath.Join(dir, "configs"), filepath.Join(dir, "projected"))
+	store.mountinfo = mountinfo
+	store.run = func(name string, args ...string) ([]byte, error) {
+		commands = append(commands, strings.Join(append([]string{name}, args...), " "))
+		return nil, nil
+	}
+	return store, &commands, func() { os.RemoveAll(dir) }
+}
+
+func TestSecretIsEncryptedAtRest(t *testing.T) {
+	store, _, cleanup := newTestStore(t)
+	defer cleanup()
+
+	err := store.CreateSecret(model.Secret{
+		Metadata: model.NewMetadata("eliot", "api"),
+		Data:     map[string][]byte{"token": []byte("super-secret-value")},
+	})
+	assert.NoError(t, err)
+
+	raw, err := ioutil.ReadFile(filepath.Join(store.root, secretsDirName, "eliot", "api"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), "super-secret-value")
+
+	secret, err := store.GetSecret("eliot", "api")
+	assert.NoError(t, err)
+	assert.Equal(t, "super-secret-value", string(secret.Data["token"]))
+
+	assert.True(t, runtime.IsAlreadyExists(store.CreateSecret(secret)))
+}
+
+func TestListAndDeleteConfigMaps(t *testing.T) {
+	store, _, cleanup := newTestStore(t)
+	defer cleanup()
+
+	store.CreateConfigMap(model.ConfigMap{Metadata: model.NewMetadata("eliot", "b"), Data: map[string]string{"foo": "bar"}})
+	store.CreateConfigMap(model.ConfigMap{Metadata: model.NewMetadata("eliot", "a")})
+	store.CreateConfigMap(model.ConfigMap{Metadata: model.NewMetadata("other", "c")})
+
+	configMaps, err := store.ListConfigMaps("eliot")
+	assert.NoError(t, err)
+	assert.Len(t, configMaps, 2)
+	assert.Equal(t, "a", configMaps[0].Metadata.Name)
+	assert.Equal(t, "bar", configMaps[1].Data["foo"])
+
+	_, err = store.DeleteConfigMap("eliot", "a")
+	assert.NoError(t, err)
+
+	_, err = store.GetConfigMap("eliot", "a")
+	assert.True(t, runtime.IsNotFound(err))
+}
+
+func TestPrepare(t *testing.T) {
+	store, commands, cleanup := newTestStore(t)
+	defer cleanup()
+
+	store.CreateSecret(model.Secret{
+		Metadata: model.NewMetadata("eliot", "api"),
+		Data:     map[string][]byte{"token": []byte("secret")},
+	})
+	store.CreateConfigMap(model.ConfigMap{
+		Metadata: model.NewMetadata("eliot", "settings"),
+		Data:     map[string]string{"level": "debug", "config.yml": "foo: bar"},
+	})
+
+	pod := model.Pod{
+		Metadata: model.NewMetadata("eliot", "my-pod"),
+		Spec: model.PodSpec{
+			Containers: []model.Container{
+				{
+					Name: "foo",
+					Env:  []string{"FOO=bar"},
+					EnvVars: []model.EnvVar{
+						{Name: "TOKEN", ValueFrom: model.EnvVarSource{SecretKeyRef: &model.KeySelector{Name: "api", Key: "token"}}},
+						{Name: "LEVEL", ValueFrom: model.EnvVarSource{ConfigMapKeyRef: &model.KeySelector{Name: "settings", Key: "level"}}},
+					},
+					Mounts: []model.Mount{
+						{Type: "configMap", Source: "settings", Destination: "/etc/app"},
+					},
+				},
+			},
+		},
+	}
+
+	assert.NoError(t, store.Prepare(&pod, &model.NodeInfo{}))
+
+	container := pod.Spec.Containers[0]
+	assert.Equal(t, []string{"FOO=bar", "LEVEL=debug"}, container.Env, "should not store the secret values to the container")
+	assert.Equal(t, "bind", container.Mounts[0].Type)
+	assert.Equal(t, []string{"ro", "rbind"}, container.Mounts[0].Options)
+
+	content, err := ioutil.ReadFile(filepath.Join(container.Mounts[0].Source, "config.yml"))
+	assert.NoError(t, err)
+	assert.Equal(t, "foo: bar", string(content))
+
+	assert.Equal(t, []string{"mount -t tmpfs -o mode=0700 tmpfs " + store.projected}, *commands)
+
+	assert.NoError(t, store.Cleanup("eliot", "my-pod"))
+	_, err = os.Stat(container.Mounts[0].Source)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestResolveSecretEnv(t *testing.T) {
+	store, _, cleanup := newTestStore(t)
+	defer cleanup()
+
+	store.CreateSecret(model.Secret{
+		Metadata: model.NewMetadata("eliot", "api"),
+		Data:     map[string][]byte{"token": []byte("secret")},
+	})
+
+	env, err := store.ResolveSecretEnv("eliot", model.Container{
+		Name: "foo",
+		EnvVars: []model.EnvVar{
+			{Name: "TOKEN", ValueFrom: model.EnvVarSource{SecretKeyRef: &model.KeySelector{Name: "api", Key: "token"}}},
+			{Name: "POD_NAME", ValueFrom: model.EnvVarSource{FieldRef: "metadata.name"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"TOKEN=secret"}, env)
+
+	_, err = store.ResolveSecretEnv("eliot", model.Container{
+		Name: "foo",
+		EnvVars: []model.EnvVar{
+			{Name: "TOKEN", ValueFrom: model.EnvVarSource{SecretKeyRef: &model.KeySelector{Name: "api", Key: "missing"}}},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestEnsureProjected(t *testing.T) {
+	store, _, cleanup := newTestStore(t)
+	defer cleanup()
+
+	store.CreateSecret(model.Secret{
+		Metadata: model.NewMetadata("eliot", "api-keys"),
+		Data:     map[string][]byte{"token": []byte("secret")},
+	})
+
+	pod := model.Pod{
+		Metadata: model.NewMetadata("eliot", "my-pod"),
+		Spec: model.PodSpec{
+			Containers: []model.Container{
+				{
+					Name: "foo",
+					Mounts: []model.Mount{
+						{Type: "secret", Source: "api-keys", Destination: "/etc/keys"},
+						{Type: "downwardAPI", Destination: "/etc/pod"},
+						{Type: "bind", Source: "/tmp", Destination: "/tmp"},
+					},
+				},
+			},
+		},
+	}
+	assert.NoError(t, store.Prepare(&pod, &model.NodeInfo{}))
+	container := pod.Spec.Containers[0]
+
+	// Node restart clears the tmpfs
+	assert.NoError(t, store.Cleanup("eliot", "my-pod"))
+
+	assert.NoError(t, store.EnsureProjected(pod, &model.NodeInfo{}, container))
+
+	content, err := ioutil.ReadFile(filepath.Join(container.Mounts[0].Source, "token"))
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", string(content))
+
+	content, err = ioutil.ReadFile(filepath.Join(container.Mounts[1].Source, "metadata.name"))
+	assert.NoError(t, err)
+	assert.Equal(t, "my-pod", string(content))
+}
+
+func TestPrepareReturnErrorIfKeyNotFound(t *testing.T) {
+	store, _, cleanup := newTestStore(t)
+	defer cleanup()
+
+	store.CreateSecret(model.Secret{Metadata: model.NewMetadata("eliot", "api")})
+
+	pod := model.Pod{
+		Metadata: model.NewMetadata("eliot", "my-pod"),
+		Spec: model.PodSpec{
+			Containers: []model.Container{
+				{
+					Name: "foo",
+					EnvVars: []model.EnvVar{
+						{Name: "TOKEN", ValueFrom: model.EnvVarSource{SecretKeyRef: &model.KeySelector{Name: "api", Key: "token"}}},
+					},
+				},
+			},
+		},
+	}
+
+	assert.Error(t, store.Prepare(&pod, &model.NodeInfo{}))
+}
+
+func TestPrepareDownwardAPI(t *testing.T) {
+	store, _, cleanup := newTestStore(t)
+	defer cleanup()
+
+	pod := model.Pod{
+		Metadata: model.NewMetadata("eliot", "my-pod"),
+		Spec: model.PodSpec{
+			Containers: []model.Container{
+				{
+					Name: "foo",
+					EnvVars: []model.EnvVar{
+						{Name: "LOCATION", ValueFrom: model.EnvVarSource{FieldRef: "node.labels['location']"}},
+						{Name: "POD_NAME", ValueFrom: model.EnvVarSource{FieldRef: "metadata.name"}},
+					},
+					Mounts: []model.Mount{
+						{Type: "downwardAPI", Destination: "/etc/eliot"},
+					},
+				},
+			},
+		},
+	}
+	info := &model.NodeInfo{
+		Hostname: "my-node",
+		Labels:   map[string]string{"location": "office"},
+	}
+
+	assert.NoError(t, store.Prepare(&pod, info))
+
+	container := pod.Spec.Containers[0]
+	assert.Equal(t, []string{"LOCATION=office", "POD_NAME=my-pod"}, container.Env)
+	assert.Equal(t, filepath.Join(store.projected, "eliot", "my-pod", "foo", "downwardapi"), container.Mounts[0].Source)
+
+	for file, expected := range map[string]string{
+		"node.hostname": "my-node",
+		"metadata.name": "my-pod",
+		"node.labels":   "location=\"office\"",
+	} {
+		content, err := ioutil.ReadFile(filepath.Join(container.Mounts[0].Source, file))
+		assert.NoError(t, err)
+		assert.Equal(t, expected, string(content))
+	}
+}
+
+func TestRejectPathOutsideRoot(t *testing.T) {
+	store, _, cleanup := newTestStore(t)
+	defer cleanup()
+
+	assert.Error(t, store.CreateSecret(model.Secret{Metadata: model.NewMetadata("../..", "api")}))
+	assert.Error(t, store.CreateConfigMap(model.ConfigMap{Metadata: model.NewMetadata("eliot", "..")}))
+	_, err := store.ListSecrets("../..")
+	assert.Error(t, err)
+	_, err = store.ListConfigMaps("")
+	assert.Error(t, err)
+	_, err = store.DeleteSecret("../../etc", "passwd")
+	assert.Error(t, err)
+	_, err = store.GetConfigMap("eliot", "../settings")
+	assert.Error(t, err)
+}
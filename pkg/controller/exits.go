@@ -0,0 +1,37 @@
+package controller
+
+import (
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	log "github.com/sirupsen/logrus"
+)
+
+// Exits is controller which records the container exit code and reason
+// as soon as the container exits, instead of when the container get restarted or removed
+type Exits struct {
+	client runtime.Client
+	done   chan struct{}
+}
+
+// NewExits creates new Exits controller instance
+func NewExits(client runtime.Client) *Exits {
+	return &Exits{
+		client: client,
+	}
+}
+
+// Serve starts the controller to watch container exits.
+// Panics if the watch fails so the supervisor restarts it
+func (e *Exits) Serve() {
+	log.Infof("Start container exits controller...")
+	e.done = make(chan struct{})
+
+	if err := e.client.WatchTaskExits(e.done); err != nil {
+		log.Panicf("Container exits controller stopped with error: %s", err)
+	}
+}
+
+// Stop the exits controller
+func (e *Exits) Stop() {
+	log.Infof("Stop container exits controller...")
+	close(e.done)
+}
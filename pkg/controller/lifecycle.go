@@ -6,6 +6,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/ernoaapa/eliot/pkg/maintenance"
 	"github.com/ernoaapa/eliot/pkg/runtime"
 	log "github.com/sirupsen/logrus"
 )
@@ -13,16 +14,19 @@ import (
 // Lifecycle is controller which monitors containers and if container stops,
 // restart it based on restart policy
 type Lifecycle struct {
-	client   runtime.Client
-	interval time.Duration
-	serving  bool
+	client      runtime.Client
+	maintenance *maintenance.Manager
+	interval    time.Duration
+	serving     bool
 }
 
-// NewLifecycle creates new Lifecycle controller instance
-func NewLifecycle(client runtime.Client) *Lifecycle {
+// NewLifecycle creates new Lifecycle controller instance.
+// The controller doesn't restart containers while the node is drained
+func NewLifecycle(client runtime.Client, maintenance *maintenance.Manager) *Lifecycle {
 	return &Lifecycle{
-		client:   client,
-		interval: 5 * time.Second,
+		client:      client,
+		maintenance: maintenance,
+		interval:    5 * time.Second,
 	}
 }
 
@@ -54,6 +58,11 @@ func (l *Lifecycle) Stop() {
 }
 
 func (l *Lifecycle) checkAll() error {
+	if l.maintenance.IsDrained() {
+		log.Debugln("Node is drained, lifecycle controller don't restart containers")
+		return nil
+	}
+
 	namespaces, err := l.client.GetNamespaces()
 	if err != nil {
 		log.Warnf("Lifecycle controller cannot validate container statuses, error while fetching namespaces: %s", err)
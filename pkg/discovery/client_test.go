@@ -8,7 +8,7 @@ import (
 )
 
 func TestClientNodes(t *testing.T) {
-	server := NewServer("testing", 1234, "v1.0")
+	server := NewServer("testing", 1234, "v1.0", map[string]string{})
 	go server.Serve()
 	defer server.Stop()
 
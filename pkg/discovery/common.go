@@ -2,3 +2,6 @@ package discovery
 
 // ZeroConfServiceName is the service name for discovering the eliotd
 var ZeroConfServiceName = "_eliot._tcp"
+
+// labelTextPrefix is the prefix of node label values in the zeroconf TXT record. E.g. label.location=barn
+var labelTextPrefix = "label."
@@ -10,19 +10,32 @@ import (
 
 func MapToAPIModel(entry *zeroconf.ServiceEntry) *node.Info {
 	version := "unknown"
+	unschedulable := false
+	labels := []*node.Label{}
 
 	for _, val := range entry.Text {
 		parts := strings.SplitN(val, "=", 2)
-		if len(parts) == 2 && parts[0] == "v" {
+		if len(parts) != 2 {
+			continue
+		}
+		switch {
+		case parts[0] == "v":
 			version = parts[1]
+		case parts[0] == "unschedulable":
+			unschedulable = parts[1] == "true"
+		case strings.HasPrefix(parts[0], labelTextPrefix):
+			labels = append(labels, &node.Label{Key: strings.TrimPrefix(parts[0], labelTextPrefix), Value: parts[1]})
 		}
 	}
 
 	return &node.Info{
+		Labels:    labels,
 		Hostname:  entry.HostName,
 		Addresses: addressesToString(append(entry.AddrIPv4, entry.AddrIPv6...)),
 		GrpcPort:  int64(entry.Port),
 		Version:   version,
+
+		Unschedulable: unschedulable,
 	}
 }
 
@@ -13,11 +13,14 @@ func TestMapToInternalModel(t *testing.T) {
 		HostName: "hostname",
 		AddrIPv4: []net.IP{net.IPv4zero},
 		AddrIPv6: []net.IP{net.IPv6loopback},
-		Text:     []string{"v=1.2.3-abcd"},
+		Text:     []string{"v=1.2.3-abcd", "unschedulable=true", "label.location=barn"},
 	})
 
 	assert.Equal(t, "hostname", result.Hostname)
 	assert.Equal(t, "1.2.3-abcd", result.Version)
+	assert.True(t, result.Unschedulable)
+	assert.Equal(t, "location", result.Labels[0].Key)
+	assert.Equal(t, "barn", result.Labels[0].Value)
 	assert.Equal(t, addressesToString([]net.IP{net.IPv4zero, net.IPv6loopback}), result.Addresses)
 }
 
@@ -2,6 +2,8 @@ package discovery
 
 import (
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/grandcat/zeroconf"
 	log "github.com/sirupsen/logrus"
@@ -9,21 +11,26 @@ import (
 
 // Server is zeroconf discovery server
 type Server struct {
-	Name     string
-	Domain   string
-	Port     int
-	Version  string
-	server   *zeroconf.Server
-	shutdown chan bool
+	Name    string
+	Domain  string
+	Port    int
+	Version string
+	labels  map[string]string
+	// True if the node is cordoned and doesn't accept new pods
+	unschedulable bool
+	server        *zeroconf.Server
+	shutdown      chan bool
+	mu            sync.Mutex
 }
 
 // NewServer creates new discovery server
-func NewServer(name string, port int, version string) *Server {
+func NewServer(name string, port int, version string, labels map[string]string) *Server {
 	return &Server{
 		Name:     name,
 		Domain:   "local.",
 		Port:     port,
 		Version:  version,
+		labels:   labels,
 		shutdown: make(chan bool),
 	}
 }
@@ -32,14 +39,14 @@ func NewServer(name string, port int, version string) *Server {
 func (s *Server) Serve() {
 	log.Infof("Start discovery server...")
 	log.Debugf("Exposing %s in port %d", s.Name, s.Port)
-	server, err := zeroconf.Register(s.Name, ZeroConfServiceName, s.Domain, s.Port, []string{
-		fmt.Sprintf("v=%s", s.Version),
-	}, nil)
+	s.mu.Lock()
+	server, err := zeroconf.Register(s.Name, ZeroConfServiceName, s.Domain, s.Port, s.getText(), nil)
 	if err != nil {
 		log.Fatalf("Failed to create zeroconf server: %s", err)
 	}
 
 	s.server = server
+	s.mu.Unlock()
 
 	select {
 	case <-s.shutdown:
@@ -47,6 +54,43 @@ func (s *Server) Serve() {
 	}
 }
 
+// SetLabels updates the advertised node labels
+func (s *Server) SetLabels(labels map[string]string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.labels = labels
+	if s.server != nil {
+		s.server.SetText(s.getText())
+	}
+}
+
+// SetUnschedulable updates the advertised node cordon state
+func (s *Server) SetUnschedulable(unschedulable bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.unschedulable = unschedulable
+	if s.server != nil {
+		s.server.SetText(s.getText())
+	}
+}
+
+// getText returns the TXT record values, caller must hold the lock
+func (s *Server) getText() []string {
+	text := []string{
+		fmt.Sprintf("v=%s", s.Version),
+	}
+	if s.unschedulable {
+		text = append(text, "unschedulable=true")
+	}
+
+	labels := []string{}
+	for key, value := range s.labels {
+		labels = append(labels, fmt.Sprintf("%s%s=%s", labelTextPrefix, key, value))
+	}
+	sort.Strings(labels)
+	return append(text, labels...)
+}
+
 // Stop server to be discoverable
 func (s *Server) Stop() {
 	log.Infof("Stop discovery server...")
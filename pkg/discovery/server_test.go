@@ -3,11 +3,13 @@ package discovery
 import (
 	"sync"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestServerServeStop(t *testing.T) {
 	var wg sync.WaitGroup
-	server := NewServer("testing", 1234, "v1.0")
+	server := NewServer("testing", 1234, "v1.0", map[string]string{"location": "barn"})
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
@@ -17,3 +19,12 @@ func TestServerServeStop(t *testing.T) {
 	server.Stop()
 	wg.Wait()
 }
+
+func TestServerText(t *testing.T) {
+	server := NewServer("testing", 1234, "v1.0", map[string]string{"location": "barn", "camera": "true"})
+	assert.Equal(t, []string{"v=v1.0", "label.camera=true", "label.location=barn"}, server.getText())
+
+	server.SetLabels(map[string]string{"location": "office"})
+	server.SetUnschedulable(true)
+	assert.Equal(t, []string{"v=v1.0", "unschedulable=true", "label.location=office"}, server.getText())
+}
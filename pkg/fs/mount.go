@@ -0,0 +1,29 @@
+package fs
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// IsMountPoint returns true if something is mounted to the path.
+// The mountinfo is path to the mountinfo file, usually /proc/self/mountinfo
+func IsMountPoint(mountinfo, path string) (bool, error) {
+	file, err := os.Open(mountinfo)
+	if err != nil {
+		return false, errors.Wrapf(err, "Failed to read mounts")
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// Fifth field is the mount point, see proc(5)
+		fields := strings.Fields(scanner.Text())
+		if len(fields) > 4 && fields[4] == path {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
@@ -0,0 +1,218 @@
+package hardware
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/pkg/errors"
+)
+
+// Allocator assigns the node hardware devices to the pods
+// and makes sure that exclusive devices are used only by single pod at the time
+type Allocator struct {
+	discoverer *Discoverer
+	client     runtime.Client
+
+	mu sync.Mutex
+	// Devices reserved for pods which are still being created, device path -> pod
+	reserved map[string]string
+}
+
+// NewAllocator creates new Allocator which allocates devices found by the discoverer
+func NewAllocator(discoverer *Discoverer, client runtime.Client) *Allocator {
+	return &Allocator{
+		discoverer: discoverer,
+		client:     client,
+		reserved:   map[string]string{},
+	}
+}
+
+// GetResources returns the node hardware resources with allocation information
+func (a *Allocator) GetResources() ([]model.DeviceResource, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	resources := a.discoverer.Discover()
+	allocations, err := a.getAllocations(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources {
+		for i, device := range resource.Devices {
+			resource.Devices[i].AllocatedTo = allocations[device.Path]
+		}
+	}
+	return resources, nil
+}
+
+// Allocate resolves devices for each container resource request and adds them to the container devices.
+// Returns error if the container devices refer to exclusive device what is allocated to another pod.
+// Exclusive devices get reserved to the pod until Release is called. Call Release once the pod containers
+// have been created (or creation failed), after that the allocation is resolved from the containers.
+func (a *Allocator) Allocate(pod *model.Pod) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	resources := a.discoverer.Discover()
+	allocations, err := a.getAllocations(resources)
+	if err != nil {
+		return err
+	}
+
+	var (
+		exclusive = getExclusiveDevices(resources)
+		key       = podKey(*pod)
+		reserved  = []string{}
+	)
+	for _, container := range pod.Spec.Containers {
+		for _, device := range container.Devices {
+			for _, path := range resolveHostPaths(device, resources) {
+				if !exclusive[path] {
+					continue
+				}
+				if owner, allocated := allocations[path]; allocated && owner != key {
+					return errors.Errorf("Cannot use device [%s] for container [%s], it is exclusively allocated to pod [%s]", path, container.Name, owner)
+				}
+				allocations[path] = key
+				reserved = append(reserved, path)
+			}
+		}
+	}
+
+	for i, container := range pod.Spec.Containers {
+		for _, name := range getSortedKeys(container.Resources) {
+			count := container.Resources[name]
+			resource, ok := findResource(resources, name)
+			if !ok {
+				return errors.Errorf("Cannot allocate [%s] for container [%s], node doesn't have such hardware resource", name, container.Name)
+			}
+
+			free := getFreeDevices(resource, allocations)
+			if len(free) < count {
+				return errors.Errorf("Cannot allocate [%s] for container [%s], requested %d but only %d available", name, container.Name, count, len(free))
+			}
+
+			for _, path := range free[:count] {
+				pod.Spec.Containers[i].Devices = append(pod.Spec.Containers[i].Devices, model.Device{HostPath: path})
+				if resource.Exclusive {
+					allocations[path] = key
+					reserved = append(reserved, path)
+				}
+			}
+		}
+	}
+
+	for _, path := range reserved {
+		a.reserved[path] = key
+	}
+	return nil
+}
+
+// Release removes the pod device reservations
+func (a *Allocator) Release(pod model.Pod) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	key := podKey(pod)
+	for path, owner := range a.reserved {
+		if owner == key {
+			delete(a.reserved, path)
+		}
+	}
+}
+
+// getAllocations resolves which pod uses each device, device path -> pod
+func (a *Allocator) getAllocations(resources []model.DeviceResource) (map[string]string, error) {
+	result := map[string]string{}
+	for path, pod := range a.reserved {
+		result[path] = pod
+	}
+
+	namespaces, err := a.client.GetNamespaces()
+	if err != nil {
+		return nil, errors.Wrap(err, "Cannot resolve device allocations, failed to fetch namespaces")
+	}
+
+	for _, namespace := range namespaces {
+		pods, err := a.client.GetPods(namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Cannot resolve device allocations, failed to fetch pods in namespace [%s]", namespace)
+		}
+
+		for _, pod := range pods {
+			for _, container := range pod.Spec.Containers {
+				for _, device := range container.Devices {
+					for _, path := range resolveHostPaths(device, resources) {
+						result[path] = podKey(pod)
+					}
+				}
+			}
+		}
+	}
+	return result, nil
+}
+
+// resolveHostPaths returns the device host paths, glob pattern gets matched to the discovered devices
+func resolveHostPaths(device model.Device, resources []model.DeviceResource) (result []string) {
+	if !model.IsGlobPattern(device.HostPath) {
+		return []string{device.HostPath}
+	}
+	for _, resource := range resources {
+		for _, discovered := range resource.Devices {
+			if matched, _ := filepath.Match(device.HostPath, discovered.Path); matched {
+				result = append(result, discovered.Path)
+			}
+		}
+	}
+	return result
+}
+
+// getExclusiveDevices returns the paths of the devices what can be used only by single pod at the time
+func getExclusiveDevices(resources []model.DeviceResource) map[string]bool {
+	result := map[string]bool{}
+	for _, resource := range resources {
+		if !resource.Exclusive {
+			continue
+		}
+		for _, device := range resource.Devices {
+			result[device.Path] = true
+		}
+	}
+	return result
+}
+
+func getFreeDevices(resource model.DeviceResource, allocations map[string]string) (result []string) {
+	for _, device := range resource.Devices {
+		if _, allocated := allocations[device.Path]; resource.Exclusive && allocated {
+			continue
+		}
+		result = append(result, device.Path)
+	}
+	return result
+}
+
+func findResource(resources []model.DeviceResource, name string) (model.DeviceResource, bool) {
+	for _, resource := range resources {
+		if resource.Name == name {
+			return resource, true
+		}
+	}
+	return model.DeviceResource{}, false
+}
+
+func getSortedKeys(source map[string]int) (result []string) {
+	for key := range source {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func podKey(pod model.Pod) string {
+	return fmt.Sprintf("%s/%s", pod.Metadata.Namespace, pod.Metadata.Name)
+}
@@ -0,0 +1,130 @@
+package hardware
+
+import (
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClient struct {
+	runtime.Client
+	pods []model.Pod
+}
+
+func (c *fakeClient) GetNamespaces() ([]string, error) {
+	return []string{"eliot"}, nil
+}
+
+func (c *fakeClient) GetPods(namespace string) ([]model.Pod, error) {
+	return c.pods, nil
+}
+
+func newPod(name string, resources map[string]int) model.Pod {
+	return model.Pod{
+		Metadata: model.NewMetadata("eliot", name),
+		Spec: model.PodSpec{
+			Containers: []model.Container{
+				{Name: "foo", Resources: resources},
+			},
+		},
+	}
+}
+
+func TestAllocate(t *testing.T) {
+	allocator := NewAllocator(NewDiscoverer("testdata/sys"), &fakeClient{})
+
+	pod := newPod("my-pod", map[string]int{"serial": 2, "i2c": 1})
+	assert.NoError(t, allocator.Allocate(&pod))
+
+	assert.Equal(t, []model.Device{
+		{HostPath: "/dev/i2c-1"},
+		{HostPath: "/dev/ttyAMA0"},
+		{HostPath: "/dev/ttyUSB0"},
+	}, pod.Spec.Containers[0].Devices)
+}
+
+func TestAllocateRefusesAllocatedExclusiveDevice(t *testing.T) {
+	running := newPod("running", nil)
+	running.Spec.Containers[0].Devices = []model.Device{{HostPath: "/dev/video0"}}
+	allocator := NewAllocator(NewDiscoverer("testdata/sys"), &fakeClient{pods: []model.Pod{running}})
+
+	pod := newPod("my-pod", map[string]int{"camera": 1})
+	assert.Error(t, allocator.Allocate(&pod))
+}
+
+func TestAllocateRefusesExplicitDeviceAllocatedExclusively(t *testing.T) {
+	running := newPod("running", nil)
+	running.Spec.Containers[0].Devices = []model.Device{{HostPath: "/dev/video0"}}
+	allocator := NewAllocator(NewDiscoverer("testdata/sys"), &fakeClient{pods: []model.Pod{running}})
+
+	pod := newPod("my-pod", nil)
+	pod.Spec.Containers[0].Devices = []model.Device{{HostPath: "/dev/video0"}}
+	assert.Error(t, allocator.Allocate(&pod))
+
+	pod.Spec.Containers[0].Devices = []model.Device{{HostPath: "/dev/video*"}}
+	assert.Error(t, allocator.Allocate(&pod), "Should refuse glob pattern what matches to allocated device")
+
+	pod.Spec.Containers[0].Devices = []model.Device{{HostPath: "/dev/i2c-1"}, {HostPath: "/dev/ttyUSB0"}}
+	assert.NoError(t, allocator.Allocate(&pod))
+
+	other := newPod("other", map[string]int{"serial": 2})
+	assert.NoError(t, allocator.Allocate(&other))
+	assert.Equal(t, []model.Device{{HostPath: "/dev/ttyAMA0"}, {HostPath: "/dev/ttyUSB1"}}, other.Spec.Containers[0].Devices, "Should not allocate the device reserved with explicit device")
+}
+
+func TestAllocateCountsGlobDevicesAsAllocated(t *testing.T) {
+	running := newPod("running", nil)
+	running.Spec.Containers[0].Devices = []model.Device{{HostPath: "/dev/ttyUSB*"}}
+	allocator := NewAllocator(NewDiscoverer("testdata/sys"), &fakeClient{pods: []model.Pod{running}})
+
+	pod := newPod("my-pod", map[string]int{"serial": 2})
+	assert.Error(t, allocator.Allocate(&pod))
+
+	pod = newPod("my-pod", map[string]int{"serial": 1})
+	assert.NoError(t, allocator.Allocate(&pod))
+	assert.Equal(t, []model.Device{{HostPath: "/dev/ttyAMA0"}}, pod.Spec.Containers[0].Devices)
+}
+
+func TestAllocateReservesUntilRelease(t *testing.T) {
+	allocator := NewAllocator(NewDiscoverer("testdata/sys"), &fakeClient{})
+
+	first := newPod("first", map[string]int{"camera": 1})
+	second := newPod("second", map[string]int{"camera": 1})
+
+	assert.NoError(t, allocator.Allocate(&first))
+	assert.Error(t, allocator.Allocate(&second), "Should not allocate exclusive device reserved for the first pod")
+
+	allocator.Release(first)
+	assert.NoError(t, allocator.Allocate(&second))
+}
+
+func TestAllocateSharesNonExclusiveDevices(t *testing.T) {
+	allocator := NewAllocator(NewDiscoverer("testdata/sys"), &fakeClient{})
+
+	first := newPod("first", map[string]int{"i2c": 1})
+	second := newPod("second", map[string]int{"i2c": 1})
+
+	assert.NoError(t, allocator.Allocate(&first))
+	assert.NoError(t, allocator.Allocate(&second))
+}
+
+func TestAllocateUnknownResource(t *testing.T) {
+	allocator := NewAllocator(NewDiscoverer("testdata/sys"), &fakeClient{})
+
+	pod := newPod("my-pod", map[string]int{"lidar": 1})
+	assert.Error(t, allocator.Allocate(&pod))
+}
+
+func TestGetResources(t *testing.T) {
+	running := newPod("running", nil)
+	running.Spec.Containers[0].Devices = []model.Device{{HostPath: "/dev/video0"}}
+	allocator := NewAllocator(NewDiscoverer("testdata/sys"), &fakeClient{pods: []model.Pod{running}})
+
+	resources, err := allocator.GetResources()
+	assert.NoError(t, err)
+
+	camera, _ := findResource(resources, "camera")
+	assert.Equal(t, "eliot/running", camera.Devices[0].AllocatedTo)
+}
@@ -0,0 +1,99 @@
+package hardware
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	log "github.com/sirupsen/logrus"
+)
+
+// deviceClass describes where from sysfs to find one type of devices
+type deviceClass struct {
+	// Resource name what the devices get advertised with
+	resource string
+	// Directory relative to the sysfs root where each device have an entry
+	dir string
+	// Device entry name prefixes to include
+	prefixes []string
+	// Can the device be used only by one pod at the time
+	exclusive bool
+}
+
+var deviceClasses = []deviceClass{
+	{resource: "gpio", dir: "bus/gpio/devices", prefixes: []string{"gpiochip"}},
+	{resource: "i2c", dir: "class/i2c-dev", prefixes: []string{"i2c-"}},
+	{resource: "spi", dir: "class/spidev", prefixes: []string{"spidev"}},
+	{resource: "serial", dir: "class/tty", prefixes: []string{"ttyUSB", "ttyACM", "ttyAMA"}, exclusive: true},
+	{resource: "camera", dir: "class/video4linux", prefixes: []string{"video"}, exclusive: true},
+}
+
+// Discoverer finds hardware devices from the node
+type Discoverer struct {
+	sysfsRoot string
+}
+
+// NewDiscoverer creates new Discoverer what reads devices from the given sysfs root (normally /sys)
+func NewDiscoverer(sysfsRoot string) *Discoverer {
+	return &Discoverer{
+		sysfsRoot: sysfsRoot,
+	}
+}
+
+// Discover returns the hardware devices grouped by resource name.
+// Resources which don't have any devices are not included.
+func (d *Discoverer) Discover() (result []model.DeviceResource) {
+	for _, class := range deviceClasses {
+		names, err := listEntries(filepath.Join(d.sysfsRoot, class.dir), class.prefixes)
+		if err != nil {
+			log.Warnf("Failed to discover %s devices, skip them: %s", class.resource, err)
+			continue
+		}
+		if len(names) == 0 {
+			continue
+		}
+
+		resource := model.DeviceResource{
+			Name:      class.resource,
+			Exclusive: class.exclusive,
+		}
+		for _, name := range names {
+			resource.Devices = append(resource.Devices, model.NodeDevice{
+				Path: filepath.Join("/dev", name),
+			})
+		}
+		result = append(result, resource)
+	}
+	return result
+}
+
+// listEntries return sorted entry names in the directory what have one of the prefixes
+func listEntries(dir string, prefixes []string) (result []string, err error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, nil
+		}
+		return result, err
+	}
+
+	for _, entry := range entries {
+		if hasAnyPrefix(entry.Name(), prefixes) {
+			result = append(result, entry.Name())
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+func hasAnyPrefix(value string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(value, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,23 @@
+package hardware
+
+import (
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscover(t *testing.T) {
+	resources := NewDiscoverer("testdata/sys").Discover()
+
+	assert.Equal(t, []model.DeviceResource{
+		{Name: "gpio", Devices: []model.NodeDevice{{Path: "/dev/gpiochip0"}, {Path: "/dev/gpiochip100"}}},
+		{Name: "i2c", Devices: []model.NodeDevice{{Path: "/dev/i2c-1"}}},
+		{Name: "serial", Exclusive: true, Devices: []model.NodeDevice{{Path: "/dev/ttyAMA0"}, {Path: "/dev/ttyUSB0"}, {Path: "/dev/ttyUSB1"}}},
+		{Name: "camera", Exclusive: true, Devices: []model.NodeDevice{{Path: "/dev/video0"}}},
+	}, resources)
+}
+
+func TestDiscoverWithoutSysfs(t *testing.T) {
+	assert.Empty(t, NewDiscoverer("testdata/dont-exist").Discover())
+}
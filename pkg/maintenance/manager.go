@@ -0,0 +1,315 @@
+package maintenance
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultStateFile is the default file where the node maintenance state get persisted
+const DefaultStateFile = "/var/lib/eliot/maintenance.yml"
+
+// PodRef identifies the pod by namespace and name
+type PodRef struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+type state struct {
+	Cordoned bool     `json:"cordoned"`
+	Drained  []PodRef `json:"drained,omitempty"`
+}
+
+// Manager keeps track of the node maintenance state.
+// Cordoned node doesn't accept new pods and drained node have all its pods stopped until the node get uncordoned
+type Manager struct {
+	client    runtime.Client
+	file      string
+	state     state
+	stopped   bool
+	listeners []func(cordoned bool)
+	mu        sync.Mutex
+}
+
+// NewManager creates new maintenance manager what persists the state to the file
+func NewManager(client runtime.Client, file string) *Manager {
+	state, err := readState(file)
+	if err != nil {
+		log.Errorf("Failed to read maintenance state from [%s], will start as schedulable: %s", file, err)
+	}
+	return &Manager{
+		client: client,
+		file:   file,
+		state:  state,
+	}
+}
+
+// IsCordoned returns true if the node doesn't accept new pods
+func (m *Manager) IsCordoned() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.state.Cordoned || m.stopped
+}
+
+// IsDrained returns true if the node pods have been stopped by drain and should not be restarted
+func (m *Manager) IsDrained() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.state.Drained) > 0 || m.stopped
+}
+
+// OnChange registers function what gets called when the node get cordoned or uncordoned
+func (m *Manager) OnChange(listener func(cordoned bool)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listeners = append(m.listeners, listener)
+}
+
+// Cordon marks the node unschedulable so it doesn't accept new pods
+func (m *Manager) Cordon() error {
+	return m.update(func(s *state) {
+		s.Cordoned = true
+	})
+}
+
+// Drain cordons the node and stops all pods gracefully within their termination grace periods.
+// The pods get restarted when the node get uncordoned
+func (m *Manager) Drain() ([]PodRef, error) {
+	pods, err := m.getPods()
+	if err != nil {
+		return nil, err
+	}
+
+	refs := []PodRef{}
+	for _, pod := range pods {
+		refs = append(refs, PodRef{Namespace: pod.Metadata.Namespace, Name: pod.Metadata.Name})
+	}
+
+	// Store the state first so the lifecycle controller doesn't restart the pods while we stop them
+	err = m.update(func(s *state) {
+		s.Cordoned = true
+		s.Drained = mergeRefs(s.Drained, refs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := StopPods(m.client, pods); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// Stop stops all pods gracefully without persisting the state, e.g. before the host reboots.
+// The node rejects new pods and doesn't restart the pods until Resume, Uncordon or the process restarts.
+// Returns error if the pods are already stopped
+func (m *Manager) Stop() ([]PodRef, error) {
+	m.mu.Lock()
+	if m.stopped {
+		m.mu.Unlock()
+		return nil, errors.New("Pods are already stopped for power action")
+	}
+	m.stopped = true
+	m.mu.Unlock()
+
+	pods, err := m.getPods()
+	if err != nil {
+		m.Resume()
+		return nil, err
+	}
+
+	refs := []PodRef{}
+	for _, pod := range pods {
+		refs = append(refs, PodRef{Namespace: pod.Metadata.Namespace, Name: pod.Metadata.Name})
+	}
+	if err := StopPods(m.client, pods); err != nil {
+		m.Resume()
+		return nil, err
+	}
+	return refs, nil
+}
+
+// Resume clears the Stop so the node accepts new pods and restarts the stopped pods again
+func (m *Manager) Resume() {
+	m.mu.Lock()
+	m.stopped = false
+	m.mu.Unlock()
+}
+
+// Restart clears the Stop and restarts the pods what Stop returned with the start function, e.g. if the host power action fails.
+// Drained pods stay stopped until the node get uncordoned
+func (m *Manager) Restart(refs []PodRef, start func(namespace, name string) error) (err error) {
+	m.mu.Lock()
+	drained := append([]PodRef{}, m.state.Drained...)
+	m.mu.Unlock()
+
+	m.Resume()
+	for _, ref := range refs {
+		if containsRef(drained, ref) {
+			continue
+		}
+		if startErr := start(ref.Namespace, ref.Name); startErr != nil {
+			if runtime.IsNotFound(startErr) {
+				log.Warnf("Stopped pod [%s/%s] doesn't exist anymore, cannot restart it", ref.Namespace, ref.Name)
+				continue
+			}
+			log.Errorf("Failed to restart stopped pod [%s/%s]: %s", ref.Namespace, ref.Name, startErr)
+			if err == nil {
+				err = errors.Wrapf(startErr, "Failed to restart stopped pod [%s/%s]", ref.Namespace, ref.Name)
+			}
+		}
+	}
+	return err
+}
+
+// Uncordon restarts the drained pods with the start function and marks the node schedulable again
+func (m *Manager) Uncordon(start func(namespace, name string) error) ([]PodRef, error) {
+	m.mu.Lock()
+	drained := append([]PodRef{}, m.state.Drained...)
+	m.mu.Unlock()
+
+	for _, ref := range drained {
+		if err := start(ref.Namespace, ref.Name); err != nil {
+			if runtime.IsNotFound(err) {
+				log.Warnf("Drained pod [%s/%s] doesn't exist anymore, cannot restart it", ref.Namespace, ref.Name)
+				continue
+			}
+			return nil, errors.Wrapf(err, "Failed to restart drained pod [%s/%s]", ref.Namespace, ref.Name)
+		}
+	}
+
+	err := m.update(func(s *state) {
+		s.Cordoned = false
+		s.Drained = nil
+	})
+	if err == nil {
+		m.Resume()
+	}
+	return drained, err
+}
+
+func (m *Manager) getPods() (result []model.Pod, err error) {
+	namespaces, err := m.client.GetNamespaces()
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to fetch namespaces")
+	}
+
+	for _, namespace := range namespaces {
+		pods, err := m.client.GetPods(namespace)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to fetch pods in namespace [%s]", namespace)
+		}
+		for _, pod := range pods {
+			if pod.Metadata.Name == model.SystemPodName {
+				continue
+			}
+			result = append(result, pod)
+		}
+	}
+	return result, nil
+}
+
+func (m *Manager) update(change func(s *state)) error {
+	m.mu.Lock()
+	updated := m.state
+	change(&updated)
+	if err := writeState(m.file, updated); err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	changed := updated.Cordoned != m.state.Cordoned
+	m.state = updated
+	listeners := m.listeners
+	m.mu.Unlock()
+
+	if changed {
+		for _, listener := range listeners {
+			listener(updated.Cordoned)
+		}
+	}
+	return nil
+}
+
+// StopPods stops all running containers of the pods gracefully, the containers don't get removed
+func StopPods(client runtime.Client, pods []model.Pod) error {
+	for _, pod := range pods {
+		// Stop in reverse order because the other containers join the first container namespaces
+		for i := len(pod.Status.ContainerStatuses) - 1; i >= 0; i-- {
+			status := pod.Status.ContainerStatuses[i]
+			if status.State != "running" {
+				continue
+			}
+			log.Debugf("Stop container [%s] in pod [%s/%s]", status.Name, pod.Metadata.Namespace, pod.Metadata.Name)
+			if _, err := client.TerminateContainer(pod.Metadata.Namespace, status.ContainerID); err != nil {
+				return errors.Wrapf(err, "Failed to stop container [%s] in pod [%s/%s]", status.Name, pod.Metadata.Namespace, pod.Metadata.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func mergeRefs(existing, refs []PodRef) []PodRef {
+	result := append([]PodRef{}, existing...)
+	for _, ref := range refs {
+		if !containsRef(existing, ref) {
+			result = append(result, ref)
+		}
+	}
+	return result
+}
+
+func containsRef(refs []PodRef, ref PodRef) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+func readState(path string) (s state, err error) {
+	if path == "" {
+		return s, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return s, errors.Wrapf(err, "Invalid maintenance state file [%s]", path)
+	}
+	return s, nil
+}
+
+func writeState(path string, s state) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := yaml.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "Failed to create directory for maintenance state file [%s]", path)
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return errors.Wrapf(err, "Failed to write maintenance state file [%s]", path)
+	}
+	return os.Rename(tmp, path)
+}
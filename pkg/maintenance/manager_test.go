@@ -0,0 +1,151 @@
+package maintenance
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClient struct {
+	runtime.Client
+	pods       []model.Pod
+	terminated []string
+}
+
+func (c *fakeClient) GetNamespaces() ([]string, error) {
+	return []string{"eliot"}, nil
+}
+
+func (c *fakeClient) GetPods(namespace string) ([]model.Pod, error) {
+	return c.pods, nil
+}
+
+func (c *fakeClient) TerminateContainer(namespace, id string) (model.ContainerStatus, error) {
+	c.terminated = append(c.terminated, id)
+	return model.ContainerStatus{ContainerID: id, State: "stopped"}, nil
+}
+
+func newPod(name string, statuses ...model.ContainerStatus) model.Pod {
+	return model.Pod{
+		Metadata: model.NewMetadata("eliot", name),
+		Status:   model.PodStatus{ContainerStatuses: statuses},
+	}
+}
+
+func TestDrainAndUncordon(t *testing.T) {
+	dir, err := ioutil.TempDir("", "maintenance-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "maintenance.yml")
+
+	client := &fakeClient{
+		pods: []model.Pod{
+			newPod("my-pod",
+				model.ContainerStatus{Name: "first", ContainerID: "1", State: "running"},
+				model.ContainerStatus{Name: "second", ContainerID: "2", State: "running"},
+				model.ContainerStatus{Name: "stopped", ContainerID: "3", State: "stopped"},
+			),
+			newPod(model.SystemPodName, model.ContainerStatus{Name: "eliotd", ContainerID: "4", State: "running"}),
+		},
+	}
+	manager := NewManager(client, file)
+
+	changes := []bool{}
+	manager.OnChange(func(cordoned bool) {
+		changes = append(changes, cordoned)
+	})
+
+	drained, err := manager.Drain()
+	assert.NoError(t, err)
+	assert.Equal(t, []PodRef{{Namespace: "eliot", Name: "my-pod"}}, drained)
+	assert.Equal(t, []string{"2", "1"}, client.terminated, "should stop running containers in reverse order and skip system pod")
+	assert.True(t, manager.IsCordoned())
+	assert.True(t, manager.IsDrained())
+
+	restarted := NewManager(client, file)
+	assert.True(t, restarted.IsCordoned(), "should persist the state")
+	assert.True(t, restarted.IsDrained(), "should persist the drained pods")
+
+	started := []string{}
+	uncordoned, err := manager.Uncordon(func(namespace, name string) error {
+		started = append(started, namespace+"/"+name)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, drained, uncordoned)
+	assert.Equal(t, []string{"eliot/my-pod"}, started)
+	assert.False(t, manager.IsCordoned())
+	assert.False(t, manager.IsDrained())
+	assert.Equal(t, []bool{true, false}, changes)
+}
+
+func TestCordon(t *testing.T) {
+	manager := NewManager(&fakeClient{}, "")
+
+	assert.False(t, manager.IsCordoned())
+	assert.NoError(t, manager.Cordon())
+	assert.True(t, manager.IsCordoned())
+	assert.False(t, manager.IsDrained(), "cordon should not drain the pods")
+}
+
+func TestStop(t *testing.T) {
+	dir, err := ioutil.TempDir("", "maintenance-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "maintenance.yml")
+
+	client := &fakeClient{
+		pods: []model.Pod{
+			newPod("my-pod", model.ContainerStatus{Name: "first", ContainerID: "1", State: "running"}),
+		},
+	}
+	manager := NewManager(client, file)
+
+	stopped, err := manager.Stop()
+	assert.NoError(t, err)
+	assert.Equal(t, []PodRef{{Namespace: "eliot", Name: "my-pod"}}, stopped)
+	assert.Equal(t, []string{"1"}, client.terminated)
+	assert.True(t, manager.IsCordoned(), "should reject new pods while stopping")
+	assert.True(t, manager.IsDrained(), "should pause restarting the pods")
+
+	_, err = manager.Stop()
+	assert.Error(t, err, "should not stop twice")
+
+	restarted := NewManager(client, file)
+	assert.False(t, restarted.IsCordoned(), "should not persist the state")
+	assert.False(t, restarted.IsDrained(), "should not persist the stopped pods")
+
+	_, err = manager.Uncordon(func(namespace, name string) error { return nil })
+	assert.NoError(t, err)
+	assert.False(t, manager.IsCordoned(), "uncordon should clear the stop")
+	assert.False(t, manager.IsDrained(), "uncordon should clear the stop")
+}
+
+func TestRestartAfterStop(t *testing.T) {
+	client := &fakeClient{
+		pods: []model.Pod{
+			newPod("my-pod", model.ContainerStatus{Name: "first", ContainerID: "1", State: "running"}),
+			newPod("drained", model.ContainerStatus{Name: "first", ContainerID: "2", State: "stopped"}),
+		},
+	}
+	manager := NewManager(client, "")
+	manager.state.Drained = []PodRef{{Namespace: "eliot", Name: "drained"}}
+
+	stopped, err := manager.Stop()
+	assert.NoError(t, err)
+
+	started := []PodRef{}
+	assert.NoError(t, manager.Restart(stopped, func(namespace, name string) error {
+		started = append(started, PodRef{Namespace: namespace, Name: name})
+		return nil
+	}))
+	assert.Equal(t, []PodRef{{Namespace: "eliot", Name: "my-pod"}}, started, "should keep the drained pods stopped")
+
+	_, err = manager.Stop()
+	assert.NoError(t, err, "should clear the stop")
+}
@@ -1,15 +1,77 @@
 package model
 
+import (
+	"strings"
+	"time"
+)
+
 // Container defines what image should be running
 type Container struct {
-	Name       string `validate:"required,gt=0,alphanumOrDash"`
-	Image      string `validate:"required,gt=0,imageRef"`
-	Tty        bool
-	Args       []string `validate:"dive,noSpaces"`
-	Env        []string `validate:"dive,envKeyValuePair"`
+	Name  string `validate:"required,gt=0,alphanumOrDash"`
+	Image string `validate:"required,gt=0,imageRef"`
+	Tty   bool
+	Args  []string `validate:"dive,noSpaces"`
+	Env   []string `validate:"dive,envKeyValuePair"`
+	// Environment variables what get the value from Secret or ConfigMap
+	EnvVars    []EnvVar `validate:"dive"`
 	WorkingDir string   `validate:"omitempty,gt=0"`
 	Mounts     []Mount  `validate:"dive"`
-	Pipe       *PipeSet
+	Devices    []Device `validate:"dive"`
+	// Hardware resources from the node what get allocated to the container. E.g. camera: 1
+	Resources       map[string]int `validate:"dive,keys,alphanumOrDash,endkeys,gt=0"`
+	SecurityContext *SecurityContext
+	// Ports to publish from the pod network to the host. Requires the pod to use bridge network
+	Ports []ContainerPort `validate:"dive"`
+	Pipe  *PipeSet
+}
+
+// ContainerPort defines port mapping from the host to the container
+type ContainerPort struct {
+	ContainerPort int `validate:"required,min=1,max=65535"`
+	// Port in the host, defaults to ContainerPort
+	HostPort int `validate:"omitempty,min=1,max=65535"`
+	// Protocol of the port, tcp or udp. Defaults to tcp
+	Protocol string `validate:"omitempty,protocol"`
+}
+
+// GetHostPort returns the port in the host, defaults to ContainerPort
+func (p ContainerPort) GetHostPort() int {
+	if p.HostPort == 0 {
+		return p.ContainerPort
+	}
+	return p.HostPort
+}
+
+// GetProtocol returns the port protocol in lowercase, defaults to tcp
+func (p ContainerPort) GetProtocol() string {
+	if p.Protocol == "" {
+		return "tcp"
+	}
+	return strings.ToLower(p.Protocol)
+}
+
+// SecurityContext defines privileges and access control settings for the container process
+type SecurityContext struct {
+	// User name or UID to run the container process as
+	User string `validate:"omitempty,excludes=:"`
+	// Group name or GID to run the container process as. Requires User to be defined
+	Group string `validate:"omitempty,excludes=:"`
+	// Capabilities to add or drop from the default set
+	Capabilities *Capabilities
+	// Run the container in privileged mode, i.e. with all capabilities and access to all devices
+	Privileged bool
+	// Mount the container root filesystem as read-only
+	ReadOnlyRootFilesystem bool
+	// Prevent the process gaining more privileges than its parent process
+	NoNewPrivileges bool
+	// Seccomp profile to apply. One of "unconfined", "runtime/default" or "localhost/<path-to-profile-json>"
+	SeccompProfile string `validate:"omitempty,seccompProfile"`
+}
+
+// Capabilities defines Linux capabilities to add or drop. E.g. NET_ADMIN or CAP_NET_ADMIN
+type Capabilities struct {
+	Add  []string `validate:"dive,capability"`
+	Drop []string `validate:"dive,capability"`
 }
 
 // PipeSet allows defining pipe from some source(s) to another container
@@ -35,6 +97,16 @@ type Mount struct {
 	Options     []string `validate:"dive,gt=0"`
 }
 
+// Device defines host device what should be available in the container
+type Device struct {
+	// Path to the device in the host, can contain glob pattern. E.g. /dev/ttyUSB*
+	HostPath string `validate:"required,gt=0"`
+	// Path in the container, defaults to HostPath. Cannot be defined if HostPath is glob pattern
+	ContainerPath string `validate:"omitempty,gt=0"`
+	// Cgroup permissions to the device, any combination of r(ead), w(rite) and m(knod). Defaults to rwm
+	Permissions string `validate:"omitempty,devicePermissions"`
+}
+
 // ContainerStatus represents one container status
 type ContainerStatus struct {
 	ContainerID  string `validate:"required,gt=0"`
@@ -42,4 +114,22 @@ type ContainerStatus struct {
 	Image        string `validate:"required,gt=0,imageRef"`
 	State        string `validate:"required,gt=0"`
 	RestartCount int    `validate:"required,gte=0"`
+	// Exit code of the process, only set when the container is stopped
+	ExitCode int
+	// Time when the container process were last time started
+	StartedAt time.Time
+	// Time when the container process exited, only set when the container is stopped
+	FinishedAt time.Time
+	// Short explanation why the container is in current state. E.g. "Completed" or "Error"
+	Reason string
+	// Details of the previous container process termination, nil if never restarted
+	LastTerminationState *ContainerTerminated
+}
+
+// ContainerTerminated describes how some container process ended
+type ContainerTerminated struct {
+	ExitCode   int
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Reason     string
 }
@@ -46,3 +46,122 @@ func TestValidationRequiresContainerFields(t *testing.T) {
 		Image: "/foo",
 	}), "should return error if container image reference is invalid")
 }
+
+func TestValidationContainerDevices(t *testing.T) {
+	assert.NoError(t, getValidator().Struct(Container{
+		Name:  "foo-1",
+		Image: "docker.io/library/foobar",
+		Devices: []Device{
+			{HostPath: "/dev/i2c-1"},
+			{HostPath: "/dev/gpiomem", ContainerPath: "/dev/gpio", Permissions: "rw"},
+			{HostPath: "/dev/ttyUSB*"},
+		},
+	}), "should be valid")
+
+	assert.Error(t, getValidator().Struct(Container{
+		Name:    "foo-1",
+		Image:   "docker.io/library/foobar",
+		Devices: []Device{{HostPath: "/dev/i2c-1", Permissions: "rwx"}},
+	}), "should return error if permissions contain other than r, w or m")
+
+	assert.Error(t, getValidator().Struct(Container{
+		Name:    "foo-1",
+		Image:   "docker.io/library/foobar",
+		Devices: []Device{{HostPath: "/dev/ttyUSB*", ContainerPath: "/dev/serial"}},
+	}), "should return error if glob pattern have container path")
+}
+
+func TestValidationContainerResources(t *testing.T) {
+	assert.NoError(t, getValidator().Struct(Container{
+		Name:      "foo-1",
+		Image:     "docker.io/library/foobar",
+		Resources: map[string]int{"camera": 1},
+	}), "should be valid")
+
+	assert.Error(t, getValidator().Struct(Container{
+		Name:      "foo-1",
+		Image:     "docker.io/library/foobar",
+		Resources: map[string]int{"camera": 0},
+	}), "should return error if resource count is not positive")
+}
+
+func TestValidationContainerSecurityContext(t *testing.T) {
+	assert.NoError(t, ValidateSecurityContext(SecurityContext{
+		User:  "1000",
+		Group: "1000",
+		Capabilities: &Capabilities{
+			Add:  []string{"NET_ADMIN", "cap_sys_time"},
+			Drop: []string{"ALL"},
+		},
+		SeccompProfile: "localhost/etc/seccomp/profile.json",
+	}), "should be valid")
+
+	assert.Error(t, ValidateSecurityContext(SecurityContext{
+		Capabilities: &Capabilities{Add: []string{"NOT_A_CAPABILITY"}},
+	}), "should return error if capability is unknown")
+
+	assert.Error(t, ValidateSecurityContext(SecurityContext{
+		Group: "1000",
+	}), "should return error if group is defined without user")
+
+	assert.Error(t, ValidateSecurityContext(SecurityContext{
+		User: "1000:1000",
+	}), "should return error if user contains group")
+
+	assert.Error(t, ValidateSecurityContext(SecurityContext{
+		SeccompProfile: "docker/default",
+	}), "should return error if seccomp profile is unknown")
+}
+
+func TestValidatePorts(t *testing.T) {
+	assert.NoError(t, ValidatePorts(PodSpec{
+		Containers: []Container{
+			{Name: "foo", Ports: []ContainerPort{{ContainerPort: 80, HostPort: 8080}}},
+			{Name: "bar", Ports: []ContainerPort{{ContainerPort: 8080, Protocol: "udp"}}},
+		},
+	}), "should be valid")
+
+	assert.Error(t, ValidatePorts(PodSpec{
+		HostNetwork: true,
+		Containers: []Container{
+			{Name: "foo", Ports: []ContainerPort{{ContainerPort: 80}}},
+		},
+	}), "should return error if pod uses host network")
+
+	assert.Error(t, ValidatePorts(PodSpec{
+		Containers: []Container{
+			{Name: "foo", Ports: []ContainerPort{{ContainerPort: 80, Protocol: "sctp"}}},
+		},
+	}), "should return error if protocol is not supported")
+
+	assert.Error(t, ValidatePorts(PodSpec{
+		Containers: []Container{
+			{Name: "foo", Ports: []ContainerPort{{ContainerPort: 80, HostPort: 8080}}},
+			{Name: "bar", Ports: []ContainerPort{{ContainerPort: 8080}}},
+		},
+	}), "should return error if host port is published twice")
+}
+
+func TestValidatePortConflicts(t *testing.T) {
+	existing := []Pod{
+		{
+			Metadata: NewMetadata("eliot", "web"),
+			Spec: PodSpec{Containers: []Container{
+				{Name: "nginx", Ports: []ContainerPort{{ContainerPort: 80, HostPort: 8080}}},
+			}},
+		},
+	}
+
+	assert.NoError(t, ValidatePortConflicts(PodSpec{
+		Containers: []Container{
+			{Name: "foo", Ports: []ContainerPort{{ContainerPort: 80}}},
+			{Name: "bar", Ports: []ContainerPort{{ContainerPort: 8080, Protocol: "udp"}}},
+		},
+	}, existing), "should be valid")
+
+	assert.Error(t, ValidatePortConflicts(PodSpec{
+		Containers: []Container{
+			{Name: "foo", Ports: []ContainerPort{{ContainerPort: 8080}}},
+		},
+	}, existing), "should return error if other pod publishes the host port")
+}
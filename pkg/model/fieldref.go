@@ -0,0 +1,75 @@
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var labelFieldRefPattern = regexp.MustCompile(`^node\.labels\['([^']+)'\]$`)
+
+// FieldRefs are the node and pod fields what can be referenced, in addition to node.labels['<key>']
+var FieldRefs = []string{
+	"metadata.name",
+	"metadata.namespace",
+	"node.hostname",
+	"node.machineID",
+	"node.arch",
+	"node.os",
+	"node.version",
+}
+
+// IsValidFieldRef returns true if value is supported node or pod field path
+func IsValidFieldRef(value string) bool {
+	if labelFieldRefPattern.MatchString(value) {
+		return true
+	}
+	for _, fieldRef := range FieldRefs {
+		if fieldRef == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveFieldRef returns the value of the node or pod field. E.g. node.labels['location'] or metadata.name
+func ResolveFieldRef(fieldRef string, pod Pod, info *NodeInfo) (string, error) {
+	if match := labelFieldRefPattern.FindStringSubmatch(fieldRef); match != nil {
+		value, ok := info.Labels[match[1]]
+		if !ok {
+			return "", fmt.Errorf("Node doesn't have label [%s]", match[1])
+		}
+		return value, nil
+	}
+
+	switch fieldRef {
+	case "metadata.name":
+		return pod.Metadata.Name, nil
+	case "metadata.namespace":
+		return pod.Metadata.Namespace, nil
+	case "node.hostname":
+		return info.Hostname, nil
+	case "node.machineID":
+		return info.MachineID, nil
+	case "node.arch":
+		return info.Arch, nil
+	case "node.os":
+		return info.OS, nil
+	case "node.version":
+		return info.Version, nil
+	default:
+		return "", fmt.Errorf("Unsupported field reference [%s]", fieldRef)
+	}
+}
+
+// FormatLabels formats labels to key="value" lines, sorted by the key
+func FormatLabels(labels map[string]string) string {
+	lines := []string{}
+	for key, value := range labels {
+		lines = append(lines, fmt.Sprintf("%s=%s", key, strconv.Quote(value)))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\n")
+}
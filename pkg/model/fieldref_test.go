@@ -0,0 +1,54 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveFieldRef(t *testing.T) {
+	pod := Pod{Metadata: Metadata{Name: "my-pod", Namespace: "eliot"}}
+	info := &NodeInfo{
+		Hostname:  "my-node",
+		MachineID: "1234",
+		Labels:    map[string]string{"location": "office", "eliot.io/arch": "arm64"},
+	}
+
+	for fieldRef, expected := range map[string]string{
+		"metadata.name":                "my-pod",
+		"metadata.namespace":           "eliot",
+		"node.hostname":                "my-node",
+		"node.machineID":               "1234",
+		"node.labels['location']":      "office",
+		"node.labels['eliot.io/arch']": "arm64",
+	} {
+		value, err := ResolveFieldRef(fieldRef, pod, info)
+		assert.NoError(t, err, "should resolve %s", fieldRef)
+		assert.Equal(t, expected, value)
+	}
+
+	_, err := ResolveFieldRef("node.labels['missing']", pod, info)
+	assert.Error(t, err, "should return error if node doesn't have the label")
+}
+
+func TestValidateEnvVarsFieldRef(t *testing.T) {
+	assert.NoError(t, ValidateEnvVars([]EnvVar{
+		{Name: "LOCATION", ValueFrom: EnvVarSource{FieldRef: "node.labels['location']"}},
+		{Name: "POD_NAME", ValueFrom: EnvVarSource{FieldRef: "metadata.name"}},
+	}), "should be valid")
+
+	assert.Error(t, ValidateEnvVars([]EnvVar{
+		{Name: "FOO", ValueFrom: EnvVarSource{FieldRef: "node.labels.location"}},
+	}), "should return error if field is not supported")
+
+	assert.Error(t, ValidateEnvVars([]EnvVar{
+		{Name: "FOO", ValueFrom: EnvVarSource{
+			FieldRef:     "metadata.name",
+			SecretKeyRef: &KeySelector{Name: "foo", Key: "bar"},
+		}},
+	}), "should return error if multiple sources defined")
+}
+
+func TestFormatLabels(t *testing.T) {
+	assert.Equal(t, "a=\"1\"\nb=\"with \\\"quotes\\\"\"", FormatLabels(map[string]string{"b": "with \"quotes\"", "a": "1"}))
+}
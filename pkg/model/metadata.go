@@ -4,6 +4,10 @@ package model
 type Metadata struct {
 	Name      string `validate:"required,gt=0,alphanumOrDash"`
 	Namespace string `validate:"omitempty,gt=0,alphanumOrDash"`
+	// Labels are key value pairs what can be used to group and select resources
+	Labels map[string]string `validate:"dive,keys,labelKey,endkeys,labelValue"`
+	// Annotations are key value pairs to store arbitrary non-identifying metadata
+	Annotations map[string]string `validate:"dive,keys,labelKey,endkeys"`
 }
 
 // NewMetadata creates new metadata with name and metadata fields
@@ -43,6 +43,12 @@ type NodeInfo struct {
 
 	// Seconds since node boot up
 	Uptime uint64
+
+	// Hardware devices in the node what pods can request, grouped by resource name
+	Devices []DeviceResource
+
+	// True if the node is cordoned and doesn't accept new pods
+	Unschedulable bool
 }
 
 // NodeState describes current state of the node
@@ -70,3 +76,21 @@ type Filesystem struct {
 	// Free blocks available to unprivileged user
 	Available uint64
 }
+
+// DeviceResource is named group of hardware devices in the node. E.g. camera, serial, i2c
+type DeviceResource struct {
+	// Name of the resource what pods use to request the devices
+	Name string
+	// If true, single device can be allocated only to one pod at the time
+	Exclusive bool
+	// Discovered devices
+	Devices []NodeDevice
+}
+
+// NodeDevice represents single hardware device in the node
+type NodeDevice struct {
+	// Path to the device. E.g. /dev/video0
+	Path string
+	// Namespace/name of the pod what have the device allocated, empty if not allocated
+	AllocatedTo string
+}
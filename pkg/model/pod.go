@@ -1,8 +1,16 @@
 package model
 
+import "time"
+
 // DefaultNamespace is namespace what each pod get if there is no metadata.namespace
 var DefaultNamespace = "eliot"
 
+// SystemPodName is the name of the pod where containers, what are not managed by eliot, get grouped
+var SystemPodName = "system"
+
+// DefaultTerminationGracePeriodSeconds is the time containers get to stop after SIGTERM before they get killed
+var DefaultTerminationGracePeriodSeconds int64 = 10
+
 // Pod is set of containers
 type Pod struct {
 	Metadata Metadata `validate:"required"`
@@ -12,15 +20,34 @@ type Pod struct {
 
 // PodSpec defines what containers should be running
 type PodSpec struct {
-	HostNetwork   bool
-	HostPID       bool
+	HostNetwork bool
+	HostPID     bool
+	// Share single process namespace between all containers in the pod
+	ShareProcessNamespace bool
+	// Hostname of the pod, get set to the UTS namespace shared by all containers in the pod
+	Hostname      string      `validate:"omitempty,hostname"`
 	Containers    []Container `validate:"required,gt=0,dive"`
+	Volumes       []PodVolume `validate:"dive"`
 	RestartPolicy string
+	// Node labels what the node must have to run the pod
+	NodeSelector map[string]string `validate:"dive,keys,labelKey,endkeys,labelValue"`
+	// Seconds the containers get to stop after SIGTERM before they get killed, zero means the default
+	TerminationGracePeriodSeconds int64 `validate:"min=0"`
+}
+
+// GetTerminationGracePeriod returns the time containers get to stop gracefully
+func (s PodSpec) GetTerminationGracePeriod() time.Duration {
+	if s.TerminationGracePeriodSeconds > 0 {
+		return time.Duration(s.TerminationGracePeriodSeconds) * time.Second
+	}
+	return time.Duration(DefaultTerminationGracePeriodSeconds) * time.Second
 }
 
 // PodStatus represents latest known state of pod
 type PodStatus struct {
-	Hostname          string
+	Hostname string
+	// IP address of the pod in the bridge network, empty if the pod uses host network
+	PodIP             string
 	ContainerStatuses []ContainerStatus `validate:"dive"`
 }
 
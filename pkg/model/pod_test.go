@@ -101,3 +101,37 @@ func TestValidationNamespaceMetadata(t *testing.T) {
 		},
 	}), "should return error if not alphanumeric namespace")
 }
+
+func TestValidateNamespaces(t *testing.T) {
+	assert.NoError(t, ValidateNamespaces(PodSpec{
+		Hostname:              "my-pod",
+		ShareProcessNamespace: true,
+	}), "should be valid")
+
+	assert.Error(t, ValidateNamespaces(PodSpec{
+		HostPID:               true,
+		ShareProcessNamespace: true,
+	}), "should return error if sharing process namespace with host PID")
+
+	assert.Error(t, ValidateNamespaces(PodSpec{
+		Hostname: "not valid_hostname",
+	}), "should return error if hostname is invalid")
+}
+
+func TestValidateVolumes(t *testing.T) {
+	assert.NoError(t, ValidateVolumes(PodSpec{
+		Volumes: []PodVolume{{Name: "data", Size: "100MB"}, {Name: "cache"}},
+	}), "should be valid")
+
+	assert.Error(t, ValidateVolumes(PodSpec{
+		Volumes: []PodVolume{{Name: "data"}, {Name: "data"}},
+	}), "should return error if volume is defined more than once")
+
+	assert.Error(t, ValidateVolumes(PodSpec{
+		Volumes: []PodVolume{{Name: "not valid"}},
+	}), "should return error if volume name is invalid")
+
+	assert.Error(t, ValidateVolumes(PodSpec{
+		Volumes: []PodVolume{{Name: "data", Size: "lots"}},
+	}), "should return error if size is invalid")
+}
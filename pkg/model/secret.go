@@ -0,0 +1,36 @@
+package model
+
+// Secret is sensitive data stored in the node, e.g. API keys or passwords.
+// The data is encrypted at rest and never returned back to the clients.
+type Secret struct {
+	Metadata Metadata          `validate:"required,hasName"`
+	Data     map[string][]byte `validate:"dive,keys,configKey,endkeys"`
+}
+
+// ConfigMap is non-sensitive configuration data stored in the node
+type ConfigMap struct {
+	Metadata Metadata          `validate:"required,hasName"`
+	Data     map[string]string `validate:"dive,keys,configKey,endkeys"`
+}
+
+// EnvVar defines container environment variable what gets the value from some source
+type EnvVar struct {
+	Name      string       `validate:"required,envName"`
+	ValueFrom EnvVarSource `validate:"required"`
+}
+
+// EnvVarSource defines the source of the environment variable value, only one can be defined
+type EnvVarSource struct {
+	// Value from the key in the Secret
+	SecretKeyRef *KeySelector
+	// Value from the key in the ConfigMap
+	ConfigMapKeyRef *KeySelector
+	// Value from the node or pod field, e.g. node.labels['location'] or metadata.name
+	FieldRef string `validate:"omitempty,fieldRef"`
+}
+
+// KeySelector selects the key from Secret or ConfigMap
+type KeySelector struct {
+	Name string `validate:"required,alphanumOrDash"`
+	Key  string `validate:"required,configKey"`
+}
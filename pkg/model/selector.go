@@ -0,0 +1,104 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Selector selects resources by labels
+type Selector []Requirement
+
+// Requirement is single label requirement in the selector
+type Requirement struct {
+	Key      string
+	Operator string
+	Value    string
+}
+
+const (
+	// OperatorEquals requires the label to have the value
+	OperatorEquals = "="
+	// OperatorNotEquals requires the label not to have the value
+	OperatorNotEquals = "!="
+	// OperatorExists requires the label to exist with any value
+	OperatorExists = "exists"
+	// OperatorNotExists requires the label not to exist
+	OperatorNotExists = "!exists"
+)
+
+// ParseSelector parses label selector in format "key=value,key!=value,key,!key".
+// Empty selector matches everything.
+func ParseSelector(selector string) (Selector, error) {
+	result := Selector{}
+	for _, part := range strings.Split(selector, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		var requirement Requirement
+		switch {
+		case strings.Contains(part, "!="):
+			kv := strings.SplitN(part, "!=", 2)
+			requirement = Requirement{Key: kv[0], Operator: OperatorNotEquals, Value: kv[1]}
+		case strings.Contains(part, "=="):
+			kv := strings.SplitN(part, "==", 2)
+			requirement = Requirement{Key: kv[0], Operator: OperatorEquals, Value: kv[1]}
+		case strings.Contains(part, "="):
+			kv := strings.SplitN(part, "=", 2)
+			requirement = Requirement{Key: kv[0], Operator: OperatorEquals, Value: kv[1]}
+		case strings.HasPrefix(part, "!"):
+			requirement = Requirement{Key: strings.TrimPrefix(part, "!"), Operator: OperatorNotExists}
+		default:
+			requirement = Requirement{Key: part, Operator: OperatorExists}
+		}
+
+		requirement.Key = strings.TrimSpace(requirement.Key)
+		requirement.Value = strings.TrimSpace(requirement.Value)
+		if !isValidLabelKey(requirement.Key) {
+			return nil, fmt.Errorf("Invalid label key [%s] in selector [%s]", requirement.Key, selector)
+		}
+		if !isValidLabelValue(requirement.Value) {
+			return nil, fmt.Errorf("Invalid label value [%s] in selector [%s]", requirement.Value, selector)
+		}
+		result = append(result, requirement)
+	}
+	return result, nil
+}
+
+// Matches returns true if labels match to all requirements in the selector
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches returns true if labels match to the requirement
+func (r Requirement) Matches(labels map[string]string) bool {
+	value, exists := labels[r.Key]
+	switch r.Operator {
+	case OperatorEquals:
+		return exists && value == r.Value
+	case OperatorNotEquals:
+		return !exists || value != r.Value
+	case OperatorExists:
+		return exists
+	case OperatorNotExists:
+		return !exists
+	default:
+		return false
+	}
+}
+
+// MatchesNodeSelector returns true if the labels have all the node selector key value pairs
+func MatchesNodeSelector(nodeSelector, labels map[string]string) bool {
+	for key, expected := range nodeSelector {
+		if value, ok := labels[key]; !ok || value != expected {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectorMatches(t *testing.T) {
+	labels := map[string]string{"app": "sensor", "env": "prod"}
+
+	for selector, expected := range map[string]bool{
+		"":                  true,
+		"app=sensor":        true,
+		"app==sensor":       true,
+		"app=sensor,env":    true,
+		"app=sensor,!debug": true,
+		"env!=dev":          true,
+		"app=camera":        false,
+		"app=sensor,debug":  false,
+		"!env":              false,
+		"env!=prod":         false,
+	} {
+		parsed, err := ParseSelector(selector)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, parsed.Matches(labels), "selector %s should match: %t", selector, expected)
+	}
+}
+
+func TestParseSelectorInvalid(t *testing.T) {
+	_, err := ParseSelector("app=foo bar")
+	assert.Error(t, err, "should return error if value contains space")
+
+	_, err = ParseSelector("=foo")
+	assert.Error(t, err, "should return error if key is empty")
+}
+
+func TestValidateMetadataLabels(t *testing.T) {
+	assert.NoError(t, ValidateMetadata(Metadata{
+		Name:        "foo",
+		Labels:      map[string]string{"app": "sensor", "eliot.io/location": "office", "empty": ""},
+		Annotations: map[string]string{"description": "Anything goes here!"},
+	}))
+
+	assert.Error(t, ValidateMetadata(Metadata{
+		Name:   "foo",
+		Labels: map[string]string{"app": "with space"},
+	}), "should return error if label value is invalid")
+
+	assert.Error(t, ValidateMetadata(Metadata{
+		Name:        "foo",
+		Annotations: map[string]string{"in valid": "foo"},
+	}), "should return error if annotation key is invalid")
+}
+
+func TestMatchesNodeSelector(t *testing.T) {
+	labels := map[string]string{"camera": "true", "location": "office"}
+
+	assert.True(t, MatchesNodeSelector(nil, labels), "empty selector should match any node")
+	assert.True(t, MatchesNodeSelector(map[string]string{"camera": "true"}, labels))
+	assert.False(t, MatchesNodeSelector(map[string]string{"camera": "false"}, labels))
+	assert.False(t, MatchesNodeSelector(map[string]string{"gpu": "true"}, labels))
+}
@@ -1,12 +1,15 @@
 package model
 
 import (
+	"fmt"
 	"log"
 	"regexp"
 	"strings"
 	"sync"
 
+	"github.com/c2h5oh/datasize"
 	imageref "github.com/containerd/containerd/reference"
+	"github.com/syndtr/gocapability/capability"
 	validator "gopkg.in/go-playground/validator.v9"
 )
 
@@ -37,6 +40,40 @@ func getValidator() *validator.Validate {
 		validate.RegisterValidation("envKeyValuePair", func(fl validator.FieldLevel) bool {
 			return IsValidEnvKeyValuePair(fl.Field().Interface().(string))
 		})
+		validate.RegisterValidation("devicePermissions", func(fl validator.FieldLevel) bool {
+			return isValidDevicePermissions(fl.Field().Interface().(string))
+		})
+		validate.RegisterValidation("capability", func(fl validator.FieldLevel) bool {
+			return IsValidCapability(fl.Field().Interface().(string))
+		})
+		validate.RegisterValidation("seccompProfile", func(fl validator.FieldLevel) bool {
+			return isValidSeccompProfile(fl.Field().Interface().(string))
+		})
+		validate.RegisterValidation("protocol", func(fl validator.FieldLevel) bool {
+			return IsValidProtocol(fl.Field().Interface().(string))
+		})
+		validate.RegisterValidation("byteSize", func(fl validator.FieldLevel) bool {
+			_, err := ParseByteSize(fl.Field().Interface().(string))
+			return err == nil
+		})
+		validate.RegisterValidation("envName", func(fl validator.FieldLevel) bool {
+			return isValidEnvName(fl.Field().Interface().(string))
+		})
+		validate.RegisterValidation("configKey", func(fl validator.FieldLevel) bool {
+			return isValidConfigKey(fl.Field().Interface().(string))
+		})
+		validate.RegisterValidation("fieldRef", func(fl validator.FieldLevel) bool {
+			return IsValidFieldRef(fl.Field().Interface().(string))
+		})
+		validate.RegisterValidation("labelKey", func(fl validator.FieldLevel) bool {
+			return isValidLabelKey(fl.Field().Interface().(string))
+		})
+		validate.RegisterValidation("labelValue", func(fl validator.FieldLevel) bool {
+			return isValidLabelValue(fl.Field().Interface().(string))
+		})
+		validate.RegisterStructValidation(deviceStructLevelValidation, Device{})
+		validate.RegisterStructValidation(securityContextStructLevelValidation, SecurityContext{})
+		validate.RegisterStructValidation(envVarSourceStructLevelValidation, EnvVarSource{})
 	})
 	return validate
 }
@@ -61,6 +98,25 @@ func isAlphanumericOrDash(value string) bool {
 	return match
 }
 
+// isValidLabelKey returns true if value is valid label or annotation key,
+// optionally prefixed with domain name. E.g. app or eliot.io/arch
+func isValidLabelKey(value string) bool {
+	match, err := regexp.MatchString("^([a-z0-9]([a-z0-9.-]*[a-z0-9])?/)?[A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?$", value)
+	if err != nil {
+		log.Fatalf("Invalid regexp definition in isValidLabelKey check: %s", err)
+	}
+	return match
+}
+
+// isValidLabelValue returns true if value is empty or valid label value
+func isValidLabelValue(value string) bool {
+	match, err := regexp.MatchString("^([A-Za-z0-9]([A-Za-z0-9._-]*[A-Za-z0-9])?)?$", value)
+	if err != nil {
+		log.Fatalf("Invalid regexp definition in isValidLabelValue check: %s", err)
+	}
+	return match
+}
+
 func containsSpaces(value string) bool {
 	return strings.Contains(value, " ")
 }
@@ -88,6 +144,247 @@ func IsValidEnvKeyValuePair(value string) bool {
 	return true
 }
 
+func isValidEnvName(value string) bool {
+	match, err := regexp.MatchString("^[A-Za-z_][A-Za-z0-9_]*$", value)
+	if err != nil {
+		log.Fatalf("Invalid regexp definition in isValidEnvName check: %s", err)
+	}
+	return match
+}
+
+// isValidConfigKey returns true if value can be used as Secret or ConfigMap key,
+// the key is also used as file name when the data get mounted to the container
+func isValidConfigKey(value string) bool {
+	match, err := regexp.MatchString("^[A-Za-z0-9._-]+$", value)
+	if err != nil {
+		log.Fatalf("Invalid regexp definition in isValidConfigKey check: %s", err)
+	}
+	return match && value != "." && value != ".."
+}
+
+func isValidDevicePermissions(value string) bool {
+	match, err := regexp.MatchString("^[rwm]{1,3}$", value)
+	if err != nil {
+		log.Fatalf("Invalid regexp definition in isValidDevicePermissions check: %s", err)
+	}
+	return match
+}
+
+// IsGlobPattern return true if value contains any glob pattern special characters
+func IsGlobPattern(value string) bool {
+	return strings.ContainsAny(value, "*?[")
+}
+
+func deviceStructLevelValidation(sl validator.StructLevel) {
+	device := sl.Current().Interface().(Device)
+	if IsGlobPattern(device.HostPath) && device.ContainerPath != "" {
+		sl.ReportError(device.ContainerPath, "ContainerPath", "containerPath", "noGlobContainerPath", "")
+	}
+}
+
+// NormalizeCapability returns capability name in the OCI format. E.g. net_admin -> CAP_NET_ADMIN
+func NormalizeCapability(value string) string {
+	value = strings.ToUpper(value)
+	if value == "ALL" || strings.HasPrefix(value, "CAP_") {
+		return value
+	}
+	return "CAP_" + value
+}
+
+// IsValidCapability return true if value is known Linux capability name or 'ALL'
+func IsValidCapability(value string) bool {
+	normalized := NormalizeCapability(value)
+	if normalized == "ALL" {
+		return true
+	}
+	for _, c := range capability.List() {
+		if normalized == "CAP_"+strings.ToUpper(c.String()) {
+			return true
+		}
+	}
+	return false
+}
+
+func isValidSeccompProfile(value string) bool {
+	switch {
+	case value == "unconfined", value == "runtime/default":
+		return true
+	case strings.HasPrefix(value, "localhost/"):
+		return len(strings.TrimPrefix(value, "localhost/")) > 0
+	default:
+		return false
+	}
+}
+
+func securityContextStructLevelValidation(sl validator.StructLevel) {
+	securityContext := sl.Current().Interface().(SecurityContext)
+	if securityContext.Group != "" && securityContext.User == "" {
+		sl.ReportError(securityContext.Group, "Group", "group", "requiresUser", "")
+	}
+}
+
+func envVarSourceStructLevelValidation(sl validator.StructLevel) {
+	source := sl.Current().Interface().(EnvVarSource)
+	sources := 0
+	if source.SecretKeyRef != nil {
+		sources++
+	}
+	if source.ConfigMapKeyRef != nil {
+		sources++
+	}
+	if source.FieldRef != "" {
+		sources++
+	}
+	if sources != 1 {
+		sl.ReportError(source, "ValueFrom", "valueFrom", "exactlyOneSource", "")
+	}
+}
+
+// ValidateEnvVars validates given container environment variable sources
+func ValidateEnvVars(vars []EnvVar) error {
+	validate := getValidator()
+	for _, envVar := range vars {
+		if err := validate.Struct(envVar); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateMetadata validates given resource metadata, including the labels and annotations
+func ValidateMetadata(metadata Metadata) error {
+	return getValidator().Struct(metadata)
+}
+
+// ValidateNodeSelector validates given pod node selector labels
+func ValidateNodeSelector(nodeSelector map[string]string) error {
+	return ValidateLabels(nodeSelector)
+}
+
+// ValidateLabels validates given label keys and values
+func ValidateLabels(labels map[string]string) error {
+	return getValidator().Var(labels, "dive,keys,labelKey,endkeys,labelValue")
+}
+
+// ValidateSecret validates given Secret
+func ValidateSecret(secret Secret) error {
+	return getValidator().Struct(secret)
+}
+
+// ValidateConfigMap validates given ConfigMap
+func ValidateConfigMap(configMap ConfigMap) error {
+	return getValidator().Struct(configMap)
+}
+
+// ValidateSecurityContext validates given container security context
+func ValidateSecurityContext(securityContext SecurityContext) error {
+	return getValidator().Struct(securityContext)
+}
+
+// IsValidProtocol return true if value is supported port protocol
+func IsValidProtocol(value string) bool {
+	switch strings.ToLower(value) {
+	case "tcp", "udp":
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidatePorts validates the pod containers port mappings
+func ValidatePorts(spec PodSpec) error {
+	validate := getValidator()
+	published := map[string]string{}
+	for _, container := range spec.Containers {
+		for _, port := range container.Ports {
+			if spec.HostNetwork {
+				return fmt.Errorf("Container [%s] cannot publish ports when pod uses host network", container.Name)
+			}
+			if err := validate.Struct(port); err != nil {
+				return err
+			}
+
+			key := fmt.Sprintf("%d/%s", port.GetHostPort(), port.GetProtocol())
+			if other, ok := published[key]; ok {
+				return fmt.Errorf("Host port %s is published by both [%s] and [%s] containers", key, other, container.Name)
+			}
+			published[key] = container.Name
+		}
+	}
+	return nil
+}
+
+// ValidatePortConflicts validates that the pod doesn't publish host ports what the existing pods already publish
+func ValidatePortConflicts(spec PodSpec, existing []Pod) error {
+	published := map[string]string{}
+	for _, pod := range existing {
+		for _, container := range pod.Spec.Containers {
+			for _, port := range container.Ports {
+				key := fmt.Sprintf("%d/%s", port.GetHostPort(), port.GetProtocol())
+				published[key] = fmt.Sprintf("%s/%s", pod.Metadata.Namespace, pod.Metadata.Name)
+			}
+		}
+	}
+
+	for _, container := range spec.Containers {
+		for _, port := range container.Ports {
+			key := fmt.Sprintf("%d/%s", port.GetHostPort(), port.GetProtocol())
+			if other, ok := published[key]; ok {
+				return fmt.Errorf("Host port %s of container [%s] is already published by pod [%s]", key, container.Name, other)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateNamespaces validates the pod namespace sharing options
+func ValidateNamespaces(spec PodSpec) error {
+	if spec.HostPID && spec.ShareProcessNamespace {
+		return fmt.Errorf("Pod cannot use host PID namespace and share process namespace at the same time")
+	}
+	return getValidator().Var(spec.Hostname, "omitempty,hostname")
+}
+
+// ParseByteSize parses human readable size, e.g. 100MB or 1GB, to number of bytes
+func ParseByteSize(value string) (uint64, error) {
+	var size datasize.ByteSize
+	if err := size.UnmarshalText([]byte(value)); err != nil {
+		return 0, err
+	}
+	return size.Bytes(), nil
+}
+
+// ValidateNamespace validates the namespace name
+func ValidateNamespace(namespace string) error {
+	return getValidator().Var(namespace, "required,alphanumOrDash")
+}
+
+// ValidateVolumeName validates the volume name
+func ValidateVolumeName(name string) error {
+	return getValidator().Var(name, "required,alphanumOrDash")
+}
+
+// ValidateConfigName validates the Secret or ConfigMap name
+func ValidateConfigName(name string) error {
+	return getValidator().Var(name, "required,alphanumOrDash")
+}
+
+// ValidateVolumes validates the pod volume definitions
+func ValidateVolumes(spec PodSpec) error {
+	validate := getValidator()
+	names := map[string]bool{}
+	for _, volume := range spec.Volumes {
+		if err := validate.Struct(volume); err != nil {
+			return err
+		}
+		if names[volume.Name] {
+			return fmt.Errorf("Volume [%s] is defined more than once", volume.Name)
+		}
+		names[volume.Name] = true
+	}
+	return nil
+}
+
 // Validate validates given pod definitions
 func Validate(pods []Pod) error {
 	validate := getValidator()
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"time"
+)
+
+// Volume is named storage in the node what can be mounted to the pod containers
+type Volume struct {
+	Metadata Metadata `validate:"required"`
+	Spec     VolumeSpec
+	Status   VolumeStatus
+}
+
+// VolumeSpec defines the volume properties
+type VolumeSpec struct {
+	// Maximum size of the volume in bytes, zero means no limit
+	Size uint64
+}
+
+// VolumeStatus represents latest known state of the volume
+type VolumeStatus struct {
+	CreatedAt time.Time
+	// Path to the volume data directory in the node
+	Path string
+	// Number of bytes used by the volume data
+	Usage uint64
+	// Names of the pods what mount the volume
+	UsedBy []string
+}
+
+// PodVolume defines volume what the pod containers can mount by name.
+// If the volume doesn't exist, it get created when the pod is created.
+type PodVolume struct {
+	Name string `validate:"required,gt=0,alphanumOrDash"`
+	// Maximum size of the volume if it get created. E.g. 100MB, 1GB
+	Size string `validate:"omitempty,byteSize"`
+}
@@ -0,0 +1,292 @@
+package network
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultBridgeName is the name of the bridge interface in the node
+	DefaultBridgeName = "eli0"
+	// DefaultSubnet is the subnet where pod IP addresses get allocated from
+	DefaultSubnet = "10.88.0.0/16"
+	// DefaultDataDir is directory where the IP allocations get stored
+	DefaultDataDir = "/var/lib/eliot/network"
+
+	netnsDir        = "/var/run/netns"
+	portMapChain    = "ELIOT-PORTMAP"
+	podInterface    = "eth0"
+	netnsNamePrefix = "eliot"
+)
+
+// Runner executes command and returns the combined output
+type Runner func(name string, args ...string) ([]byte, error)
+
+func execRunner(name string, args ...string) ([]byte, error) {
+	return exec.Command(name, args...).CombinedOutput()
+}
+
+// Bridge connects the pods to the node network through Linux bridge.
+// Each pod get own network namespace with veth pair to the bridge and
+// the ports get published with iptables DNAT rules
+type Bridge struct {
+	name     string
+	subnet   *net.IPNet
+	ipam     *IPAM
+	netnsDir string
+	run      Runner
+	mu       sync.Mutex
+}
+
+// NewBridge creates new Bridge network what allocates pod addresses from the subnet
+func NewBridge(name, subnet, dataDir string) (*Bridge, error) {
+	_, ipnet, err := net.ParseCIDR(subnet)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid pod subnet [%s]", subnet)
+	}
+	if ipnet.IP.To4() == nil {
+		return nil, errors.Errorf("Invalid pod subnet [%s], only IPv4 subnets are supported", subnet)
+	}
+
+	return &Bridge{
+		name:     name,
+		subnet:   ipnet,
+		ipam:     NewIPAM(ipnet, dataDir),
+		netnsDir: netnsDir,
+		run:      execRunner,
+	}, nil
+}
+
+// GetNetnsPath returns path to the pod network namespace
+func (b *Bridge) GetNetnsPath(namespace, podName string) string {
+	return filepath.Join(b.netnsDir, getNetnsName(getPodID(namespace, podName)))
+}
+
+// EnsurePodNetwork creates network namespace for the pod and connects it to the bridge.
+// If the pod network already exist, just returns the pod IP address
+func (b *Bridge) EnsurePodNetwork(namespace, podName string) (ip net.IP, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if err := b.ensureBridge(); err != nil {
+		return nil, errors.Wrapf(err, "Failed to setup bridge [%s]", b.name)
+	}
+
+	id := getPodID(namespace, podName)
+	existing, err := b.ipam.Get(id)
+	if err != nil {
+		return nil, err
+	}
+	ip, err = b.ipam.Allocate(id)
+	if err != nil {
+		return nil, err
+	}
+
+	netns := getNetnsName(id)
+	if _, err := os.Stat(filepath.Join(b.netnsDir, netns)); err == nil {
+		return ip, nil
+	}
+
+	log.Debugf("Create network namespace [%s] for pod [%s] with IP [%s]", netns, id, ip)
+	defer func() {
+		if err != nil {
+			if cleanupErr := b.removeNetns(netns); cleanupErr != nil {
+				log.Warnf("Failed to cleanup pod [%s] network namespace: %s", id, cleanupErr)
+			}
+			// Keep the address if the pod already had it, e.g. the pod containers still refer to it after node restart
+			if existing == nil {
+				if cleanupErr := b.ipam.Release(id); cleanupErr != nil {
+					log.Warnf("Failed to release pod [%s] IP address: %s", id, cleanupErr)
+				}
+			}
+		}
+	}()
+
+	var (
+		hostVeth  = getVethName(id)
+		prefix, _ = b.subnet.Mask.Size()
+		address   = fmt.Sprintf("%s/%d", ip, prefix)
+	)
+	err = b.runAll([][]string{
+		{"ip", "netns", "add", netns},
+		{"ip", "link", "add", hostVeth, "type", "veth", "peer", "name", podInterface, "netns", netns},
+		{"ip", "link", "set", hostVeth, "master", b.name},
+		{"ip", "link", "set", hostVeth, "up"},
+		{"ip", "-n", netns, "addr", "add", address, "dev", podInterface},
+		{"ip", "-n", netns, "link", "set", podInterface, "up"},
+		{"ip", "-n", netns, "link", "set", "lo", "up"},
+		{"ip", "-n", netns, "route", "add", "default", "via", b.ipam.Gateway().String()},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to setup pod [%s] network", id)
+	}
+	return ip, nil
+}
+
+// EnsurePortMappings publishes the ports from the node to the pod IP address
+func (b *Bridge) EnsurePortMappings(namespace, podName string, ip net.IP, ports []model.ContainerPort) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := getPodID(namespace, podName)
+	for _, port := range ports {
+		err := b.ensureRule("nat", portMapChain,
+			"-p", port.GetProtocol(),
+			"--dport", fmt.Sprintf("%d", port.GetHostPort()),
+			"-m", "comment", "--comment", getPortMapComment(id),
+			"-j", "DNAT",
+			"--to-destination", fmt.Sprintf("%s:%d", ip, port.ContainerPort),
+		)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to publish port %d/%s for pod [%s]", port.GetHostPort(), port.GetProtocol(), id)
+		}
+	}
+	return nil
+}
+
+// RemovePodNetwork removes the pod port mappings, network namespace and releases the IP address
+func (b *Bridge) RemovePodNetwork(namespace, podName string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	id := getPodID(namespace, podName)
+	log.Debugf("Remove pod [%s] network", id)
+
+	if err := b.removePortMappings(id); err != nil {
+		return err
+	}
+
+	if err := b.removeNetns(getNetnsName(id)); err != nil {
+		return err
+	}
+
+	return b.ipam.Release(id)
+}
+
+func (b *Bridge) ensureBridge() error {
+	if _, err := b.run("ip", "link", "show", b.name); err != nil {
+		prefix, _ := b.subnet.Mask.Size()
+		err := b.runAll([][]string{
+			{"ip", "link", "add", "name", b.name, "type", "bridge"},
+			{"ip", "addr", "add", fmt.Sprintf("%s/%d", b.ipam.Gateway(), prefix), "dev", b.name},
+			{"ip", "link", "set", b.name, "up"},
+			{"sysctl", "-w", "net.ipv4.ip_forward=1"},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := b.run("iptables", "-t", "nat", "-L", portMapChain, "-n"); err != nil {
+		if err := b.runAll([][]string{{"iptables", "-t", "nat", "-N", portMapChain}}); err != nil {
+			return err
+		}
+	}
+
+	rules := [][]string{
+		{"nat", "POSTROUTING", "-s", b.subnet.String(), "!", "-o", b.name, "-j", "MASQUERADE"},
+		{"nat", "PREROUTING", "-m", "addrtype", "--dst-type", "LOCAL", "-j", portMapChain},
+		{"nat", "OUTPUT", "-m", "addrtype", "--dst-type", "LOCAL", "-j", portMapChain},
+		{"filter", "FORWARD", "-i", b.name, "-j", "ACCEPT"},
+		{"filter", "FORWARD", "-o", b.name, "-j", "ACCEPT"},
+	}
+	for _, rule := range rules {
+		if err := b.ensureRule(rule[0], rule[1], rule[2:]...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ensureRule appends the iptables rule to the chain if it doesn't exist yet
+func (b *Bridge) ensureRule(table, chain string, rule ...string) error {
+	check := append([]string{"-t", table, "-C", chain}, rule...)
+	if _, err := b.run("iptables", check...); err == nil {
+		return nil
+	}
+	return b.runAll([][]string{append([]string{"iptables", "-t", table, "-A", chain}, rule...)})
+}
+
+func (b *Bridge) removePortMappings(id string) error {
+	out, err := b.run("iptables", "-t", "nat", "-S", portMapChain)
+	if err != nil {
+		// The chain doesn't exist so there's no mappings either
+		return nil
+	}
+
+	comment := getPortMapComment(id)
+	for _, line := range strings.Split(string(out), "\n") {
+		args := parseRule(line)
+		if len(args) < 2 || args[0] != "-A" || !hasComment(args, comment) {
+			continue
+		}
+		args[0] = "-D"
+		if err := b.runAll([][]string{append([]string{"iptables", "-t", "nat"}, args...)}); err != nil {
+			return errors.Wrapf(err, "Failed to remove pod [%s] port mapping", id)
+		}
+	}
+	return nil
+}
+
+func (b *Bridge) removeNetns(netns string) error {
+	if _, err := os.Stat(filepath.Join(b.netnsDir, netns)); os.IsNotExist(err) {
+		return nil
+	}
+	// Deleting the namespace deletes also the veth pair
+	return b.runAll([][]string{{"ip", "netns", "delete", netns}})
+}
+
+func (b *Bridge) runAll(commands [][]string) error {
+	for _, command := range commands {
+		if out, err := b.run(command[0], command[1:]...); err != nil {
+			return errors.Wrapf(err, "Command [%s] failed: %s", strings.Join(command, " "), strings.TrimSpace(string(out)))
+		}
+	}
+	return nil
+}
+
+func parseRule(line string) (result []string) {
+	for _, field := range strings.Fields(line) {
+		result = append(result, strings.Trim(field, `"`))
+	}
+	return result
+}
+
+func hasComment(args []string, comment string) bool {
+	for i := 0; i < len(args)-1; i++ {
+		if args[i] == "--comment" && args[i+1] == comment {
+			return true
+		}
+	}
+	return false
+}
+
+func getPodID(namespace, podName string) string {
+	return fmt.Sprintf("%s/%s", namespace, podName)
+}
+
+// getNetnsName returns unique network namespace name for the pod.
+// Use hash of the pod id because both the namespace and the pod name can contain dashes.
+func getNetnsName(id string) string {
+	return fmt.Sprintf("%s-%x", netnsNamePrefix, sha1.Sum([]byte(id)))
+}
+
+func getPortMapComment(id string) string {
+	return fmt.Sprintf("eliot:%s", id)
+}
+
+// getVethName returns unique host side interface name for the pod.
+// Linux interface names can be max 15 characters so use hash of the pod id.
+func getVethName(id string) string {
+	return fmt.Sprintf("veli%x", sha1.Sum([]byte(id)))[:12]
+}
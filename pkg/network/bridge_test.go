@@ -0,0 +1,160 @@
+package network
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeRunner struct {
+	commands []string
+	outputs  map[string]string
+	failing  map[string]bool
+}
+
+func (r *fakeRunner) run(name string, args ...string) ([]byte, error) {
+	command := strings.Join(append([]string{name}, args...), " ")
+	r.commands = append(r.commands, command)
+	if r.failing[command] {
+		return nil, errors.New("exit status 1")
+	}
+	return []byte(r.outputs[command]), nil
+}
+
+func newTestBridge(t *testing.T, runner *fakeRunner) (*Bridge, func()) {
+	dir, err := ioutil.TempDir("", "bridge-test")
+	assert.NoError(t, err)
+
+	bridge, err := NewBridge("eli0", "10.88.0.0/16", filepath.Join(dir, "ipam"))
+	assert.NoError(t, err)
+	bridge.netnsDir = filepath.Join(dir, "netns")
+	bridge.run = runner.run
+
+	return bridge, func() { os.RemoveAll(dir) }
+}
+
+func TestNewBridgeReturnErrorIfInvalidSubnet(t *testing.T) {
+	_, err := NewBridge("eli0", "foobar", "/tmp")
+	assert.Error(t, err)
+
+	_, err = NewBridge("eli0", "fd00::/64", "/tmp")
+	assert.Error(t, err)
+}
+
+func TestEnsurePodNetwork(t *testing.T) {
+	runner := &fakeRunner{
+		failing: map[string]bool{
+			"ip link show eli0": true,
+			"iptables -t nat -C POSTROUTING -s 10.88.0.0/16 ! -o eli0 -j MASQUERADE": true,
+		},
+	}
+	bridge, cleanup := newTestBridge(t, runner)
+	defer cleanup()
+
+	ip, err := bridge.EnsurePodNetwork("eliot", "my-pod")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.88.0.2", ip.String())
+
+	assert.Contains(t, runner.commands, "ip link add name eli0 type bridge")
+	assert.Contains(t, runner.commands, "ip addr add 10.88.0.1/16 dev eli0")
+	assert.Contains(t, runner.commands, "iptables -t nat -A POSTROUTING -s 10.88.0.0/16 ! -o eli0 -j MASQUERADE")
+	assert.NotContains(t, runner.commands, "iptables -t nat -A PREROUTING -m addrtype --dst-type LOCAL -j ELIOT-PORTMAP", "should not add existing rule")
+	netns := getNetnsName("eliot/my-pod")
+	assert.Contains(t, runner.commands, "ip netns add "+netns)
+	assert.Contains(t, runner.commands, "ip -n "+netns+" addr add 10.88.0.2/16 dev eth0")
+	assert.Contains(t, runner.commands, "ip -n "+netns+" route add default via 10.88.0.1")
+}
+
+func TestEnsurePodNetworkReleaseIPOnFailure(t *testing.T) {
+	runner := &fakeRunner{
+		failing: map[string]bool{
+			"ip netns add " + getNetnsName("eliot/my-pod"): true,
+		},
+	}
+	bridge, cleanup := newTestBridge(t, runner)
+	defer cleanup()
+
+	_, err := bridge.EnsurePodNetwork("eliot", "my-pod")
+	assert.Error(t, err)
+
+	ip, err := bridge.ipam.Get("eliot/my-pod")
+	assert.NoError(t, err)
+	assert.Nil(t, ip, "should release the IP address")
+}
+
+func TestEnsurePortMappings(t *testing.T) {
+	runner := &fakeRunner{
+		failing: map[string]bool{
+			"iptables -t nat -C ELIOT-PORTMAP -p tcp --dport 8080 -m comment --comment eliot:eliot/my-pod -j DNAT --to-destination 10.88.0.2:80": true,
+		},
+	}
+	bridge, cleanup := newTestBridge(t, runner)
+	defer cleanup()
+
+	ip, _ := bridge.ipam.Allocate("eliot/my-pod")
+	err := bridge.EnsurePortMappings("eliot", "my-pod", ip, []model.ContainerPort{
+		{ContainerPort: 80, HostPort: 8080},
+	})
+	assert.NoError(t, err)
+	assert.Contains(t, runner.commands, "iptables -t nat -A ELIOT-PORTMAP -p tcp --dport 8080 -m comment --comment eliot:eliot/my-pod -j DNAT --to-destination 10.88.0.2:80")
+}
+
+func TestRemovePodNetwork(t *testing.T) {
+	runner := &fakeRunner{
+		outputs: map[string]string{
+			"iptables -t nat -S ELIOT-PORTMAP": strings.Join([]string{
+				"-N ELIOT-PORTMAP",
+				`-A ELIOT-PORTMAP -p tcp -m tcp --dport 8080 -m comment --comment "eliot:eliot/my-pod" -j DNAT --to-destination 10.88.0.2:80`,
+				`-A ELIOT-PORTMAP -p udp -m udp --dport 53 -m comment --comment "eliot:eliot/other" -j DNAT --to-destination 10.88.0.3:53`,
+			}, "\n"),
+		},
+	}
+	bridge, cleanup := newTestBridge(t, runner)
+	defer cleanup()
+
+	bridge.ipam.Allocate("eliot/my-pod")
+	assert.NoError(t, os.MkdirAll(bridge.netnsDir, 0755))
+	assert.NoError(t, ioutil.WriteFile(bridge.GetNetnsPath("eliot", "my-pod"), []byte{}, 0644))
+
+	assert.NoError(t, bridge.RemovePodNetwork("eliot", "my-pod"))
+	assert.Contains(t, runner.commands, "iptables -t nat -D ELIOT-PORTMAP -p tcp -m tcp --dport 8080 -m comment --comment eliot:eliot/my-pod -j DNAT --to-destination 10.88.0.2:80")
+	assert.NotContains(t, runner.commands, "iptables -t nat -D ELIOT-PORTMAP -p udp -m udp --dport 53 -m comment --comment eliot:eliot/other -j DNAT --to-destination 10.88.0.3:53")
+	assert.Contains(t, runner.commands, "ip netns delete "+getNetnsName("eliot/my-pod"))
+
+	ip, err := bridge.ipam.Get("eliot/my-pod")
+	assert.NoError(t, err)
+	assert.Nil(t, ip)
+}
+
+func TestGetNetnsName(t *testing.T) {
+	assert.NotEqual(t, getNetnsName(getPodID("a-b", "c")), getNetnsName(getPodID("a", "b-c")), "should not collide when the names contain dashes")
+}
+
+func TestEnsurePodNetworkDashedNames(t *testing.T) {
+	runner := &fakeRunner{}
+	bridge, cleanup := newTestBridge(t, runner)
+	defer cleanup()
+	assert.NoError(t, os.MkdirAll(bridge.netnsDir, 0755))
+
+	first, err := bridge.EnsurePodNetwork("a-b", "c")
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(bridge.GetNetnsPath("a-b", "c"), []byte{}, 0644))
+
+	second, err := bridge.EnsurePodNetwork("a", "b-c")
+	assert.NoError(t, err)
+	assert.NotEqual(t, first.String(), second.String())
+	assert.NotEqual(t, bridge.GetNetnsPath("a-b", "c"), bridge.GetNetnsPath("a", "b-c"))
+	assert.Contains(t, runner.commands, "ip netns add "+getNetnsName("a/b-c"), "should create own network namespace for the second pod")
+}
+
+func TestGetVethName(t *testing.T) {
+	name := getVethName("eliot/my-pod")
+	assert.True(t, len(name) <= 15, "interface name must fit to IFNAMSIZ")
+	assert.NotEqual(t, name, getVethName("eliot/other-pod"))
+}
@@ -0,0 +1,129 @@
+package network
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// IPAM allocates pod IP addresses from the subnet.
+// Each allocation is stored as file named by the IP address and containing the owner id,
+// so allocations survive over eliotd and node restarts.
+type IPAM struct {
+	subnet  *net.IPNet
+	dataDir string
+}
+
+// NewIPAM creates new IPAM what stores allocations to the dataDir
+func NewIPAM(subnet *net.IPNet, dataDir string) *IPAM {
+	return &IPAM{
+		subnet:  subnet,
+		dataDir: dataDir,
+	}
+}
+
+// Gateway returns the first address in the subnet, reserved for the bridge
+func (i *IPAM) Gateway() net.IP {
+	return addToIP(i.subnet.IP.Mask(i.subnet.Mask), 1)
+}
+
+// Get returns IP address allocated to the id or nil if there's no allocation
+func (i *IPAM) Get(id string) (net.IP, error) {
+	allocations, err := i.list()
+	if err != nil {
+		return nil, err
+	}
+	for ip, owner := range allocations {
+		if owner == id {
+			return net.ParseIP(ip), nil
+		}
+	}
+	return nil, nil
+}
+
+// Allocate returns IP address for the id. If the id have already allocation, returns the same address
+func (i *IPAM) Allocate(id string) (net.IP, error) {
+	if existing, err := i.Get(id); err != nil || existing != nil {
+		return existing, err
+	}
+
+	if err := os.MkdirAll(i.dataDir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "Failed to create IPAM data directory [%s]", i.dataDir)
+	}
+
+	ones, bits := i.subnet.Mask.Size()
+	size := uint32(1) << uint(bits-ones)
+	network := i.subnet.IP.Mask(i.subnet.Mask)
+
+	// Skip network address, gateway and broadcast address
+	for offset := uint32(2); offset < size-1; offset++ {
+		ip := addToIP(network, offset)
+		file, err := os.OpenFile(filepath.Join(i.dataDir, ip.String()), os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+		if os.IsExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to store IP [%s] allocation", ip)
+		}
+
+		_, err = file.WriteString(id)
+		file.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to store IP [%s] allocation", ip)
+		}
+		return ip, nil
+	}
+
+	return nil, errors.Errorf("No free IP addresses left in subnet [%s]", i.subnet)
+}
+
+// Release frees all IP addresses allocated to the id
+func (i *IPAM) Release(id string) error {
+	allocations, err := i.list()
+	if err != nil {
+		return err
+	}
+	for ip, owner := range allocations {
+		if owner == id {
+			if err := os.Remove(filepath.Join(i.dataDir, ip)); err != nil && !os.IsNotExist(err) {
+				return errors.Wrapf(err, "Failed to release IP [%s]", ip)
+			}
+		}
+	}
+	return nil
+}
+
+func (i *IPAM) list() (map[string]string, error) {
+	result := map[string]string{}
+	files, err := ioutil.ReadDir(i.dataDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, nil
+		}
+		return nil, errors.Wrapf(err, "Failed to read IPAM data directory [%s]", i.dataDir)
+	}
+
+	for _, file := range files {
+		if net.ParseIP(file.Name()) == nil {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(i.dataDir, file.Name()))
+		if err != nil {
+			return nil, errors.Wrapf(err, "Failed to read IP [%s] allocation", file.Name())
+		}
+		result[file.Name()] = strings.TrimSpace(string(data))
+	}
+	return result, nil
+}
+
+func addToIP(ip net.IP, offset uint32) net.IP {
+	ip = ip.To4()
+	result := make(net.IP, net.IPv4len)
+	binary.BigEndian.PutUint32(result, binary.BigEndian.Uint32(ip)+offset)
+	return result
+}
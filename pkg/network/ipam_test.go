@@ -0,0 +1,67 @@
+package network
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestIPAM(t *testing.T, subnet string) (*IPAM, func()) {
+	dir, err := ioutil.TempDir("", "ipam-test")
+	assert.NoError(t, err)
+	_, ipnet, err := net.ParseCIDR(subnet)
+	assert.NoError(t, err)
+
+	return NewIPAM(ipnet, dir), func() { os.RemoveAll(dir) }
+}
+
+func TestIPAMAllocate(t *testing.T) {
+	ipam, cleanup := newTestIPAM(t, "10.88.0.0/16")
+	defer cleanup()
+
+	assert.Equal(t, "10.88.0.1", ipam.Gateway().String())
+
+	first, err := ipam.Allocate("eliot/first")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.88.0.2", first.String())
+
+	second, err := ipam.Allocate("eliot/second")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.88.0.3", second.String())
+
+	again, err := ipam.Allocate("eliot/first")
+	assert.NoError(t, err)
+	assert.Equal(t, first.String(), again.String(), "should return existing allocation")
+}
+
+func TestIPAMRelease(t *testing.T) {
+	ipam, cleanup := newTestIPAM(t, "10.88.0.0/16")
+	defer cleanup()
+
+	first, _ := ipam.Allocate("eliot/first")
+	ipam.Allocate("eliot/second")
+
+	assert.NoError(t, ipam.Release("eliot/first"))
+
+	ip, err := ipam.Get("eliot/first")
+	assert.NoError(t, err)
+	assert.Nil(t, ip)
+
+	reused, err := ipam.Allocate("eliot/third")
+	assert.NoError(t, err)
+	assert.Equal(t, first.String(), reused.String(), "should reuse released address")
+}
+
+func TestIPAMAllocateReturnErrorIfSubnetFull(t *testing.T) {
+	ipam, cleanup := newTestIPAM(t, "10.88.0.0/30")
+	defer cleanup()
+
+	_, err := ipam.Allocate("eliot/first")
+	assert.NoError(t, err)
+
+	_, err = ipam.Allocate("eliot/second")
+	assert.Error(t, err)
+}
@@ -0,0 +1,64 @@
+package network
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// DialInNetns connects to the address from inside the network namespace
+func DialInNetns(netnsPath, network, address string) (net.Conn, error) {
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+	resultc := make(chan result, 1)
+
+	// Switch the namespace in dedicated goroutine, if restoring the original namespace fails
+	// the goroutine exits while still locked to the thread, so the thread get terminated
+	go func() {
+		runtime.LockOSThread()
+
+		origin, err := os.Open(fmt.Sprintf("/proc/self/task/%d/ns/net", unix.Gettid()))
+		if err != nil {
+			runtime.UnlockOSThread()
+			resultc <- result{err: errors.Wrapf(err, "Failed to open current network namespace")}
+			return
+		}
+		defer origin.Close()
+
+		target, err := os.Open(netnsPath)
+		if err != nil {
+			runtime.UnlockOSThread()
+			resultc <- result{err: errors.Wrapf(err, "Failed to open network namespace [%s]", netnsPath)}
+			return
+		}
+		defer target.Close()
+
+		if err := unix.Setns(int(target.Fd()), unix.CLONE_NEWNET); err != nil {
+			runtime.UnlockOSThread()
+			resultc <- result{err: errors.Wrapf(err, "Failed to enter network namespace [%s]", netnsPath)}
+			return
+		}
+
+		// The socket stays in the namespace where it was created
+		conn, dialErr := net.Dial(network, address)
+
+		if err := unix.Setns(int(origin.Fd()), unix.CLONE_NEWNET); err != nil {
+			if conn != nil {
+				conn.Close()
+			}
+			resultc <- result{err: errors.Wrapf(err, "Failed to restore network namespace")}
+			return
+		}
+		runtime.UnlockOSThread()
+		resultc <- result{conn, dialErr}
+	}()
+
+	r := <-resultc
+	return r.conn, r.err
+}
@@ -0,0 +1,15 @@
+// +build !linux
+
+package network
+
+import (
+	"net"
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+// DialInNetns is not supported outside Linux
+func DialInNetns(netnsPath, network, address string) (net.Conn, error) {
+	return nil, errors.Errorf("Network namespaces are not supported on %s", runtime.GOOS)
+}
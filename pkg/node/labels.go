@@ -0,0 +1,149 @@
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+)
+
+// DefaultLabelsFile is the default file where the node labels changed at runtime get persisted
+const DefaultLabelsFile = "/var/lib/eliot/labels.yml"
+
+// labelChanges are the node labels set and removed at runtime
+type labelChanges struct {
+	Labels  map[string]string `json:"labels,omitempty"`
+	Removed []string          `json:"removed,omitempty"`
+}
+
+// GetLabels returns the current node labels
+func (r *Resolver) GetLabels() map[string]string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.resolveLabels()
+}
+
+// SetLabels adds or updates the node labels and persists the change
+func (r *Resolver) SetLabels(labels map[string]string) (map[string]string, error) {
+	return r.updateLabels(func(changes *labelChanges) {
+		for key, value := range labels {
+			changes.Labels[key] = value
+			changes.Removed = without(changes.Removed, key)
+		}
+	})
+}
+
+// RemoveLabels removes the node labels and persists the change
+func (r *Resolver) RemoveLabels(keys []string) (map[string]string, error) {
+	return r.updateLabels(func(changes *labelChanges) {
+		for _, key := range keys {
+			delete(changes.Labels, key)
+			if _, ok := r.static[key]; ok {
+				changes.Removed = append(without(changes.Removed, key), key)
+			}
+		}
+	})
+}
+
+// OnLabelsChanged registers function what gets called with the new labels when the labels change
+func (r *Resolver) OnLabelsChanged(listener func(labels map[string]string)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.listeners = append(r.listeners, listener)
+}
+
+func (r *Resolver) updateLabels(update func(changes *labelChanges)) (map[string]string, error) {
+	r.mu.Lock()
+	changes := r.changes.copy()
+	update(&changes)
+	if err := writeLabelChanges(r.labelsFile, changes); err != nil {
+		r.mu.Unlock()
+		return nil, err
+	}
+	r.changes = changes
+	labels := r.resolveLabels()
+	listeners := r.listeners
+	r.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener(labels)
+	}
+	return labels, nil
+}
+
+// resolveLabels merges the changes over the static labels, caller must hold the lock
+func (r *Resolver) resolveLabels() map[string]string {
+	labels := map[string]string{}
+	for key, value := range r.static {
+		labels[key] = value
+	}
+	for _, key := range r.changes.Removed {
+		delete(labels, key)
+	}
+	for key, value := range r.changes.Labels {
+		labels[key] = value
+	}
+	return labels
+}
+
+func (c labelChanges) copy() labelChanges {
+	result := labelChanges{
+		Labels:  map[string]string{},
+		Removed: append([]string{}, c.Removed...),
+	}
+	for key, value := range c.Labels {
+		result.Labels[key] = value
+	}
+	return result
+}
+
+func without(values []string, value string) (result []string) {
+	for _, v := range values {
+		if v != value {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func readLabelChanges(path string) (changes labelChanges, err error) {
+	if path == "" {
+		return changes, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return changes, nil
+	}
+	if err != nil {
+		return changes, err
+	}
+
+	if err := yaml.Unmarshal(data, &changes); err != nil {
+		return changes, errors.Wrapf(err, "Invalid labels file [%s]", path)
+	}
+	return changes, nil
+}
+
+func writeLabelChanges(path string, changes labelChanges) error {
+	if path == "" {
+		return nil
+	}
+
+	data, err := yaml.Marshal(changes)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "Failed to create directory for labels file [%s]", path)
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return errors.Wrapf(err, "Failed to write labels file [%s]", path)
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,40 @@
+package node
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAndRemoveLabels(t *testing.T) {
+	dir, err := ioutil.TempDir("", "labels-test")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "labels.yml")
+
+	resolver := NewResolver(5000, "test-version", map[string]string{"location": "home", "env": "testing"}, file)
+
+	var notified map[string]string
+	resolver.OnLabelsChanged(func(labels map[string]string) {
+		notified = labels
+	})
+
+	labels, err := resolver.SetLabels(map[string]string{"location": "barn", "camera": "true"})
+	assert.NoError(t, err)
+	assert.Equal(t, "barn", labels["location"])
+	assert.Equal(t, "true", labels["camera"])
+	assert.Equal(t, labels, notified, "should notify listeners about the change")
+
+	labels, err = resolver.RemoveLabels([]string{"env", "camera"})
+	assert.NoError(t, err)
+	assert.NotContains(t, labels, "env")
+	assert.NotContains(t, labels, "camera")
+
+	restarted := NewResolver(5000, "test-version", map[string]string{"location": "home", "env": "testing"}, file)
+	assert.Equal(t, resolver.GetLabels(), restarted.GetLabels(), "should merge persisted labels over the static labels")
+	assert.Equal(t, "barn", restarted.GetLabels()["location"])
+	assert.NotContains(t, restarted.GetLabels(), "env")
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -17,15 +18,29 @@ var eliotLabelPrefix = "eliot.io"
 type Resolver struct {
 	grpcPort int
 	version  string
-	labels   map[string]string
+	// Labels given at startup
+	static map[string]string
+	// Labels changed at runtime, persisted to the labelsFile
+	changes    labelChanges
+	labelsFile string
+	listeners  []func(labels map[string]string)
+	mu         sync.RWMutex
 }
 
-// NewResolver creates new resolver with static node labels
-func NewResolver(grpcPort int, version string, labels map[string]string) *Resolver {
+// NewResolver creates new resolver with static node labels.
+// If labelsFile is given, the labels changed at runtime get persisted to the file and merged over the static labels
+func NewResolver(grpcPort int, version string, labels map[string]string, labelsFile string) *Resolver {
+	changes, err := readLabelChanges(labelsFile)
+	if err != nil {
+		log.Errorf("Failed to read node labels from [%s], will use only the static labels: %s", labelsFile, err)
+	}
+
 	return &Resolver{
-		grpcPort: grpcPort,
-		version:  version,
-		labels:   withHostLabels(labels),
+		grpcPort:   grpcPort,
+		version:    version,
+		static:     withHostLabels(labels),
+		changes:    changes,
+		labelsFile: labelsFile,
 	}
 }
 
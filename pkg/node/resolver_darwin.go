@@ -23,7 +23,7 @@ func (r *Resolver) GetInfo() *model.NodeInfo {
 	return &model.NodeInfo{
 		Version:   r.version,
 		Uptime:    0,
-		Labels:    r.labels,
+		Labels:    r.GetLabels(),
 		Arch:      runtime.GOARCH,
 		OS:        runtime.GOOS,
 		Hostname:  hostname,
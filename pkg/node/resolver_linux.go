@@ -18,7 +18,7 @@ func (r *Resolver) GetInfo() *model.NodeInfo {
 	return &model.NodeInfo{
 		Version:   r.version,
 		Uptime:    resolveUptime(),
-		Labels:    r.labels,
+		Labels:    r.GetLabels(),
 		Arch:      runtime.GOARCH,
 		OS:        runtime.GOOS,
 		Hostname:  hostname,
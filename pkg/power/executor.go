@@ -0,0 +1,74 @@
+package power
+
+import (
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Action is the host power action
+type Action string
+
+const (
+	// Reboot restarts the host
+	Reboot Action = "reboot"
+	// Shutdown powers off the host
+	Shutdown Action = "shutdown"
+)
+
+const (
+	// SystemdExecutorName is name of the executor what asks systemd to perform the action
+	SystemdExecutorName = "systemd"
+	// SyscallExecutorName is name of the executor what calls reboot(2) directly
+	SyscallExecutorName = "syscall"
+)
+
+// Executor performs the host power action
+type Executor interface {
+	Execute(action Action) error
+}
+
+// NewExecutor returns the Executor by the name
+func NewExecutor(name string) (Executor, error) {
+	switch name {
+	case SystemdExecutorName:
+		return NewSystemdExecutor(), nil
+	case SyscallExecutorName:
+		return &SyscallExecutor{}, nil
+	default:
+		return nil, errors.Errorf("Unknown power executor [%s], must be one of: %s, %s", name, SystemdExecutorName, SyscallExecutorName)
+	}
+}
+
+// SystemdExecutor asks systemd to reboot or power off the host
+type SystemdExecutor struct {
+	run func(name string, args ...string) ([]byte, error)
+}
+
+// NewSystemdExecutor creates new Executor what calls systemctl
+func NewSystemdExecutor() *SystemdExecutor {
+	return &SystemdExecutor{
+		run: func(name string, args ...string) ([]byte, error) {
+			return exec.Command(name, args...).CombinedOutput()
+		},
+	}
+}
+
+// Execute is Executor implementation
+func (e *SystemdExecutor) Execute(action Action) error {
+	var command string
+	switch action {
+	case Reboot:
+		command = "reboot"
+	case Shutdown:
+		command = "poweroff"
+	default:
+		return errors.Errorf("Unknown power action [%s]", action)
+	}
+
+	if out, err := e.run("systemctl", command); err != nil {
+		return errors.Wrapf(err, "Failed to %s the host: %s", action, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package power
+
+import (
+	"os"
+
+	"github.com/ernoaapa/eliot/pkg/maintenance"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Manager stops the pods gracefully before the host power action
+type Manager struct {
+	maintenance *maintenance.Manager
+	executor    Executor
+	flush       func()
+}
+
+// NewManager creates new power Manager what performs the actions with the executor
+func NewManager(maintenance *maintenance.Manager, executor Executor) *Manager {
+	return &Manager{
+		maintenance: maintenance,
+		executor:    executor,
+		flush:       flushLogs,
+	}
+}
+
+// Execute stops all pods within their termination grace periods, flushes the logs
+// and performs the power action. Returns the stopped pods.
+// If the action fails, the stopped pods get restarted with the start function and the node continues like before
+func (m *Manager) Execute(action Action, start func(namespace, name string) error) ([]maintenance.PodRef, error) {
+	log.Infof("Stopping all pods before host %s", action)
+	stopped, err := m.maintenance.Stop()
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to stop pods before %s", action)
+	}
+
+	log.Infof("Stopped %d pods, will %s the host", len(stopped), action)
+	m.flush()
+
+	if err := m.executor.Execute(action); err != nil {
+		log.Errorf("Failed to %s the host, restart the stopped pods: %s", action, err)
+		if restartErr := m.maintenance.Restart(stopped, start); restartErr != nil {
+			log.Errorf("Failed to restart all pods after failed %s: %s", action, restartErr)
+		}
+		return nil, err
+	}
+	return stopped, nil
+}
+
+func flushLogs() {
+	if file, ok := log.StandardLogger().Out.(*os.File); ok {
+		file.Sync()
+	}
+	syncFilesystems()
+}
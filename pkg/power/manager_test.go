@@ -0,0 +1,121 @@
+package power
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/maintenance"
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClient struct {
+	runtime.Client
+	pods       []model.Pod
+	terminated []string
+}
+
+func (c *fakeClient) GetNamespaces() ([]string, error) {
+	return []string{"eliot"}, nil
+}
+
+func (c *fakeClient) GetPods(namespace string) ([]model.Pod, error) {
+	return c.pods, nil
+}
+
+func (c *fakeClient) TerminateContainer(namespace, id string) (model.ContainerStatus, error) {
+	c.terminated = append(c.terminated, id)
+	return model.ContainerStatus{ContainerID: id, State: "stopped"}, nil
+}
+
+type fakeExecutor struct {
+	actions []Action
+	err     error
+}
+
+func (e *fakeExecutor) Execute(action Action) error {
+	e.actions = append(e.actions, action)
+	return e.err
+}
+
+func TestExecute(t *testing.T) {
+	client := &fakeClient{
+		pods: []model.Pod{
+			{
+				Metadata: model.NewMetadata("eliot", "my-pod"),
+				Status: model.PodStatus{ContainerStatuses: []model.ContainerStatus{
+					{Name: "first", ContainerID: "1", State: "running"},
+				}},
+			},
+		},
+	}
+	executor := &fakeExecutor{}
+	flushed := false
+
+	manager := NewManager(maintenance.NewManager(client, ""), executor)
+	manager.flush = func() {
+		assert.Equal(t, []string{"1"}, client.terminated, "should flush the logs after stopping the pods")
+		flushed = true
+	}
+
+	stopped, err := manager.Execute(Reboot, func(namespace, name string) error {
+		t.Fatal("should not restart the pods")
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []maintenance.PodRef{{Namespace: "eliot", Name: "my-pod"}}, stopped)
+	assert.True(t, flushed)
+	assert.Equal(t, []Action{Reboot}, executor.actions)
+
+	_, err = manager.Execute(Shutdown, nil)
+	assert.Error(t, err, "should not allow another action while the host goes down")
+}
+
+func TestExecuteFailure(t *testing.T) {
+	client := &fakeClient{
+		pods: []model.Pod{
+			{
+				Metadata: model.NewMetadata("eliot", "my-pod"),
+				Status: model.PodStatus{ContainerStatuses: []model.ContainerStatus{
+					{Name: "first", ContainerID: "1", State: "running"},
+				}},
+			},
+		},
+	}
+	executor := &fakeExecutor{err: errors.New("permission denied")}
+	node := maintenance.NewManager(client, "")
+	manager := NewManager(node, executor)
+	manager.flush = func() {}
+
+	started := []string{}
+	start := func(namespace, name string) error {
+		started = append(started, namespace+"/"+name)
+		return nil
+	}
+
+	_, err := manager.Execute(Reboot, start)
+	assert.Error(t, err, "should return the executor error")
+	assert.Equal(t, []string{"eliot/my-pod"}, started, "should restart the stopped pods")
+	assert.False(t, node.IsCordoned(), "should accept pods again")
+	assert.False(t, node.IsDrained(), "should let the lifecycle controller restart pods again")
+
+	executor.err = nil
+	_, err = manager.Execute(Reboot, start)
+	assert.NoError(t, err, "should allow retry after failure")
+}
+
+func TestSystemdExecutor(t *testing.T) {
+	commands := [][]string{}
+	executor := &SystemdExecutor{
+		run: func(name string, args ...string) ([]byte, error) {
+			commands = append(commands, append([]string{name}, args...))
+			return nil, nil
+		},
+	}
+
+	assert.NoError(t, executor.Execute(Reboot))
+	assert.NoError(t, executor.Execute(Shutdown))
+	assert.Error(t, executor.Execute(Action("hibernate")))
+	assert.Equal(t, [][]string{{"systemctl", "reboot"}, {"systemctl", "poweroff"}}, commands)
+}
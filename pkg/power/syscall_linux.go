@@ -0,0 +1,30 @@
+package power
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// SyscallExecutor reboots or powers off the host with reboot(2) system call
+type SyscallExecutor struct{}
+
+// Execute is Executor implementation
+func (e *SyscallExecutor) Execute(action Action) error {
+	var cmd int
+	switch action {
+	case Reboot:
+		cmd = unix.LINUX_REBOOT_CMD_RESTART
+	case Shutdown:
+		cmd = unix.LINUX_REBOOT_CMD_POWER_OFF
+	default:
+		return errors.Errorf("Unknown power action [%s]", action)
+	}
+
+	// reboot(2) doesn't flush the filesystem buffers
+	syncFilesystems()
+	return errors.Wrapf(unix.Reboot(cmd), "Failed to %s the host", action)
+}
+
+func syncFilesystems() {
+	unix.Sync()
+}
@@ -0,0 +1,19 @@
+// +build !linux
+
+package power
+
+import (
+	"runtime"
+
+	"github.com/pkg/errors"
+)
+
+// SyscallExecutor is not supported outside Linux
+type SyscallExecutor struct{}
+
+// Execute is Executor implementation
+func (e *SyscallExecutor) Execute(action Action) error {
+	return errors.Errorf("Power action %s is not supported on %s", action, runtime.GOOS)
+}
+
+func syncFilesystems() {}
@@ -10,9 +10,11 @@ import (
 	"strings"
 	"time"
 
+	configs "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
 	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
 	node "github.com/ernoaapa/eliot/pkg/api/services/node/v1"
 	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
+	volumes "github.com/ernoaapa/eliot/pkg/api/services/volumes/v1"
 	"github.com/ernoaapa/eliot/pkg/config"
 	"github.com/ernoaapa/eliot/pkg/printers/humanreadable"
 	"github.com/ernoaapa/eliot/pkg/utils"
@@ -39,10 +41,11 @@ func (p *HumanReadablePrinter) PrintPods(pods []*pods.Pod, writer io.Writer) err
 		return nil
 	}
 
-	fmt.Fprintln(writer, "\nNAMESPACE\tNAME\tCONTAINERS\tSTATUS")
+	fmt.Fprintln(writer, "\nNAMESPACE\tNAME\tCONTAINERS\tSTATUS\tRESTARTS\tIP\tAGE")
 
+	now := time.Now()
 	for _, pod := range pods {
-		_, err := fmt.Fprintf(writer, "%s\t%s\t%d\t%s\n", pod.Metadata.Namespace, pod.Metadata.Name, len(pod.Spec.Containers), getStatus(pod))
+		_, err := fmt.Fprintf(writer, "%s\t%s\t%d\t%s\t%d\t%s\t%s\n", pod.Metadata.Namespace, pod.Metadata.Name, len(pod.Spec.Containers), getStatus(pod), getRestartCount(pod), getPodIP(pod), formatAge(getStartedAt(pod), now))
 		if err != nil {
 			return errors.Wrapf(err, "Error while writing pod row")
 		}
@@ -76,6 +79,65 @@ func getStatus(pod *pods.Pod) string {
 	return strings.Join(result, ",")
 }
 
+// getRestartCount returns sum of all pod containers restarts
+func getRestartCount(pod *pods.Pod) (result int32) {
+	if pod.Status == nil {
+		return 0
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		result += status.RestartCount
+	}
+	return result
+}
+
+// getPodIP returns the pod IP address or '-' if the pod uses host network
+func getPodIP(pod *pods.Pod) string {
+	if pod.Status == nil || pod.Status.PodIP == "" {
+		return "-"
+	}
+	return pod.Status.PodIP
+}
+
+// getStartedAt returns the earliest start time of pod containers as unix timestamp
+// or zero if none of the containers have started
+func getStartedAt(pod *pods.Pod) (result int64) {
+	if pod.Status == nil {
+		return 0
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.StartedAt != 0 && (result == 0 || status.StartedAt < result) {
+			result = status.StartedAt
+		}
+	}
+	return result
+}
+
+// formatAge returns short human readable duration since given unix timestamp. E.g. 5m, 3h, 2d
+func formatAge(timestamp int64, now time.Time) string {
+	if timestamp == 0 {
+		return "-"
+	}
+	age := now.Sub(time.Unix(timestamp, 0))
+	switch {
+	case age < time.Minute:
+		return fmt.Sprintf("%ds", int(age.Seconds()))
+	case age < time.Hour:
+		return fmt.Sprintf("%dm", int(age.Minutes()))
+	case age < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(age.Hours()/24))
+	}
+}
+
+// formatTimestamp returns unix timestamp in RFC3339 format or empty string if not set
+func formatTimestamp(timestamp int64) string {
+	if timestamp == 0 {
+		return ""
+	}
+	return time.Unix(timestamp, 0).Format(time.RFC3339)
+}
+
 func getKeys(source map[string]int) (result []string) {
 	for key := range source {
 		result = append(result, key)
@@ -89,11 +151,11 @@ func (p *HumanReadablePrinter) PrintNodes(nodes []*node.Info, writer io.Writer)
 		fmt.Fprintf(writer, "\n\t(No nodes)\n\n")
 		return nil
 	}
-	fmt.Fprintln(writer, "\nHOSTNAME\tENDPOINT\tVERSION")
+	fmt.Fprintln(writer, "\nHOSTNAME\tENDPOINT\tSTATUS\tVERSION")
 
 	for _, node := range nodes {
 		endpoint := fmt.Sprintf("%s:%d", utils.GetFirst(node.Addresses, ""), node.GrpcPort)
-		_, err := fmt.Fprintf(writer, "%s\t%s\t%s\n", node.Hostname, endpoint, node.Version)
+		_, err := fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", node.Hostname, endpoint, getNodeStatus(node), node.Version)
 		if err != nil {
 			return errors.Wrapf(err, "Error while writing node row")
 		}
@@ -102,6 +164,13 @@ func (p *HumanReadablePrinter) PrintNodes(nodes []*node.Info, writer io.Writer)
 	return nil
 }
 
+func getNodeStatus(info *node.Info) string {
+	if info.Unschedulable {
+		return "Ready,SchedulingDisabled"
+	}
+	return "Ready"
+}
+
 // PrintNode writes a node in human readable detailed format to the writer
 func (p *HumanReadablePrinter) PrintNode(info *node.Info, writer io.Writer) error {
 	t := template.New("node-details").Funcs(template.FuncMap{
@@ -163,7 +232,8 @@ func (p *HumanReadablePrinter) PrintPod(pod *pods.Pod, writer io.Writer) error {
 			}
 			return nil
 		},
-		"StringsJoin": strings.Join,
+		"StringsJoin":     strings.Join,
+		"FormatTimestamp": formatTimestamp,
 	})
 	t, err := t.Parse(humanreadable.PodDetailsTemplate)
 	if err != nil {
@@ -176,6 +246,112 @@ func (p *HumanReadablePrinter) PrintPod(pod *pods.Pod, writer io.Writer) error {
 	return t.Execute(writer, data)
 }
 
+// PrintVolumes writes list of Volumes in human readable table format to the writer
+func (p *HumanReadablePrinter) PrintVolumes(volumes []*volumes.Volume, writer io.Writer) error {
+	if len(volumes) == 0 {
+		fmt.Fprintf(writer, "\n\t(No volumes)\n\n")
+		return nil
+	}
+
+	fmt.Fprintln(writer, "\nNAMESPACE\tNAME\tSIZE\tUSAGE\tPODS\tAGE")
+
+	now := time.Now()
+	for _, volume := range volumes {
+		_, err := fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", volume.Metadata.Namespace, volume.Metadata.Name, getVolumeSize(volume), getVolumeUsage(volume), getVolumeUsedBy(volume), formatAge(getVolumeCreatedAt(volume), now))
+		if err != nil {
+			return errors.Wrapf(err, "Error while writing volume row")
+		}
+	}
+
+	return nil
+}
+
+// getVolumeSize returns human readable volume size limit or '-' if the volume is unlimited
+func getVolumeSize(volume *volumes.Volume) string {
+	if volume.Spec == nil || volume.Spec.Size == 0 {
+		return "-"
+	}
+	return datasize.ByteSize(volume.Spec.Size).HumanReadable()
+}
+
+// getVolumeUsage returns human readable volume usage
+func getVolumeUsage(volume *volumes.Volume) string {
+	if volume.Status == nil {
+		return "-"
+	}
+	return datasize.ByteSize(volume.Status.Usage).HumanReadable()
+}
+
+// getVolumeUsedBy returns comma separated list of pods what use the volume or '-' if not in use
+func getVolumeUsedBy(volume *volumes.Volume) string {
+	if volume.Status == nil || len(volume.Status.UsedBy) == 0 {
+		return "-"
+	}
+	return strings.Join(volume.Status.UsedBy, ",")
+}
+
+func getVolumeCreatedAt(volume *volumes.Volume) int64 {
+	if volume.Status == nil {
+		return 0
+	}
+	return volume.Status.CreatedAt
+}
+
+// PrintSecrets writes list of Secrets in human readable table format to the writer
+func (p *HumanReadablePrinter) PrintSecrets(secrets []*configs.Secret, writer io.Writer) error {
+	if len(secrets) == 0 {
+		fmt.Fprintf(writer, "\n\t(No secrets)\n\n")
+		return nil
+	}
+
+	fmt.Fprintln(writer, "\nNAMESPACE\tNAME\tKEYS")
+
+	for _, secret := range secrets {
+		keys := []string{}
+		for key := range secret.Data {
+			keys = append(keys, key)
+		}
+		_, err := fmt.Fprintf(writer, "%s\t%s\t%s\n", secret.Metadata.Namespace, secret.Metadata.Name, formatKeys(keys))
+		if err != nil {
+			return errors.Wrapf(err, "Error while writing secret row")
+		}
+	}
+
+	return nil
+}
+
+// PrintConfigMaps writes list of ConfigMaps in human readable table format to the writer
+func (p *HumanReadablePrinter) PrintConfigMaps(configMaps []*configs.ConfigMap, writer io.Writer) error {
+	if len(configMaps) == 0 {
+		fmt.Fprintf(writer, "\n\t(No configmaps)\n\n")
+		return nil
+	}
+
+	fmt.Fprintln(writer, "\nNAMESPACE\tNAME\tKEYS")
+
+	for _, configMap := range configMaps {
+		keys := []string{}
+		for key := range configMap.Data {
+			keys = append(keys, key)
+		}
+		_, err := fmt.Fprintf(writer, "%s\t%s\t%s\n", configMap.Metadata.Namespace, configMap.Metadata.Name, formatKeys(keys))
+		if err != nil {
+			return errors.Wrapf(err, "Error while writing configmap row")
+		}
+	}
+
+	return nil
+}
+
+// formatKeys returns sorted comma separated list of keys or '-' if there's no keys
+func formatKeys(keys []string) string {
+	if len(keys) == 0 {
+		return "-"
+	}
+	sort.Strings(keys)
+	return strings.Join(keys, ",")
+}
+
 // PrintConfig writes list of pods in human readable detailed format to the writer
 func (p *HumanReadablePrinter) PrintConfig(config *config.Config, writer io.Writer) error {
 	t := template.New("config")
@@ -5,6 +5,7 @@ const NodeDetailsTemplate = `Hostname:	{{.Hostname}}
 Uptime:	{{FormatUptime .Uptime}}
 Arch/OS:	{{.Os}}/{{.Arch}}
 Version:	{{.Version}}
+Unschedulable:	{{.Unschedulable}}
 Labels:{{range .Labels}}
 	{{.Key}}={{.Value}}
 {{- end}}
@@ -23,4 +24,14 @@ Filesystems:
 	{{.Filesystem}}	{{.TypeName}}	{{FormatBytes .Total}}	{{Subtract .Total .Free | FormatBytes}}	{{FormatBytes .Available}}	{{FormatPercent .Total .Free .Available}}	{{.MountDir}}
 {{- end}}
 {{- end}}
+{{- if .Devices }}
+Devices:
+	Resource	Device	Exclusive	Allocated to
+	--------	------	---------	------------
+{{- range $resource := .Devices}}
+{{- range .Devices}}
+	{{$resource.Name}}	{{.Path}}	{{$resource.Exclusive}}	{{if .AllocatedTo}}{{.AllocatedTo}}{{else}}-{{end}}
+{{- end}}
+{{- end}}
+{{- end}}
 `
@@ -4,11 +4,28 @@ package humanreadable
 const PodDetailsTemplate = `{{$pod := .Pod -}}
 Name:	{{.Pod.Metadata.Name}}
 Namespace:	{{.Pod.Metadata.Namespace}}
+{{- if .Pod.Metadata.Labels}}
+Labels:{{range $key, $value := .Pod.Metadata.Labels}}
+	{{$key}}={{$value}}
+{{- end}}
+{{- end}}
+{{- if .Pod.Metadata.Annotations}}
+Annotations:{{range $key, $value := .Pod.Metadata.Annotations}}
+	{{$key}}={{$value}}
+{{- end}}
+{{- end}}
 Node:	{{.Pod.Status.Hostname}}
 State:	{{.Status}}
 Restart Policy:	{{.Pod.Spec.RestartPolicy}}
 Host Network:	{{.Pod.Spec.HostNetwork}}
+{{- if .Pod.Status.PodIP}}
+IP:	{{.Pod.Status.PodIP}}
+{{- end}}
 Host PID:	{{.Pod.Spec.HostPID}}
+Share Process Namespace:	{{.Pod.Spec.ShareProcessNamespace}}
+{{- if .Pod.Spec.Hostname}}
+Hostname:	{{.Pod.Spec.Hostname}}
+{{- end}}
 Containers:{{range .Pod.Spec.Containers}}
   {{- $status := GetStatus $pod .Name}}
 	{{.Name}}:
@@ -17,6 +34,21 @@ Containers:{{range .Pod.Spec.Containers}}
 		ContainerID:	{{$status.ContainerID}}
 		State:	{{$status.State}}
 		Restart Count:	{{$status.RestartCount}}
+		{{- if $status.StartedAt}}
+		Started:	{{FormatTimestamp $status.StartedAt}}
+		{{- end}}
+		{{- if $status.FinishedAt}}
+		Finished:	{{FormatTimestamp $status.FinishedAt}}
+		Exit Code:	{{$status.ExitCode}}
+		Reason:	{{$status.Reason}}
+		{{- end}}
+		{{- with $status.LastTerminationState}}
+		Last State:	Terminated
+			Exit Code:	{{.ExitCode}}
+			Reason:	{{.Reason}}
+			Started:	{{FormatTimestamp .StartedAt}}
+			Finished:	{{FormatTimestamp .FinishedAt}}
+		{{- end}}
 		Working Dir:	{{.WorkingDir}}
 		{{- end}}
 		Args:{{range .Args}}
@@ -25,9 +57,42 @@ Containers:{{range .Pod.Spec.Containers}}
 		Env:{{range .Env}}
 			- {{.}}
 		{{- end}}
+		{{- range .EnvVars}}
+			- {{.Name}} from {{with .ValueFrom.SecretKeyRef}}secret {{.Name}} key {{.Key}}{{end}}{{with .ValueFrom.ConfigMapKeyRef}}configmap {{.Name}} key {{.Key}}{{end}}{{with .ValueFrom.FieldRef}}field {{.}}{{end}}
+		{{- end}}
 		Mounts:{{range .Mounts}}
 			- type={{.Type}},source={{.Source}},destination={{.Destination}},options={{StringsJoin .Options ":"}}
 		{{- end}}
+    {{- if .Ports}}
+		Ports:{{range .Ports}}
+			- {{if .HostPort}}{{.HostPort}}{{else}}{{.ContainerPort}}{{end}}:{{.ContainerPort}}/{{if .Protocol}}{{.Protocol}}{{else}}tcp{{end}}
+		{{- end}}
+		{{- end}}
+    {{- if .Resources}}
+		Resources:{{range $name, $count := .Resources}}
+			- {{$name}}: {{$count}}
+		{{- end}}
+		{{- end}}
+    {{- if .Devices}}
+		Devices:{{range .Devices}}
+			- hostPath={{.HostPath}},containerPath={{.ContainerPath}},permissions={{.Permissions}}
+		{{- end}}
+		{{- end}}
+    {{- with .SecurityContext}}
+		Security Context:
+			{{- if .User}}
+			User:	{{.User}}{{if .Group}}:{{.Group}}{{end}}
+			{{- end}}
+			Privileged:	{{.Privileged}}
+			Read-only Root Filesystem:	{{.ReadOnlyRootFilesystem}}
+			No New Privileges:	{{.NoNewPrivileges}}
+			{{- with .Capabilities}}
+			Capabilities:	add={{StringsJoin .Add ","}},drop={{StringsJoin .Drop ","}}
+			{{- end}}
+			{{- if .SeccompProfile}}
+			Seccomp Profile:	{{.SeccompProfile}}
+			{{- end}}
+		{{- end}}
     {{- if .Pipe}}
 		Pipe:
 			stdout -> stdin: {{.Pipe.Stdout.Stdin.Name}}
@@ -2,7 +2,10 @@ package printers
 
 import (
 	"testing"
+	"time"
 
+	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
+	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -15,3 +18,26 @@ func TestFormatDuration(t *testing.T) {
 	assert.Equal(t, "292 years 24 weeks 3 days 23 hours 47 minutes 16 seconds", formatUptime(9223372036), "should format large value (maximum Nanosecond duration in seconds)")
 	assert.Equal(t, "18446744073709551615 seconds", formatUptime(18446744073709551615), "Should not break if goes above int64 (e.g. if maximum uint64)")
 }
+
+func TestFormatAge(t *testing.T) {
+	now := time.Unix(1000000, 0)
+	assert.Equal(t, "-", formatAge(0, now), "Should return dash if not started")
+	assert.Equal(t, "30s", formatAge(now.Add(-30*time.Second).Unix(), now))
+	assert.Equal(t, "5m", formatAge(now.Add(-5*time.Minute).Unix(), now))
+	assert.Equal(t, "47h", formatAge(now.Add(-47*time.Hour).Unix(), now))
+	assert.Equal(t, "3d", formatAge(now.Add(-72*time.Hour).Unix(), now))
+}
+
+func TestGetStartedAt(t *testing.T) {
+	pod := &pods.Pod{
+		Status: &pods.PodStatus{
+			ContainerStatuses: []*containers.ContainerStatus{
+				{StartedAt: 0},
+				{StartedAt: 200},
+				{StartedAt: 100},
+			},
+		},
+	}
+	assert.Equal(t, int64(100), getStartedAt(pod), "Should return earliest container start time")
+	assert.Equal(t, int64(0), getStartedAt(&pods.Pod{}), "Should return zero if no status")
+}
@@ -3,8 +3,10 @@ package printers
 import (
 	"io"
 
+	configs "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
 	node "github.com/ernoaapa/eliot/pkg/api/services/node/v1"
 	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
+	volumes "github.com/ernoaapa/eliot/pkg/api/services/volumes/v1"
 	"github.com/ernoaapa/eliot/pkg/config"
 )
 
@@ -15,4 +17,7 @@ type ResourcePrinter interface {
 	PrintNode(*node.Info, io.Writer) error
 	PrintPod(*pods.Pod, io.Writer) error
 	PrintConfig(*config.Config, io.Writer) error
+	PrintVolumes([]*volumes.Volume, io.Writer) error
+	PrintSecrets([]*configs.Secret, io.Writer) error
+	PrintConfigMaps([]*configs.ConfigMap, io.Writer) error
 }
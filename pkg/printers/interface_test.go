@@ -5,9 +5,11 @@ import (
 	"testing"
 
 	"github.com/ernoaapa/eliot/pkg/api/core"
+	configs "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
 	containers "github.com/ernoaapa/eliot/pkg/api/services/containers/v1"
 	node "github.com/ernoaapa/eliot/pkg/api/services/node/v1"
 	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
+	volumes "github.com/ernoaapa/eliot/pkg/api/services/volumes/v1"
 	"github.com/ernoaapa/eliot/pkg/config"
 	"github.com/stretchr/testify/assert"
 )
@@ -38,6 +40,9 @@ func TestSuite(t *testing.T) {
 			testPrintNode(t, impl)
 			testPrintPods(t, impl)
 			testPrintConfig(t, impl)
+			testPrintVolumes(t, impl)
+			testPrintSecrets(t, impl)
+			testPrintConfigMaps(t, impl)
 		})
 	}
 }
@@ -115,8 +120,10 @@ func testPrintPod(t *testing.T, printer ResourcePrinter) {
 
 	data := &pods.Pod{
 		Metadata: &core.ResourceMetadata{
-			Name:      "foo",
-			Namespace: "eliot",
+			Name:        "foo",
+			Namespace:   "eliot",
+			Labels:      map[string]string{"app": "sensor"},
+			Annotations: map[string]string{"description": "Reads temperature"},
 		},
 		Spec: &pods.PodSpec{
 			Containers: []*containers.Container{
@@ -153,3 +160,58 @@ func testPrintConfig(t *testing.T, printer ResourcePrinter) {
 
 	assert.True(t, len(result) > 0, "Should write something to the writer")
 }
+
+func testPrintVolumes(t *testing.T, printer ResourcePrinter) {
+	var buffer bytes.Buffer
+
+	data := []*volumes.Volume{
+		{
+			Metadata: &core.ResourceMetadata{Name: "data", Namespace: "eliot"},
+			Spec:     &volumes.VolumeSpec{Size: 1024 * 1024},
+			Status:   &volumes.VolumeStatus{Usage: 1024, UsedBy: []string{"foo"}},
+		},
+	}
+
+	err := printer.PrintVolumes(data, &buffer)
+	assert.NoError(t, err, "Printing volumes table should not return error")
+
+	result := buffer.String()
+
+	assert.True(t, len(result) > 0, "Should write something to the writer")
+}
+
+func testPrintSecrets(t *testing.T, printer ResourcePrinter) {
+	var buffer bytes.Buffer
+
+	data := []*configs.Secret{
+		{
+			Metadata: &core.ResourceMetadata{Name: "api", Namespace: "eliot"},
+			Data:     map[string][]byte{"token": {}},
+		},
+	}
+
+	err := printer.PrintSecrets(data, &buffer)
+	assert.NoError(t, err, "Printing secrets table should not return error")
+
+	result := buffer.String()
+
+	assert.True(t, len(result) > 0, "Should write something to the writer")
+}
+
+func testPrintConfigMaps(t *testing.T, printer ResourcePrinter) {
+	var buffer bytes.Buffer
+
+	data := []*configs.ConfigMap{
+		{
+			Metadata: &core.ResourceMetadata{Name: "settings", Namespace: "eliot"},
+			Data:     map[string]string{"level": "debug"},
+		},
+	}
+
+	err := printer.PrintConfigMaps(data, &buffer)
+	assert.NoError(t, err, "Printing configmaps table should not return error")
+
+	result := buffer.String()
+
+	assert.True(t, len(result) > 0, "Should write something to the writer")
+}
@@ -3,8 +3,10 @@ package printers
 import (
 	"io"
 
+	configs "github.com/ernoaapa/eliot/pkg/api/services/configs/v1"
 	node "github.com/ernoaapa/eliot/pkg/api/services/node/v1"
 	pods "github.com/ernoaapa/eliot/pkg/api/services/pods/v1"
+	volumes "github.com/ernoaapa/eliot/pkg/api/services/volumes/v1"
 	"github.com/ernoaapa/eliot/pkg/config"
 	"github.com/pkg/errors"
 	yaml "gopkg.in/yaml.v2"
@@ -59,6 +61,30 @@ func (p *YamlPrinter) PrintConfig(config *config.Config, w io.Writer) error {
 	return nil
 }
 
+// PrintVolumes takes list of volumes and prints to Writer in YAML format
+func (p *YamlPrinter) PrintVolumes(volumes []*volumes.Volume, w io.Writer) error {
+	if err := writeAsYml(volumes, w); err != nil {
+		return errors.Wrap(err, "Failed to write volumes yaml")
+	}
+	return nil
+}
+
+// PrintSecrets takes list of secrets and prints to Writer in YAML format
+func (p *YamlPrinter) PrintSecrets(secrets []*configs.Secret, w io.Writer) error {
+	if err := writeAsYml(secrets, w); err != nil {
+		return errors.Wrap(err, "Failed to write secrets yaml")
+	}
+	return nil
+}
+
+// PrintConfigMaps takes list of configmaps and prints to Writer in YAML format
+func (p *YamlPrinter) PrintConfigMaps(configMaps []*configs.ConfigMap, w io.Writer) error {
+	if err := writeAsYml(configMaps, w); err != nil {
+		return errors.Wrap(err, "Failed to write configmaps yaml")
+	}
+	return nil
+}
+
 func writeAsYml(in interface{}, w io.Writer) error {
 	data, err := yaml.Marshal(in)
 	if err != nil {
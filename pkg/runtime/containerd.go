@@ -3,15 +3,22 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"io"
+	"net"
 	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
 	"github.com/containerd/containerd"
+	eventsapi "github.com/containerd/containerd/api/events"
 	tasks "github.com/containerd/containerd/api/services/tasks/v1"
 	"github.com/containerd/containerd/cio"
+	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/errdefs"
+	"github.com/containerd/containerd/events"
 	"github.com/containerd/containerd/images"
 	"github.com/containerd/containerd/namespaces"
 	namespaceutils "github.com/containerd/containerd/namespaces"
@@ -19,7 +26,9 @@ import (
 	"github.com/containerd/containerd/platforms"
 	"github.com/containerd/containerd/plugin"
 	"github.com/containerd/containerd/remotes"
+	"github.com/containerd/typeurl"
 	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/network"
 	"github.com/ernoaapa/eliot/pkg/progress"
 	opts "github.com/ernoaapa/eliot/pkg/runtime/containerd"
 	"github.com/ernoaapa/eliot/pkg/runtime/containerd/extensions"
@@ -38,16 +47,34 @@ type ContainerdClient struct {
 	snapshotter string
 	address     string
 	hostname    string
+	network     *network.Bridge
+	replaySize  int
+	startHooks  []StartHook
+	streams     map[string]*containerStreams
+	streamsMu   sync.Mutex
+	// attachMu serialises attaching to the existing tasks, so only one attach reads the task FIFOs
+	attachMu sync.Mutex
 }
 
-// NewContainerdClient creates new containerd client with given timeout
-func NewContainerdClient(context context.Context, timeout time.Duration, snapshotter, address, hostname string) *ContainerdClient {
+// containerStreams is the container task stdin and output what all attached clients share
+type containerStreams struct {
+	stdin  io.Writer
+	output *OutputBroadcaster
+}
+
+// NewContainerdClient creates new containerd client with given timeout.
+// Pods which don't use host network get connected to the bridge network
+// New attachers get replay of the latest replaySize bytes of the container output
+func NewContainerdClient(context context.Context, timeout time.Duration, snapshotter, address, hostname string, bridge *network.Bridge, replaySize int) *ContainerdClient {
 	return &ContainerdClient{
 		context:     context,
 		timeout:     timeout,
 		address:     address,
 		snapshotter: snapshotter,
 		hostname:    hostname,
+		network:     bridge,
+		replaySize:  replaySize,
+		streams:     map[string]*containerStreams{},
 	}
 }
 
@@ -196,14 +223,76 @@ func (c *ContainerdClient) CreateContainer(pod model.Pod, container model.Contai
 		specOpts = append(specOpts, oci.WithHostNamespace(specs.PIDNamespace))
 	}
 
+	if pod.Spec.Hostname != "" {
+		specOpts = append(specOpts, oci.WithHostname(pod.Spec.Hostname))
+	}
+
+	podNamespaces, err := resolvePodNamespaces(ctx, client, pod)
+	if err != nil {
+		return status, err
+	}
+
+	var podIP net.IP
+	if !pod.Spec.HostNetwork && c.network != nil {
+		podIP, err = c.ensurePodNetwork(pod.Metadata.Namespace, pod.Metadata.Name, container.Ports)
+		if err != nil {
+			c.cleanupPodNetwork(client, pod)
+			return status, err
+		}
+		defer func() {
+			if err != nil {
+				c.cleanupPodNetwork(client, pod)
+			}
+		}()
+		specOpts = append(specOpts,
+			oci.WithLinuxNamespace(specs.LinuxNamespace{
+				Type: specs.NetworkNamespace,
+				Path: c.network.GetNetnsPath(pod.Metadata.Namespace, pod.Metadata.Name),
+			}),
+			oci.WithHostResolvconf,
+		)
+	} else if len(container.Ports) > 0 {
+		return status, ErrWithMessagef(ErrNotSupported, "Cannot publish container [%s] ports, bridge network is not available", container.Name)
+	}
+
+	if container.SecurityContext != nil {
+		specOpts = append(specOpts, opts.WithSecurityContext(*container.SecurityContext))
+	}
+
 	id := xid.New()
 	containerOpts := []containerd.NewContainerOpts{
 		containerd.WithContainerLabels(mapping.NewLabels(pod, container)),
-		containerd.WithNewSpec(specOpts...),
 		containerd.WithSnapshotter(c.snapshotter),
 		containerd.WithNewSnapshot(id.String(), image),
+		containerd.WithNewSpec(specOpts...),
 		containerd.WithRuntime(fmt.Sprintf("%s.%s", plugin.RuntimePlugin, "linux"), nil),
 		extensions.WithLifecycleExtension,
+		extensions.WithTerminationGracePeriod(pod.Spec.GetTerminationGracePeriod()),
+		extensions.WithPodNamespacesExtension(podNamespaces),
+	}
+
+	if len(container.Devices) > 0 || len(container.Resources) > 0 {
+		containerOpts = append(containerOpts, extensions.WithDeviceSetExtension(
+			mapping.MapDeviceSetToContainerdModel(container),
+		))
+	}
+
+	if len(container.EnvVars) > 0 {
+		containerOpts = append(containerOpts, extensions.WithEnvVarsExtension(
+			mapping.MapEnvVarsToContainerdModel(container.EnvVars),
+		))
+	}
+
+	if container.SecurityContext != nil {
+		containerOpts = append(containerOpts, extensions.WithSecurityContextExtension(
+			mapping.MapSecurityContextToContainerdModel(*container.SecurityContext),
+		))
+	}
+
+	if podIP != nil {
+		containerOpts = append(containerOpts, extensions.WithNetworkExtension(
+			mapping.MapNetworkToContainerdModel(podIP.String(), container),
+		))
 	}
 
 	if container.Pipe != nil {
@@ -256,6 +345,7 @@ func (c *ContainerdClient) StartContainer(namespace, id string, ioSet IOSet) (re
 		return result, errors.Wrapf(err, "Error while creating container task IO")
 	}
 
+	updates := []containerd.UpdateContainerOpts{}
 	if task, err := container.Task(ctx, nil); err != nil {
 		if !errdefs.IsNotFound(err) {
 			return result, errors.Wrapf(err, "Error while resolving container task status")
@@ -264,12 +354,51 @@ func (c *ContainerdClient) StartContainer(namespace, id string, ioSet IOSet) (re
 		if err := ensureTaskStopped(ctx, task); err != nil {
 			return result, errors.Wrapf(err, "Failed to ensure task is stopped")
 		}
-		if _, err := task.Delete(ctx); err != nil {
+		exitStatus, err := task.Delete(ctx)
+		if err != nil {
 			return result, errors.Wrapf(err, "Error while cleaning up old container task")
 		}
+		updates = append(updates, extensions.RecordTermination(exitStatus.ExitCode(), exitStatus.ExitTime()))
+	}
+
+	if podIP := mapping.GetPodIP(info); podIP != "" && c.network != nil {
+		// Network namespace and port mappings don't survive over node restart so ensure they exist
+		if _, err := c.ensurePodNetwork(namespace, mapping.GetPodName(info), mapping.MapPortsToInternalModel(info)); err != nil {
+			return result, err
+		}
 	}
 
-	task, err := container.NewTask(ctx, io.IOCreate)
+	var (
+		pod            = mapping.InitialisePodModel(info, namespace, mapping.GetPodName(info), c.hostname)
+		containerModel = mapping.MapContainerToInternalModel(info)
+		env            = []string{}
+	)
+	for _, hook := range c.startHooks {
+		hookEnv, err := hook(pod, containerModel)
+		if err != nil {
+			return result, errors.Wrapf(err, "Failed to prepare container [%s] for start", container.ID())
+		}
+		env = append(env, hookEnv...)
+	}
+
+	if err := joinPodNamespaces(ctx, client, container, info); err != nil {
+		return result, err
+	}
+
+	devices, privileged := mapping.MapDevicesToInternalModel(info), isPrivileged(containerModel)
+	if len(devices) > 0 || privileged {
+		log.Debugf("Resolve %d device definitions for container: %s", len(devices), container.ID())
+		specOpts := []oci.SpecOpts{opts.WithDevices(devices)}
+		if privileged {
+			// Resolved on each start so the devices plugged in after create show up in the container
+			specOpts = append(specOpts, opts.WithHostDevices)
+		}
+		if err := container.Update(ctx, opts.WithSpecUpdate(specOpts...)); err != nil {
+			return result, errors.Wrapf(err, "Failed to add devices to container [%s]", container.ID())
+		}
+	}
+
+	task, err := newTaskWithEnv(ctx, container, io.IOCreate, env)
 	if err != nil {
 		return result, errors.Wrapf(err, "Error while creating task for container [%s]", container.ID())
 	}
@@ -281,13 +410,196 @@ func (c *ContainerdClient) StartContainer(namespace, id string, ioSet IOSet) (re
 	}
 	log.Debugf("Task started (pid %d)", task.Pid())
 
-	if err := container.Update(ctx, extensions.IncrementRestart); err != nil {
-		return result, errors.Wrapf(err, "Failed to increment container [%s] start counter", container.ID())
+	stdout := io.Stdout
+	if ioSet.stdoutPiped {
+		// Other container reads the stdout, don't steal it
+		stdout = nil
+	}
+	output := NewOutputBroadcaster(c.replaySize)
+	output.Broadcast(stdout, io.Stderr)
+	c.setStreams(namespace, id, &containerStreams{stdin: io.Stdin, output: output})
+
+	updates = append(updates, extensions.IncrementRestart, extensions.MarkStarted(time.Now()))
+	if err := container.Update(ctx, updates...); err != nil {
+		return result, errors.Wrapf(err, "Failed to update container [%s] lifecycle information", container.ID())
+	}
+
+	info, err = container.Info(ctx)
+	if err != nil {
+		return result, errors.Wrap(err, "Error while fetching container info")
 	}
 
 	return mapping.MapContainerStatusToInternalModel(info, resolveContainerStatus(ctx, container)), nil
 }
 
+// WatchTaskExits records the container exit code and time as soon as the container task exits,
+// so the status is available before the task get deleted. Runs until the done channel get closed
+func (c *ContainerdClient) WatchTaskExits(done <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(c.context)
+	defer cancel()
+	go func() {
+		select {
+		case <-done:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	client, err := c.getConnection("")
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	envelopes, errs := client.Subscribe(ctx, `topic=="/tasks/exit"`)
+	for {
+		select {
+		case envelope := <-envelopes:
+			if err := c.recordExit(envelope); err != nil {
+				log.Warnf("Failed to record container exit status: %s", err)
+			}
+		case err := <-errs:
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Wrap(err, "Task exit event subscription failed")
+		}
+	}
+}
+
+func (c *ContainerdClient) recordExit(envelope *events.Envelope) error {
+	decoded, err := typeurl.UnmarshalAny(envelope.Event)
+	if err != nil {
+		return errors.Wrap(err, "Invalid task exit event")
+	}
+	exit, ok := decoded.(*eventsapi.TaskExit)
+	if !ok || exit.ID != exit.ContainerID {
+		// Exec processes have their own ID
+		return nil
+	}
+
+	ctx, cancel := c.getContext()
+	defer cancel()
+	ctx = namespaces.WithNamespace(ctx, envelope.Namespace)
+
+	client, err := c.getConnection(envelope.Namespace)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	container, err := client.LoadContainer(ctx, exit.ContainerID)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "Failed to load container [%s]", exit.ContainerID)
+	}
+	info, err := container.Info(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Error while fetching container info")
+	}
+	if _, err := extensions.GetLifecycleExtension(info); err != nil {
+		if extensions.IsNotFound(err) {
+			// Not created by eliot
+			return nil
+		}
+		return err
+	}
+
+	log.Debugf("Container [%s] exited with code %d", exit.ContainerID, exit.ExitStatus)
+	return container.Update(ctx, extensions.RecordTermination(exit.ExitStatus, exit.ExitedAt))
+}
+
+func isPrivileged(container model.Container) bool {
+	return container.SecurityContext != nil && container.SecurityContext.Privileged
+}
+
+// newTaskWithEnv creates the container task with additional environment variables.
+// The variables are in the container spec only until the task is created, so they don't stay in the container metadata
+func newTaskWithEnv(ctx context.Context, container containerd.Container, ioCreate cio.Creator, env []string) (containerd.Task, error) {
+	if len(env) == 0 {
+		return container.NewTask(ctx, ioCreate)
+	}
+
+	spec, err := container.Spec(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to read container [%s] spec", container.ID())
+	}
+	original := spec.Process.Env
+
+	if err := container.Update(ctx, opts.WithSpecUpdate(opts.WithEnv(env))); err != nil {
+		return nil, errors.Wrapf(err, "Failed to add environment variables to container [%s]", container.ID())
+	}
+	defer func() {
+		if err := container.Update(ctx, opts.WithSpecUpdate(opts.WithProcessEnv(original))); err != nil {
+			log.Errorf("Failed to remove environment variables from container [%s] spec: %s", container.ID(), err)
+		}
+	}()
+
+	return container.NewTask(ctx, ioCreate)
+}
+
+// OnStart registers hook what gets called before each container start.
+// Must be called before any container get started
+func (c *ContainerdClient) OnStart(hook StartHook) {
+	c.startHooks = append(c.startHooks, hook)
+}
+
+// resolvePodNamespaces resolves the namespaces what the new container shares with the first container in the pod
+func resolvePodNamespaces(ctx context.Context, client *containerd.Client, pod model.Pod) (result extensions.PodNamespaces, err error) {
+	containers, err := client.Containers(namespaceutils.WithNamespace(ctx, pod.Metadata.Namespace))
+	if err != nil {
+		return result, errors.Wrapf(err, "Failed to list containers, cannot resolve pod [%s] namespaces", pod.Metadata.Name)
+	}
+
+	for _, container := range containers {
+		info, err := container.Info(ctx)
+		if err != nil {
+			return result, errors.Wrap(err, "Error while fetching container info")
+		}
+		if mapping.GetPodName(info) == pod.Metadata.Name {
+			return mapping.MapPodNamespacesToContainerdModel(pod, info.ID), nil
+		}
+	}
+	return mapping.MapPodNamespacesToContainerdModel(pod, ""), nil
+}
+
+// joinPodNamespaces updates the container spec to join the namespaces of the first container in the pod
+func joinPodNamespaces(ctx context.Context, client *containerd.Client, container containerd.Container, info containers.Container) error {
+	podNamespaces, err := extensions.GetPodNamespacesExtension(info)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to read container [%s] pod namespaces", info.ID)
+	}
+	if podNamespaces == nil || podNamespaces.ContainerID == "" || len(podNamespaces.Namespaces) == 0 {
+		return nil
+	}
+
+	owner, err := client.LoadContainer(ctx, podNamespaces.ContainerID)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to load container [%s] to join its namespaces", podNamespaces.ContainerID)
+	}
+
+	task, err := owner.Task(ctx, nil)
+	if err != nil {
+		return errors.Wrapf(err, "Container [%s] must be running to join its namespaces", podNamespaces.ContainerID)
+	}
+
+	status, err := task.Status(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to resolve container [%s] task status", podNamespaces.ContainerID)
+	}
+	if status.Status != containerd.Running {
+		return ErrWithMessagef(ErrNotSupported, "Container [%s] must be running to join its namespaces, but it's %s", podNamespaces.ContainerID, status.Status)
+	}
+
+	log.Debugf("Join container [%s] to [%s] namespaces of container [%s]", info.ID, strings.Join(podNamespaces.Namespaces, ","), podNamespaces.ContainerID)
+	if err := container.Update(ctx, opts.WithSpecUpdate(opts.WithJoinNamespaces(task.Pid(), podNamespaces.Namespaces))); err != nil {
+		return errors.Wrapf(err, "Failed to join container [%s] to the pod namespaces", info.ID)
+	}
+	return nil
+}
+
 func ensureTaskStopped(ctx context.Context, task containerd.Task) error {
 	status, err := task.Status(ctx)
 	if err != nil {
@@ -300,6 +612,75 @@ func ensureTaskStopped(ctx context.Context, task containerd.Task) error {
 	return nil
 }
 
+// TerminateContainer stops the container process gracefully but keeps the container so it can be started again.
+// The process gets SIGTERM and if it doesn't exit within the termination grace period, it gets killed
+func (c *ContainerdClient) TerminateContainer(namespace, name string) (result model.ContainerStatus, err error) {
+	ctx, cancel := c.getContext()
+	defer cancel()
+
+	client, connectionErr := c.getConnection(namespace)
+	if connectionErr != nil {
+		return result, connectionErr
+	}
+
+	container, err := client.LoadContainer(ctx, name)
+	if err != nil {
+		return result, errors.Wrapf(err, "Failed to load container [%s], cannot terminate it", name)
+	}
+
+	info, err := container.Info(ctx)
+	if err != nil {
+		return result, errors.Wrap(err, "Error while fetching container info")
+	}
+
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		if !errdefs.IsNotFound(err) {
+			return result, errors.Wrap(err, "Fetching container task returned unexpected error")
+		}
+		return mapping.MapContainerStatusToInternalModel(info, containerd.Status{Status: containerd.Stopped}), nil
+	}
+
+	gracePeriod := mapping.GetTerminationGracePeriod(info)
+	waitCtx, waitCancel := context.WithTimeout(c.context, gracePeriod)
+	defer waitCancel()
+
+	exitc, err := task.Wait(waitCtx)
+	if err != nil {
+		return result, errors.Wrapf(err, "Failed to wait container [%s] task", name)
+	}
+
+	if err := ensureTaskStopped(ctx, task); err != nil {
+		log.Warnf("Failed to kill task with SIGTERM, will next force kill. Error: %s", err)
+	}
+
+	select {
+	case <-exitc:
+		log.Debugf("Container [%s] stopped gracefully", name)
+	case <-waitCtx.Done():
+		log.Warnf("Container [%s] didn't stop within %s grace period, will force kill", name, gracePeriod)
+	}
+
+	// The grace period might have used up the timeout so continue with new context
+	ctx, cancel = c.getContext()
+	defer cancel()
+
+	exitStatus, err := task.Delete(ctx, containerd.WithProcessKill)
+	if err != nil {
+		return result, errors.Wrapf(err, "Container task deletion returned error")
+	}
+
+	if err := container.Update(ctx, extensions.RecordTermination(exitStatus.ExitCode(), exitStatus.ExitTime())); err != nil {
+		return result, errors.Wrapf(err, "Failed to update container [%s] lifecycle information", name)
+	}
+
+	info, err = container.Info(ctx)
+	if err != nil {
+		return result, errors.Wrap(err, "Error while fetching container info")
+	}
+	return mapping.MapContainerStatusToInternalModel(info, containerd.Status{Status: containerd.Stopped}), nil
+}
+
 // StopContainer stops given container
 func (c *ContainerdClient) StopContainer(namespace, name string) (result model.ContainerStatus, err error) {
 	ctx, cancel := c.getContext()
@@ -345,6 +726,12 @@ func (c *ContainerdClient) StopContainer(namespace, name string) (result model.C
 		}
 	}
 
+	if podIP := mapping.GetPodIP(info); podIP != "" && c.network != nil {
+		if err := c.removePodNetworkIfUnused(client, namespace, mapping.GetPodName(info)); err != nil {
+			return result, err
+		}
+	}
+
 	return model.ContainerStatus{
 		ContainerID: info.ID,
 		Image:       info.Image,
@@ -352,6 +739,51 @@ func (c *ContainerdClient) StopContainer(namespace, name string) (result model.C
 	}, nil
 }
 
+func (c *ContainerdClient) ensurePodNetwork(namespace, podName string, ports []model.ContainerPort) (net.IP, error) {
+	ip, err := c.network.EnsurePodNetwork(namespace, podName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to setup pod [%s] network", podName)
+	}
+
+	if err := c.network.EnsurePortMappings(namespace, podName, ip, ports); err != nil {
+		return nil, errors.Wrapf(err, "Failed to setup pod [%s] port mappings", podName)
+	}
+	return ip, nil
+}
+
+// cleanupPodNetwork removes the pod network after failed container create, unless other pod containers use it
+func (c *ContainerdClient) cleanupPodNetwork(client *containerd.Client, pod model.Pod) {
+	if err := c.removePodNetworkIfUnused(client, pod.Metadata.Namespace, pod.Metadata.Name); err != nil {
+		log.Warnf("Failed to cleanup pod [%s] network after failed create: %s", pod.Metadata.Name, err)
+	}
+}
+
+// removePodNetworkIfUnused removes the pod network when the last container in the pod is removed
+func (c *ContainerdClient) removePodNetworkIfUnused(client *containerd.Client, namespace, podName string) error {
+	ctx, cancel := c.getContext()
+	defer cancel()
+
+	containers, err := client.Containers(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "Failed to list containers, cannot resolve is pod [%s] network still in use", podName)
+	}
+
+	for _, container := range containers {
+		info, err := container.Info(ctx)
+		if err != nil {
+			return errors.Wrap(err, "Error while fetching container info")
+		}
+		if mapping.GetPodName(info) == podName {
+			return nil
+		}
+	}
+
+	if err := c.network.RemovePodNetwork(namespace, podName); err != nil {
+		return errors.Wrapf(err, "Failed to remove pod [%s] network", podName)
+	}
+	return nil
+}
+
 // Signal will send a syscall.Signal to the container task process
 func (c *ContainerdClient) Signal(namespace, name string, signal syscall.Signal) error {
 	ctx, cancel := c.getContext()
@@ -376,6 +808,51 @@ func (c *ContainerdClient) Signal(namespace, name string, signal syscall.Signal)
 	return task.Kill(ctx, signal, containerd.WithKillAll)
 }
 
+// GetContainerRoot returns path to the running container root filesystem in the host.
+// The path is the container process root, so it contains also the container mounts
+func (c *ContainerdClient) GetContainerRoot(namespace, name string) (string, error) {
+	ctx, cancel := c.getContext()
+	defer cancel()
+
+	client, err := c.getConnection(namespace)
+	if err != nil {
+		return "", err
+	}
+
+	container, err := client.LoadContainer(ctx, name)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return "", ErrWithMessagef(ErrNotFound, "Container [%s] not found", name)
+		}
+		return "", errors.Wrapf(err, "Failed to load container [%s]", name)
+	}
+
+	task, err := container.Task(ctx, nil)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return "", ErrWithMessagef(ErrNotFound, "Container [%s] is not running", name)
+		}
+		return "", errors.Wrapf(err, "Unable to get task in container [%s]", name)
+	}
+
+	return fmt.Sprintf("/proc/%d/root", task.Pid()), nil
+}
+
+// DialPod opens TCP connection to the port in the pod network.
+// Pods in the bridge network get connected from inside the pod network namespace
+func (c *ContainerdClient) DialPod(namespace, podName string, port int) (net.Conn, error) {
+	pod, err := c.GetPod(namespace, podName)
+	if err != nil {
+		return nil, err
+	}
+
+	address := net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+	if pod.Spec.HostNetwork || c.network == nil {
+		return net.Dial("tcp", address)
+	}
+	return network.DialInNetns(c.network.GetNetnsPath(namespace, podName), "tcp", address)
+}
+
 // PullImage ensures that given container image is pulled to the namespace
 func (c *ContainerdClient) PullImage(namespace, ref string, progress *progress.ImageFetch) error {
 	ctx, cancel := c.getContext()
@@ -523,59 +1000,89 @@ func (c *ContainerdClient) GetContainerTaskStatus(namespace, name string) string
 }
 
 // Exec run command in container and hook IO to the new process
-func (c *ContainerdClient) Exec(namespace, name, id string, args []string, tty bool, io AttachIO) error {
+func (c *ContainerdClient) Exec(namespace, name string, exec ExecProcess, io AttachIO) (uint32, error) {
 	ctx, cancel := c.getContext()
 	defer cancel()
 	ctx = namespaces.WithNamespace(ctx, namespace)
 
 	client, err := c.getConnection(namespace)
 	if err != nil {
-		return errors.Wrapf(err, "Unable to get connection to execute command")
+		return 0, errors.Wrapf(err, "Unable to get connection to execute command")
 	}
 
 	container, err := client.LoadContainer(ctx, name)
 	if err != nil {
-		return errors.Wrapf(err, "Cannot execute command in container [%s] in namespace [%s]", name, namespace)
+		return 0, errors.Wrapf(err, "Cannot execute command in container [%s] in namespace [%s]", name, namespace)
+	}
+
+	info, err := container.Info(ctx)
+	if err != nil {
+		return 0, err
 	}
 
 	spec, err := container.Spec(ctx)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	specOpts := []oci.SpecOpts{oci.WithEnv(exec.Env)}
+	if exec.User != "" {
+		// Resolves the user from the container filesystem
+		specOpts = append(specOpts, oci.WithUser(exec.User))
+	}
+	if err := oci.Compose(specOpts...)(ctx, client, &info, spec); err != nil {
+		return 0, errors.Wrapf(err, "Invalid exec process in container [%s]", name)
 	}
 
 	task, taskErr := container.Task(ctx, nil)
 	if taskErr != nil {
-		return taskErr
+		return 0, taskErr
 	}
 
 	pspec := spec.Process
-	pspec.Terminal = tty
-	pspec.Args = args
+	pspec.Terminal = exec.Tty
+	pspec.Args = exec.Args
+	if exec.WorkingDir != "" {
+		pspec.Cwd = exec.WorkingDir
+	}
 
-	process, err := task.Exec(ctx, id, pspec, cio.NewCreator(
+	process, err := task.Exec(ctx, exec.ID, pspec, cio.NewCreator(
 		cio.WithStreams(io.Stdin, io.Stdout, io.Stderr),
 		cio.WithTerminal,
 	))
 	if err != nil {
-		return err
+		return 0, err
 	}
-	defer process.Delete(ctx)
 
 	status, err := process.Wait(ctx)
 	if err != nil {
-		return err
+		process.Delete(ctx)
+		return 0, err
 	}
 
 	if err := process.Start(ctx); err != nil {
-		return err
+		process.Delete(ctx)
+		return 0, err
+	}
+
+	if exec.Tty {
+		done := make(chan struct{})
+		defer close(done)
+		go forwardResize(ctx, io.Resize, done, process.Resize)
 	}
 
 	exitStatus := <-status
-	return exitStatus.Error()
+	// Delete waits until all output is copied, so the caller receives the whole output before the exit code
+	if _, err := process.Delete(ctx); err != nil {
+		log.Warnf("Failed to delete exec process [%s] in container [%s]: %s", exec.ID, name, err)
+	}
+
+	code, _, err := exitStatus.Result()
+	return code, err
 }
 
 // Attach hook IO to container main process
-func (c *ContainerdClient) Attach(namespace, name string, io AttachIO) error {
+func (c *ContainerdClient) Attach(namespace, name string, attach AttachIO) error {
 	ctx, cancel := c.getContext()
 	defer cancel()
 
@@ -589,9 +1096,7 @@ func (c *ContainerdClient) Attach(namespace, name string, io AttachIO) error {
 		return errors.Wrapf(err, "Cannot attach to container [%s] in namespace [%s]", name, namespace)
 	}
 
-	task, taskErr := container.Task(ctx, cio.NewAttach(
-		cio.WithStreams(io.Stdin, io.Stdout, io.Stderr),
-	))
+	task, taskErr := container.Task(ctx, nil)
 	if taskErr != nil {
 		return taskErr
 	}
@@ -601,6 +1106,131 @@ func (c *ContainerdClient) Attach(namespace, name string, io AttachIO) error {
 		return err
 	}
 
-	exitStatus := <-status
-	return exitStatus.Error()
+	streams, err := c.getStreams(namespace, container)
+	if err != nil {
+		return errors.Wrapf(err, "Cannot attach to container [%s] in namespace [%s]", name, namespace)
+	}
+
+	subscription := streams.output.Subscribe(attach.Stdout, attach.Stderr)
+	defer func() {
+		// Wait the subscription to stop writing before the caller closes the output
+		subscription.Close()
+		<-subscription.Done()
+	}()
+
+	if attach.Stdin != nil {
+		go func() {
+			// Don't close the container stdin when the client stops, other clients can still be attached
+			if _, err := io.Copy(streams.stdin, attach.Stdin); err != nil {
+				log.Debugf("Stopped copying stdin to container [%s]: %s", name, err)
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go forwardResize(ctx, attach.Resize, done, task.Resize)
+
+	select {
+	case exitStatus := <-status:
+		// Send the rest of the output before ending the attach
+		select {
+		case <-subscription.Done():
+		case <-attach.Detach:
+		}
+		return exitStatus.Error()
+	case <-attach.Detach:
+		// The container keeps running because eliotd holds the container stdin open
+		log.Debugf("Client detached from container [%s] in namespace [%s]", name, namespace)
+		return nil
+	}
+}
+
+func getStreamsKey(namespace, id string) string {
+	return namespace + "/" + id
+}
+
+func (c *ContainerdClient) setStreams(namespace, id string, streams *containerStreams) {
+	key := getStreamsKey(namespace, id)
+
+	c.streamsMu.Lock()
+	c.streams[key] = streams
+	c.streamsMu.Unlock()
+
+	go func() {
+		<-streams.output.Done()
+		c.streamsMu.Lock()
+		defer c.streamsMu.Unlock()
+		if c.streams[key] == streams {
+			delete(c.streams, key)
+		}
+	}()
+}
+
+// getStreams returns the container task shared streams. If the task were started before eliotd
+// restart, attaches to the task and starts broadcasting the output
+func (c *ContainerdClient) getStreams(namespace string, container containerd.Container) (*containerStreams, error) {
+	if streams, ok := c.lookupStreams(namespace, container.ID()); ok {
+		return streams, nil
+	}
+
+	c.attachMu.Lock()
+	defer c.attachMu.Unlock()
+
+	// Other request might have attached while waiting the lock
+	if streams, ok := c.lookupStreams(namespace, container.ID()); ok {
+		return streams, nil
+	}
+
+	// The attach must outlive the request so use the client context without timeout
+	ctx := namespaces.WithNamespace(c.context, namespace)
+
+	stdin, stdinWriter := io.Pipe()
+	output := NewOutputBroadcaster(c.replaySize)
+	task, err := container.Task(ctx, cio.NewAttach(
+		cio.WithStreams(stdin, output.Writer(false), output.Writer(true)),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := task.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		<-status
+		if taskIO := task.IO(); taskIO != nil {
+			taskIO.Wait()
+			taskIO.Close()
+		}
+		stdinWriter.Close()
+		output.Close()
+	}()
+
+	streams := &containerStreams{stdin: stdinWriter, output: output}
+	c.setStreams(namespace, container.ID(), streams)
+	return streams, nil
+}
+
+func (c *ContainerdClient) lookupStreams(namespace, id string) (*containerStreams, bool) {
+	c.streamsMu.Lock()
+	defer c.streamsMu.Unlock()
+	streams, ok := c.streams[getStreamsKey(namespace, id)]
+	return streams, ok
+}
+
+// forwardResize resizes the process terminal to match the client terminal until done get closed
+func forwardResize(ctx context.Context, resize <-chan TerminalSize, done <-chan struct{}, fn func(ctx context.Context, w, h uint32) error) {
+	for {
+		select {
+		case size := <-resize:
+			if err := fn(ctx, size.Width, size.Height); err != nil {
+				log.Debugf("Failed to resize terminal to %dx%d: %s", size.Width, size.Height, err)
+			}
+		case <-done:
+			return
+		}
+	}
 }
@@ -0,0 +1,101 @@
+package containerd
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// resolveDevices reads the device information from the host for the device definition
+func resolveDevices(device model.Device) (result []specs.LinuxDevice, err error) {
+	paths, err := resolveDevicePaths(device)
+	if err != nil {
+		return nil, err
+	}
+
+	hostPaths := []string{}
+	for hostPath := range paths {
+		hostPaths = append(hostPaths, hostPath)
+	}
+	sort.Strings(hostPaths)
+
+	for _, hostPath := range hostPaths {
+		linuxDevice, err := deviceFromPath(hostPath, paths[hostPath])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, linuxDevice)
+	}
+	return result, nil
+}
+
+// resolveHostDevices returns all character and block devices under the directory, e.g. /dev.
+// Skips the directories and devices what the runtime sets up itself for the container
+func resolveHostDevices(dir string) (result []specs.LinuxDevice, err error) {
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Device got removed while walking
+				return nil
+			}
+			return err
+		}
+
+		if info.IsDir() {
+			switch info.Name() {
+			case "pts", "shm", "fd", "mqueue", ".lxc", ".lxd-mounts", ".udev":
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if info.Mode()&os.ModeDevice == 0 || path == filepath.Join(dir, "console") {
+			return nil
+		}
+
+		device, err := deviceFromPath(path, path)
+		if err != nil {
+			return nil
+		}
+		result = append(result, device)
+		return nil
+	})
+	return result, err
+}
+
+func deviceFromPath(hostPath, containerPath string) (specs.LinuxDevice, error) {
+	var stat unix.Stat_t
+	if err := unix.Stat(hostPath, &stat); err != nil {
+		return specs.LinuxDevice{}, errors.Wrapf(err, "Cannot read device [%s] information", hostPath)
+	}
+
+	var deviceType string
+	switch stat.Mode & unix.S_IFMT {
+	case unix.S_IFCHR:
+		deviceType = "c"
+	case unix.S_IFBLK:
+		deviceType = "b"
+	default:
+		return specs.LinuxDevice{}, errors.Errorf("Path [%s] is not a character or block device", hostPath)
+	}
+
+	var (
+		devNumber = uint64(stat.Rdev)
+		fileMode  = os.FileMode(stat.Mode &^ unix.S_IFMT)
+		uid       = stat.Uid
+		gid       = stat.Gid
+	)
+	return specs.LinuxDevice{
+		Path:     containerPath,
+		Type:     deviceType,
+		Major:    int64(unix.Major(devNumber)),
+		Minor:    int64(unix.Minor(devNumber)),
+		FileMode: &fileMode,
+		UID:      &uid,
+		GID:      &gid,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package containerd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithDevices(t *testing.T) {
+	spec := &specs.Spec{}
+	opt := WithDevices([]model.Device{
+		{HostPath: "/dev/null", ContainerPath: "/dev/foo", Permissions: "rw"},
+	})
+
+	assert.NoError(t, opt(context.Background(), nil, nil, spec))
+	assert.NoError(t, opt(context.Background(), nil, nil, spec), "Should be able to re-apply")
+
+	assert.Len(t, spec.Linux.Devices, 1)
+	assert.Equal(t, "/dev/foo", spec.Linux.Devices[0].Path)
+	assert.Equal(t, "c", spec.Linux.Devices[0].Type)
+	assert.Equal(t, int64(1), spec.Linux.Devices[0].Major)
+	assert.Equal(t, int64(3), spec.Linux.Devices[0].Minor)
+
+	assert.Len(t, spec.Linux.Resources.Devices, 1)
+	assert.True(t, spec.Linux.Resources.Devices[0].Allow)
+	assert.Equal(t, "rw", spec.Linux.Resources.Devices[0].Access)
+}
+
+func TestWithDevicesResolvesGlobPattern(t *testing.T) {
+	spec := &specs.Spec{}
+	err := WithDevices([]model.Device{{HostPath: "/dev/nul*"}})(context.Background(), nil, nil, spec)
+
+	assert.NoError(t, err)
+	assert.Len(t, spec.Linux.Devices, 1)
+	assert.Equal(t, "/dev/null", spec.Linux.Devices[0].Path)
+	assert.Equal(t, "rwm", spec.Linux.Resources.Devices[0].Access)
+}
+
+func TestWithDevicesReturnErrorIfNotDevice(t *testing.T) {
+	err := WithDevices([]model.Device{{HostPath: "/etc"}})(context.Background(), nil, nil, &specs.Spec{})
+	assert.Error(t, err)
+}
+
+func TestWithHostDevices(t *testing.T) {
+	spec := &specs.Spec{}
+	assert.NoError(t, WithDevices([]model.Device{{HostPath: "/dev/null", ContainerPath: "/dev/foo"}})(context.Background(), nil, nil, spec))
+	assert.NoError(t, WithHostDevices(context.Background(), nil, nil, spec))
+
+	paths := map[string]bool{}
+	for _, device := range spec.Linux.Devices {
+		assert.False(t, paths[device.Path], "Should not add same device twice")
+		paths[device.Path] = true
+	}
+	assert.True(t, paths["/dev/foo"], "Should keep the existing devices")
+	assert.True(t, paths["/dev/null"])
+	assert.False(t, paths["/dev/console"], "Should let the runtime setup the console")
+	for path := range paths {
+		assert.False(t, strings.HasPrefix(path, "/dev/pts/"), "Should skip the pts devices")
+	}
+}
@@ -0,0 +1,21 @@
+// +build !linux
+
+package containerd
+
+import (
+	"runtime"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// resolveDevices is not supported outside Linux
+func resolveDevices(device model.Device) ([]specs.LinuxDevice, error) {
+	return nil, errors.Errorf("Device passthrough is not supported on %s", runtime.GOOS)
+}
+
+// resolveHostDevices is not supported outside Linux
+func resolveHostDevices(dir string) ([]specs.LinuxDevice, error) {
+	return nil, errors.Errorf("Host devices are not supported on %s", runtime.GOOS)
+}
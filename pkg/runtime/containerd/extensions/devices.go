@@ -0,0 +1,63 @@
+package extensions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
+)
+
+var deviceSetExtensionName = "eliot.io.deviceset"
+
+// DeviceSet contains the host devices what should be resolved and added to the container on each start
+type DeviceSet struct {
+	Devices []Device
+	// Requested hardware resources which the devices were allocated from
+	Resources map[string]int
+}
+
+// Device defines single host device (or glob pattern) to pass to the container
+type Device struct {
+	HostPath      string
+	ContainerPath string
+	Permissions   string
+}
+
+// WithDeviceSetExtension appends device set extension data to the container object.
+func WithDeviceSetExtension(devices DeviceSet) containerd.NewContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		any, err := typeurl.MarshalAny(&devices)
+		if err != nil {
+			return err
+		}
+
+		if c.Extensions == nil {
+			c.Extensions = make(map[string]types.Any)
+		}
+		c.Extensions[deviceSetExtensionName] = *any
+		return nil
+	}
+}
+
+// GetDeviceSetExtension returns DeviceSet from container extensions or nil if not defined
+func GetDeviceSetExtension(container containers.Container) (*DeviceSet, error) {
+	extension, ok := container.Extensions[deviceSetExtensionName]
+	if !ok {
+		return nil, nil
+	}
+
+	decoded, err := typeurl.UnmarshalAny(&extension)
+	if err != nil {
+		return nil, err
+	}
+
+	devices, ok := decoded.(*DeviceSet)
+	if !ok {
+		return nil, fmt.Errorf("Failed to decode DeviceSet from container [%s] extensions", container.ID)
+	}
+
+	return devices, err
+}
@@ -0,0 +1,69 @@
+package extensions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
+)
+
+var envVarsExtensionName = "eliot.io.envvars"
+
+// EnvVars contains the container environment variables what get the value from Secret, ConfigMap or node and pod fields.
+// The resolved values are in the spec, these are needed to hide the values and show the source instead
+type EnvVars struct {
+	Vars []EnvVar
+}
+
+// EnvVar defines environment variable value source
+type EnvVar struct {
+	Name            string
+	SecretKeyRef    *KeySelector
+	ConfigMapKeyRef *KeySelector
+	FieldRef        string
+}
+
+// KeySelector selects key from Secret or ConfigMap
+type KeySelector struct {
+	Name string
+	Key  string
+}
+
+// WithEnvVarsExtension appends environment variable sources to the container object.
+func WithEnvVarsExtension(envVars EnvVars) containerd.NewContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		any, err := typeurl.MarshalAny(&envVars)
+		if err != nil {
+			return err
+		}
+
+		if c.Extensions == nil {
+			c.Extensions = make(map[string]types.Any)
+		}
+		c.Extensions[envVarsExtensionName] = *any
+		return nil
+	}
+}
+
+// GetEnvVarsExtension returns EnvVars from container extensions or nil if not defined
+func GetEnvVarsExtension(container containers.Container) (*EnvVars, error) {
+	extension, ok := container.Extensions[envVarsExtensionName]
+	if !ok {
+		return nil, nil
+	}
+
+	decoded, err := typeurl.UnmarshalAny(&extension)
+	if err != nil {
+		return nil, err
+	}
+
+	envVars, ok := decoded.(*EnvVars)
+	if !ok {
+		return nil, fmt.Errorf("Failed to decode EnvVars from container [%s] extensions", container.ID)
+	}
+
+	return envVars, err
+}
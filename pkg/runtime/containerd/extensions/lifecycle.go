@@ -3,6 +3,7 @@ package extensions
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/containers"
@@ -40,6 +41,21 @@ type ContainerLifecycle struct {
 	// If value is zero, assumed that it's not yet created
 	StartCount    int
 	RestartPolicy RestartPolicy
+	// StartedAt is the time when the container task were last time started
+	StartedAt time.Time
+	// Termination describes how the current container task ended, nil if the task is running or never started
+	Termination *Termination
+	// LastTermination describes how the previous container task ended, nil if never terminated
+	LastTermination *Termination
+	// TerminationGracePeriod is the time the container gets to stop after SIGTERM before it gets killed
+	TerminationGracePeriod time.Duration
+}
+
+// Termination contains information about container task what have exited
+type Termination struct {
+	ExitCode   uint32
+	StartedAt  time.Time
+	FinishedAt time.Time
 }
 
 // WithLifecycleExtension is containerd.NewContainerOpts implementation what add lifecycle extension data to the container object.
@@ -47,6 +63,20 @@ func WithLifecycleExtension(ctx context.Context, client *containerd.Client, c *c
 	return updateLifecycleExtension(c, ContainerLifecycle{})
 }
 
+// WithTerminationGracePeriod is containerd.NewContainerOpts implementation what sets the container termination grace period.
+// Must be given after WithLifecycleExtension
+func WithTerminationGracePeriod(period time.Duration) containerd.NewContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		lifecycle, err := GetLifecycleExtension(*c)
+		if err != nil {
+			return errors.Wrapf(err, "Cannot set container termination grace period")
+		}
+		lifecycle.TerminationGracePeriod = period
+
+		return updateLifecycleExtension(c, lifecycle)
+	}
+}
+
 func updateLifecycleExtension(c *containers.Container, lifecycle ContainerLifecycle) error {
 	any, err := typeurl.MarshalAny(&lifecycle)
 	if err != nil {
@@ -73,6 +103,42 @@ func IncrementRestart(ctx context.Context, client *containerd.Client, c *contain
 	return updateLifecycleExtension(c, lifecycle)
 }
 
+// MarkStarted is containerd.UpdateContainerOpts implementation what sets the container start time
+// and moves the termination of the previous task to the last termination
+func MarkStarted(startedAt time.Time) containerd.UpdateContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		lifecycle, err := GetLifecycleExtension(*c)
+		if err != nil {
+			return errors.Wrapf(err, "Cannot update container start time")
+		}
+		lifecycle.StartedAt = startedAt
+		lifecycle.LastTermination, lifecycle.Termination = lifecycle.Termination, nil
+
+		return updateLifecycleExtension(c, lifecycle)
+	}
+}
+
+// RecordTermination is containerd.UpdateContainerOpts implementation what stores the exit status of the current task.
+// Exit what happened before the current task were started gets ignored
+func RecordTermination(exitCode uint32, finishedAt time.Time) containerd.UpdateContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		lifecycle, err := GetLifecycleExtension(*c)
+		if err != nil {
+			return errors.Wrapf(err, "Cannot record container termination")
+		}
+		if finishedAt.Before(lifecycle.StartedAt) {
+			return nil
+		}
+		lifecycle.Termination = &Termination{
+			ExitCode:   exitCode,
+			StartedAt:  lifecycle.StartedAt,
+			FinishedAt: finishedAt,
+		}
+
+		return updateLifecycleExtension(c, lifecycle)
+	}
+}
+
 // GetLifecycleExtension returns ContainerLifecycle from container extensions or nil if not defined
 func GetLifecycleExtension(c containers.Container) (ContainerLifecycle, error) {
 	extension, ok := c.Extensions[lifecycleExtensionName]
@@ -4,6 +4,7 @@ import (
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/typeurl"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/types"
 	"github.com/stretchr/testify/assert"
@@ -28,3 +29,27 @@ func TestGetLifecycleExtensionReturnNotFoundErr(t *testing.T) {
 	_, err := GetLifecycleExtension(containers.Container{})
 	assert.True(t, IsNotFound(err))
 }
+
+func TestRecordTermination(t *testing.T) {
+	startedAt := time.Unix(100, 0)
+	finishedAt := time.Unix(200, 0)
+	container := &containers.Container{}
+	assert.NoError(t, WithLifecycleExtension(nil, nil, container))
+	assert.NoError(t, MarkStarted(startedAt)(nil, nil, container))
+	assert.NoError(t, RecordTermination(1, finishedAt)(nil, nil, container))
+
+	result, err := GetLifecycleExtension(*container)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(1), result.Termination.ExitCode)
+	assert.True(t, startedAt.Equal(result.Termination.StartedAt))
+	assert.True(t, finishedAt.Equal(result.Termination.FinishedAt))
+	assert.Nil(t, result.LastTermination, "should not have previous termination before restart")
+
+	assert.NoError(t, MarkStarted(time.Unix(300, 0))(nil, nil, container))
+	assert.NoError(t, RecordTermination(2, finishedAt)(nil, nil, container))
+
+	result, err = GetLifecycleExtension(*container)
+	assert.NoError(t, err)
+	assert.Nil(t, result.Termination, "should ignore exit of the previous task")
+	assert.Equal(t, uint32(1), result.LastTermination.ExitCode)
+}
@@ -0,0 +1,63 @@
+package extensions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
+)
+
+var networkExtensionName = "eliot.io.network"
+
+// Network contains the pod bridge network information of the container
+type Network struct {
+	// IP address of the pod in the bridge network
+	IP    string
+	Ports []Port
+}
+
+// Port defines single port mapping from the node to the container
+type Port struct {
+	ContainerPort int
+	HostPort      int
+	Protocol      string
+}
+
+// WithNetworkExtension appends network extension data to the container object.
+func WithNetworkExtension(network Network) containerd.NewContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		any, err := typeurl.MarshalAny(&network)
+		if err != nil {
+			return err
+		}
+
+		if c.Extensions == nil {
+			c.Extensions = make(map[string]types.Any)
+		}
+		c.Extensions[networkExtensionName] = *any
+		return nil
+	}
+}
+
+// GetNetworkExtension returns Network from container extensions or nil if not defined
+func GetNetworkExtension(container containers.Container) (*Network, error) {
+	extension, ok := container.Extensions[networkExtensionName]
+	if !ok {
+		return nil, nil
+	}
+
+	decoded, err := typeurl.UnmarshalAny(&extension)
+	if err != nil {
+		return nil, err
+	}
+
+	network, ok := decoded.(*Network)
+	if !ok {
+		return nil, fmt.Errorf("Failed to decode Network from container [%s] extensions", container.ID)
+	}
+
+	return network, err
+}
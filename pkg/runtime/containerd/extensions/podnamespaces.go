@@ -0,0 +1,59 @@
+package extensions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
+)
+
+var podNamespacesExtensionName = "eliot.io.podnamespaces"
+
+// PodNamespaces defines the Linux namespaces what the container shares with the other pod containers
+type PodNamespaces struct {
+	// Container which namespaces to join when the container starts, empty if this is the first container in the pod
+	ContainerID string
+	// Namespace types to share. E.g. ipc, uts, pid
+	Namespaces []string
+	// Is the pod sharing single process namespace
+	ShareProcessNamespace bool
+}
+
+// WithPodNamespacesExtension appends pod namespaces extension data to the container object.
+func WithPodNamespacesExtension(namespaces PodNamespaces) containerd.NewContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		any, err := typeurl.MarshalAny(&namespaces)
+		if err != nil {
+			return err
+		}
+
+		if c.Extensions == nil {
+			c.Extensions = make(map[string]types.Any)
+		}
+		c.Extensions[podNamespacesExtensionName] = *any
+		return nil
+	}
+}
+
+// GetPodNamespacesExtension returns PodNamespaces from container extensions or nil if not defined
+func GetPodNamespacesExtension(container containers.Container) (*PodNamespaces, error) {
+	extension, ok := container.Extensions[podNamespacesExtensionName]
+	if !ok {
+		return nil, nil
+	}
+
+	decoded, err := typeurl.UnmarshalAny(&extension)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces, ok := decoded.(*PodNamespaces)
+	if !ok {
+		return nil, fmt.Errorf("Failed to decode PodNamespaces from container [%s] extensions", container.ID)
+	}
+
+	return namespaces, err
+}
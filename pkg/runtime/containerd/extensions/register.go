@@ -14,4 +14,9 @@ func init() {
 	major := strconv.Itoa(versionMajor)
 	typeurl.Register(&PipeSet{}, prefix, "containerd/extensions", major, "PipeSet")
 	typeurl.Register(&ContainerLifecycle{}, prefix, "containerd/extensions", major, "ContainerLifecycle")
+	typeurl.Register(&DeviceSet{}, prefix, "containerd/extensions", major, "DeviceSet")
+	typeurl.Register(&SecurityContext{}, prefix, "containerd/extensions", major, "SecurityContext")
+	typeurl.Register(&Network{}, prefix, "containerd/extensions", major, "Network")
+	typeurl.Register(&PodNamespaces{}, prefix, "containerd/extensions", major, "PodNamespaces")
+	typeurl.Register(&EnvVars{}, prefix, "containerd/extensions", major, "EnvVars")
 }
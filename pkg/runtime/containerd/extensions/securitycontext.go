@@ -0,0 +1,61 @@
+package extensions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/typeurl"
+	"github.com/gogo/protobuf/types"
+)
+
+var securityContextExtensionName = "eliot.io.securitycontext"
+
+// SecurityContext contains the security settings what were applied to the container spec
+type SecurityContext struct {
+	User                   string
+	Group                  string
+	AddCapabilities        []string
+	DropCapabilities       []string
+	Privileged             bool
+	ReadOnlyRootFilesystem bool
+	NoNewPrivileges        bool
+	SeccompProfile         string
+}
+
+// WithSecurityContextExtension appends security context extension data to the container object.
+func WithSecurityContextExtension(securityContext SecurityContext) containerd.NewContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		any, err := typeurl.MarshalAny(&securityContext)
+		if err != nil {
+			return err
+		}
+
+		if c.Extensions == nil {
+			c.Extensions = make(map[string]types.Any)
+		}
+		c.Extensions[securityContextExtensionName] = *any
+		return nil
+	}
+}
+
+// GetSecurityContextExtension returns SecurityContext from container extensions or nil if not defined
+func GetSecurityContextExtension(container containers.Container) (*SecurityContext, error) {
+	extension, ok := container.Extensions[securityContextExtensionName]
+	if !ok {
+		return nil, nil
+	}
+
+	decoded, err := typeurl.UnmarshalAny(&extension)
+	if err != nil {
+		return nil, err
+	}
+
+	securityContext, ok := decoded.(*SecurityContext)
+	if !ok {
+		return nil, fmt.Errorf("Failed to decode SecurityContext from container [%s] extensions", container.ID)
+	}
+
+	return securityContext, err
+}
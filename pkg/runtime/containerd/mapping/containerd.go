@@ -2,6 +2,8 @@ package mapping
 
 import (
 	"encoding/json"
+	"strings"
+	"time"
 
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	log "github.com/sirupsen/logrus"
@@ -18,23 +20,34 @@ func GetPodName(container containers.Container) string {
 	podName := labels.getPodName()
 	if podName == "" {
 		// container is not eliot managed container so add it under 'system' pod in namespace 'default'
-		podName = "system"
+		podName = model.SystemPodName
 	}
 	return podName
 }
 
-// InitialisePodModel creates new Pod struct with name and namespace metadata
+// InitialisePodModel creates new Pod struct with metadata
 func InitialisePodModel(container containers.Container, namespace, name, hostname string) model.Pod {
+	labels := ContainerLabels(container.Labels)
 	return model.Pod{
-		Metadata: model.NewMetadata(namespace, name),
+		Metadata: model.Metadata{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      labels.getPodLabels(),
+			Annotations: labels.getPodAnnotations(),
+		},
 		Spec: model.PodSpec{
-			Containers:    []model.Container{},
-			HostNetwork:   !haveNamespace(container, specs.NetworkNamespace),
-			HostPID:       !haveNamespace(container, specs.PIDNamespace),
-			RestartPolicy: getRestartPolicy(container),
+			Containers:                    []model.Container{},
+			HostNetwork:                   !haveNamespace(container, specs.NetworkNamespace),
+			HostPID:                       !haveNamespace(container, specs.PIDNamespace),
+			RestartPolicy:                 getRestartPolicy(container),
+			ShareProcessNamespace:         isSharingProcessNamespace(container),
+			Hostname:                      getHostname(container),
+			NodeSelector:                  labels.getNodeSelector(),
+			TerminationGracePeriodSeconds: getTerminationGracePeriodSeconds(container),
 		},
 		Status: model.PodStatus{
 			Hostname:          hostname,
+			PodIP:             GetPodIP(container),
 			ContainerStatuses: []model.ContainerStatus{},
 		},
 	}
@@ -52,14 +65,19 @@ func MapContainersToInternalModel(containers []containers.Container) (result []m
 func MapContainerToInternalModel(container containers.Container) model.Container {
 	labels := ContainerLabels(container.Labels)
 	return model.Container{
-		Name:       labels.getContainerName(),
-		Image:      container.Image,
-		Tty:        RequireTty(container),
-		Args:       processArgs(container),
-		Env:        processEnv(container),
-		WorkingDir: processWorkingDir(container),
-		Pipe:       mapPipeToInternalModel(container),
-		Mounts:     mapMountsToInternalModel(container),
+		Name:            labels.getContainerName(),
+		Image:           container.Image,
+		Tty:             RequireTty(container),
+		Args:            processArgs(container),
+		Env:             processEnv(container),
+		EnvVars:         MapEnvVarsToInternalModel(container),
+		WorkingDir:      processWorkingDir(container),
+		Pipe:            mapPipeToInternalModel(container),
+		Mounts:          mapMountsToInternalModel(container),
+		Devices:         MapDevicesToInternalModel(container),
+		Resources:       mapResourcesToInternalModel(container),
+		SecurityContext: mapSecurityContextToInternalModel(container),
+		Ports:           MapPortsToInternalModel(container),
 	}
 }
 
@@ -100,6 +118,128 @@ func mapPipeToInternalModel(container containers.Container) *model.PipeSet {
 	}
 }
 
+// MapDevicesToInternalModel returns the device definitions of the container
+func MapDevicesToInternalModel(container containers.Container) (result []model.Device) {
+	devices, err := extensions.GetDeviceSetExtension(container)
+	if err != nil {
+		log.Errorf("Failed to read DeviceSet extension from container [%s]: %s", container.ID, err)
+	}
+	if devices == nil {
+		return nil
+	}
+
+	for _, device := range devices.Devices {
+		result = append(result, model.Device{
+			HostPath:      device.HostPath,
+			ContainerPath: device.ContainerPath,
+			Permissions:   device.Permissions,
+		})
+	}
+	return result
+}
+
+func mapSecurityContextToInternalModel(container containers.Container) *model.SecurityContext {
+	securityContext, err := extensions.GetSecurityContextExtension(container)
+	if err != nil {
+		log.Errorf("Failed to read SecurityContext extension from container [%s]: %s", container.ID, err)
+	}
+	if securityContext == nil {
+		return nil
+	}
+
+	result := &model.SecurityContext{
+		User:                   securityContext.User,
+		Group:                  securityContext.Group,
+		Privileged:             securityContext.Privileged,
+		ReadOnlyRootFilesystem: securityContext.ReadOnlyRootFilesystem,
+		NoNewPrivileges:        securityContext.NoNewPrivileges,
+		SeccompProfile:         securityContext.SeccompProfile,
+	}
+	if len(securityContext.AddCapabilities) > 0 || len(securityContext.DropCapabilities) > 0 {
+		result.Capabilities = &model.Capabilities{
+			Add:  securityContext.AddCapabilities,
+			Drop: securityContext.DropCapabilities,
+		}
+	}
+	return result
+}
+
+// MapPortsToInternalModel returns the port mappings of the container
+func MapPortsToInternalModel(container containers.Container) (result []model.ContainerPort) {
+	network, err := extensions.GetNetworkExtension(container)
+	if err != nil {
+		log.Errorf("Failed to read Network extension from container [%s]: %s", container.ID, err)
+	}
+	if network == nil {
+		return nil
+	}
+
+	for _, port := range network.Ports {
+		result = append(result, model.ContainerPort{
+			ContainerPort: port.ContainerPort,
+			HostPort:      port.HostPort,
+			Protocol:      port.Protocol,
+		})
+	}
+	return result
+}
+
+// MapEnvVarsToInternalModel returns the environment variable sources of the container
+func MapEnvVarsToInternalModel(container containers.Container) (result []model.EnvVar) {
+	envVars, err := extensions.GetEnvVarsExtension(container)
+	if err != nil {
+		log.Errorf("Failed to read EnvVars extension from container [%s]: %s", container.ID, err)
+	}
+	if envVars == nil {
+		return nil
+	}
+
+	for _, envVar := range envVars.Vars {
+		result = append(result, model.EnvVar{
+			Name: envVar.Name,
+			ValueFrom: model.EnvVarSource{
+				SecretKeyRef:    mapKeySelectorToInternalModel(envVar.SecretKeyRef),
+				ConfigMapKeyRef: mapKeySelectorToInternalModel(envVar.ConfigMapKeyRef),
+				FieldRef:        envVar.FieldRef,
+			},
+		})
+	}
+	return result
+}
+
+func mapKeySelectorToInternalModel(selector *extensions.KeySelector) *model.KeySelector {
+	if selector == nil {
+		return nil
+	}
+	return &model.KeySelector{
+		Name: selector.Name,
+		Key:  selector.Key,
+	}
+}
+
+// GetPodIP returns the pod IP address in the bridge network or empty string if the container uses host network
+func GetPodIP(container containers.Container) string {
+	network, err := extensions.GetNetworkExtension(container)
+	if err != nil {
+		log.Errorf("Failed to read Network extension from container [%s]: %s", container.ID, err)
+	}
+	if network == nil {
+		return ""
+	}
+	return network.IP
+}
+
+func mapResourcesToInternalModel(container containers.Container) map[string]int {
+	devices, err := extensions.GetDeviceSetExtension(container)
+	if err != nil {
+		log.Errorf("Failed to read DeviceSet extension from container [%s]: %s", container.ID, err)
+	}
+	if devices == nil {
+		return nil
+	}
+	return devices.Resources
+}
+
 func processArgs(container containers.Container) []string {
 	spec, err := getSpec(container)
 	if err != nil {
@@ -117,7 +257,28 @@ func processEnv(container containers.Container) []string {
 		return nil
 	}
 
-	return spec.Process.Env
+	return withoutEnvVars(spec.Process.Env, MapEnvVarsToInternalModel(container))
+}
+
+// withoutEnvVars filters out the environment variables what get the value from
+// Secret or ConfigMap, so the resolved values don't leak to the clients
+func withoutEnvVars(env []string, envVars []model.EnvVar) (result []string) {
+	if len(envVars) == 0 {
+		return env
+	}
+
+	hidden := map[string]bool{}
+	for _, envVar := range envVars {
+		hidden[envVar.Name] = true
+	}
+
+	for _, value := range env {
+		name := strings.SplitN(value, "=", 2)[0]
+		if !hidden[name] {
+			result = append(result, value)
+		}
+	}
+	return result
 }
 
 func processWorkingDir(container containers.Container) string {
@@ -151,12 +312,61 @@ func mapMountsToInternalModel(container containers.Container) (result []model.Mo
 // MapContainerStatusToInternalModel maps containerd model to internal container status model
 func MapContainerStatusToInternalModel(container containers.Container, status containerd.Status) model.ContainerStatus {
 	labels := ContainerLabels(container.Labels)
-	return model.ContainerStatus{
-		ContainerID:  container.ID,
-		Name:         labels.getContainerName(),
-		Image:        container.Image,
-		State:        mapContainerStatus(status),
-		RestartCount: getRestartCount(container),
+	lifecycle := getLifecycle(container)
+	result := model.ContainerStatus{
+		ContainerID:          container.ID,
+		Name:                 labels.getContainerName(),
+		Image:                container.Image,
+		State:                mapContainerStatus(status),
+		RestartCount:         getRestartCount(container),
+		StartedAt:            lifecycle.StartedAt,
+		LastTerminationState: mapTerminationToInternalModel(lifecycle.LastTermination),
+	}
+
+	if status.Status == containerd.Stopped {
+		result.ExitCode = int(status.ExitStatus)
+		result.FinishedAt = status.ExitTime
+		result.Reason = resolveTerminationReason(status.ExitStatus)
+	} else if status.Status != containerd.Running && lifecycle.Termination != nil {
+		// The task have been deleted, e.g. the container got terminated or the node restarted
+		result.ExitCode = int(lifecycle.Termination.ExitCode)
+		result.FinishedAt = lifecycle.Termination.FinishedAt
+		result.Reason = resolveTerminationReason(lifecycle.Termination.ExitCode)
+	}
+	return result
+}
+
+func getLifecycle(container containers.Container) extensions.ContainerLifecycle {
+	lifecycle, err := extensions.GetLifecycleExtension(container)
+	if err != nil && !extensions.IsNotFound(err) {
+		log.Warnf("Error while resolving container lifecycle information, fallback to empty: %s", err)
+	}
+	return lifecycle
+}
+
+func mapTerminationToInternalModel(termination *extensions.Termination) *model.ContainerTerminated {
+	if termination == nil {
+		return nil
+	}
+	return &model.ContainerTerminated{
+		ExitCode:   int(termination.ExitCode),
+		StartedAt:  termination.StartedAt,
+		FinishedAt: termination.FinishedAt,
+		Reason:     resolveTerminationReason(termination.ExitCode),
+	}
+}
+
+// resolveTerminationReason returns short explanation for the process exit code
+func resolveTerminationReason(exitCode uint32) string {
+	switch exitCode {
+	case 0:
+		return "Completed"
+	case 128 + 9:
+		return "Killed"
+	case 128 + 15:
+		return "Terminated"
+	default:
+		return "Error"
 	}
 }
 
@@ -187,6 +397,26 @@ func haveNamespace(container containers.Container, namespace specs.LinuxNamespac
 	return false
 }
 
+func isSharingProcessNamespace(container containers.Container) bool {
+	namespaces, err := extensions.GetPodNamespacesExtension(container)
+	if err != nil {
+		log.Errorf("Failed to read PodNamespaces extension from container [%s]: %s", container.ID, err)
+	}
+	if namespaces == nil {
+		return false
+	}
+	return namespaces.ShareProcessNamespace
+}
+
+func getHostname(container containers.Container) string {
+	spec, err := getSpec(container)
+	if err != nil {
+		log.Fatalf("Cannot read container spec to resolve hostname: %s", err)
+		return ""
+	}
+	return spec.Hostname
+}
+
 func getRestartPolicy(container containers.Container) string {
 	lifecycle, err := extensions.GetLifecycleExtension(container)
 	if err != nil && !extensions.IsNotFound(err) {
@@ -196,6 +426,21 @@ func getRestartPolicy(container containers.Container) string {
 	return lifecycle.RestartPolicy.String()
 }
 
+func getTerminationGracePeriodSeconds(container containers.Container) int64 {
+	lifecycle, err := extensions.GetLifecycleExtension(container)
+	if err != nil && !extensions.IsNotFound(err) {
+		log.Warnf("Error while resolving container termination grace period, fallback to default: %s", err)
+	}
+
+	return int64(lifecycle.TerminationGracePeriod / time.Second)
+}
+
+// GetTerminationGracePeriod returns the time the container gets to stop after SIGTERM before it gets killed
+func GetTerminationGracePeriod(container containers.Container) time.Duration {
+	spec := model.PodSpec{TerminationGracePeriodSeconds: getTerminationGracePeriodSeconds(container)}
+	return spec.GetTerminationGracePeriod()
+}
+
 func mapContainerStatus(status containerd.Status) string {
 	if status.Status == "" {
 		return string(containerd.Unknown)
@@ -0,0 +1,79 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/containers"
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime/containerd/extensions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapContainerStatusToInternalModel(t *testing.T) {
+	exitTime := time.Unix(100, 0)
+	result := MapContainerStatusToInternalModel(containers.Container{ID: "foo"}, containerd.Status{
+		Status:     containerd.Stopped,
+		ExitStatus: 1,
+		ExitTime:   exitTime,
+	})
+
+	assert.Equal(t, "foo", result.ContainerID)
+	assert.Equal(t, 1, result.ExitCode)
+	assert.Equal(t, "Error", result.Reason)
+	assert.Equal(t, exitTime, result.FinishedAt)
+	assert.Nil(t, result.LastTerminationState)
+}
+
+func TestMapContainerStatusLastTerminationIsPreviousTask(t *testing.T) {
+	container := &containers.Container{ID: "foo"}
+	assert.NoError(t, extensions.WithLifecycleExtension(nil, nil, container))
+	assert.NoError(t, extensions.MarkStarted(time.Unix(100, 0))(nil, nil, container))
+	assert.NoError(t, extensions.RecordTermination(1, time.Unix(200, 0))(nil, nil, container))
+
+	result := MapContainerStatusToInternalModel(*container, containerd.Status{
+		Status:     containerd.Stopped,
+		ExitStatus: 1,
+		ExitTime:   time.Unix(200, 0),
+	})
+	assert.Equal(t, 1, result.ExitCode)
+	assert.Nil(t, result.LastTerminationState, "should not duplicate the current exit")
+
+	assert.NoError(t, extensions.MarkStarted(time.Unix(300, 0))(nil, nil, container))
+	assert.NoError(t, extensions.RecordTermination(0, time.Unix(400, 0))(nil, nil, container))
+
+	// The task have been deleted
+	result = MapContainerStatusToInternalModel(*container, containerd.Status{})
+	assert.Equal(t, 0, result.ExitCode)
+	assert.Equal(t, "Completed", result.Reason)
+	assert.True(t, time.Unix(400, 0).Equal(result.FinishedAt))
+	assert.Equal(t, 1, result.LastTerminationState.ExitCode)
+	assert.True(t, time.Unix(200, 0).Equal(result.LastTerminationState.FinishedAt))
+}
+
+func TestMapRunningContainerStatusDontHaveExitCode(t *testing.T) {
+	result := MapContainerStatusToInternalModel(containers.Container{ID: "foo"}, containerd.Status{
+		Status: containerd.Running,
+	})
+
+	assert.Equal(t, "running", result.State)
+	assert.Equal(t, "", result.Reason)
+	assert.True(t, result.FinishedAt.IsZero())
+}
+
+func TestResolveTerminationReason(t *testing.T) {
+	assert.Equal(t, "Completed", resolveTerminationReason(0))
+	assert.Equal(t, "Error", resolveTerminationReason(2))
+	assert.Equal(t, "Killed", resolveTerminationReason(137))
+	assert.Equal(t, "Terminated", resolveTerminationReason(143))
+}
+
+func TestWithoutEnvVarsHidesResolvedValues(t *testing.T) {
+	result := withoutEnvVars(
+		[]string{"PATH=/bin", "TOKEN=secret", "FOO=bar"},
+		[]model.EnvVar{{Name: "TOKEN", ValueFrom: model.EnvVarSource{SecretKeyRef: &model.KeySelector{Name: "api", Key: "token"}}}},
+	)
+
+	assert.Equal(t, []string{"PATH=/bin", "FOO=bar"}, result)
+}
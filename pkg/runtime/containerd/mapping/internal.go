@@ -26,3 +26,91 @@ func MapPipeToContainerdModel(pipe model.PipeSet) extensions.PipeSet {
 		},
 	}
 }
+
+// MapDeviceSetToContainerdModel maps model.Container devices and resources to containerd extension DeviceSet
+func MapDeviceSetToContainerdModel(container model.Container) extensions.DeviceSet {
+	result := extensions.DeviceSet{
+		Resources: container.Resources,
+	}
+	for _, device := range container.Devices {
+		result.Devices = append(result.Devices, extensions.Device{
+			HostPath:      device.HostPath,
+			ContainerPath: device.ContainerPath,
+			Permissions:   device.Permissions,
+		})
+	}
+	return result
+}
+
+// MapSecurityContextToContainerdModel maps model.SecurityContext to containerd extension SecurityContext
+func MapSecurityContextToContainerdModel(securityContext model.SecurityContext) extensions.SecurityContext {
+	result := extensions.SecurityContext{
+		User:                   securityContext.User,
+		Group:                  securityContext.Group,
+		Privileged:             securityContext.Privileged,
+		ReadOnlyRootFilesystem: securityContext.ReadOnlyRootFilesystem,
+		NoNewPrivileges:        securityContext.NoNewPrivileges,
+		SeccompProfile:         securityContext.SeccompProfile,
+	}
+	if securityContext.Capabilities != nil {
+		result.AddCapabilities = securityContext.Capabilities.Add
+		result.DropCapabilities = securityContext.Capabilities.Drop
+	}
+	return result
+}
+
+// MapNetworkToContainerdModel maps pod IP and model.Container ports to containerd extension Network
+func MapNetworkToContainerdModel(ip string, container model.Container) extensions.Network {
+	result := extensions.Network{
+		IP: ip,
+	}
+	for _, port := range container.Ports {
+		result.Ports = append(result.Ports, extensions.Port{
+			ContainerPort: port.ContainerPort,
+			HostPort:      port.HostPort,
+			Protocol:      port.Protocol,
+		})
+	}
+	return result
+}
+
+// MapPodNamespacesToContainerdModel resolves the namespaces what the pod containers share.
+// The containerID is the container which namespaces to join, empty if the container is the first one in the pod
+func MapPodNamespacesToContainerdModel(pod model.Pod, containerID string) extensions.PodNamespaces {
+	result := extensions.PodNamespaces{
+		ContainerID:           containerID,
+		Namespaces:            []string{string(specs.IPCNamespace)},
+		ShareProcessNamespace: pod.Spec.ShareProcessNamespace,
+	}
+	if pod.Spec.Hostname != "" {
+		result.Namespaces = append(result.Namespaces, string(specs.UTSNamespace))
+	}
+	if pod.Spec.ShareProcessNamespace && !pod.Spec.HostPID {
+		result.Namespaces = append(result.Namespaces, string(specs.PIDNamespace))
+	}
+	return result
+}
+
+// MapEnvVarsToContainerdModel maps model.EnvVar sources to containerd extension EnvVars
+func MapEnvVarsToContainerdModel(envVars []model.EnvVar) extensions.EnvVars {
+	result := extensions.EnvVars{}
+	for _, envVar := range envVars {
+		result.Vars = append(result.Vars, extensions.EnvVar{
+			Name:            envVar.Name,
+			SecretKeyRef:    mapKeySelectorToContainerdModel(envVar.ValueFrom.SecretKeyRef),
+			ConfigMapKeyRef: mapKeySelectorToContainerdModel(envVar.ValueFrom.ConfigMapKeyRef),
+			FieldRef:        envVar.ValueFrom.FieldRef,
+		})
+	}
+	return result
+}
+
+func mapKeySelectorToContainerdModel(selector *model.KeySelector) *extensions.KeySelector {
+	if selector == nil {
+		return nil
+	}
+	return &extensions.KeySelector{
+		Name: selector.Name,
+		Key:  selector.Key,
+	}
+}
@@ -0,0 +1,28 @@
+package mapping
+
+import (
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapPodNamespacesToContainerdModel(t *testing.T) {
+	result := MapPodNamespacesToContainerdModel(model.Pod{
+		Spec: model.PodSpec{
+			Hostname:              "my-pod",
+			ShareProcessNamespace: true,
+		},
+	}, "first")
+
+	assert.Equal(t, "first", result.ContainerID)
+	assert.Equal(t, []string{"ipc", "uts", "pid"}, result.Namespaces)
+	assert.True(t, result.ShareProcessNamespace)
+}
+
+func TestMapPodNamespacesToContainerdModelShareOnlyIPCByDefault(t *testing.T) {
+	result := MapPodNamespacesToContainerdModel(model.Pod{}, "")
+
+	assert.Equal(t, "", result.ContainerID)
+	assert.Equal(t, []string{"ipc"}, result.Namespaces)
+}
@@ -2,6 +2,7 @@ package mapping
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/ernoaapa/eliot/pkg/model"
 )
@@ -10,6 +11,10 @@ var (
 	labelPrefix        = "io.eliot"
 	podNameLabel       = "pod.name"
 	containerNameLabel = "container.name"
+	// Pod labels and annotations are stored with prefix, e.g. io.eliot.pod.label.app
+	podLabelPrefix      = "pod.label."
+	podAnnotationPrefix = "pod.annotation."
+	nodeSelectorPrefix  = "pod.nodeSelector."
 )
 
 // ContainerLabels is helper type for managing container labels
@@ -23,10 +28,36 @@ func (l ContainerLabels) getContainerName() string {
 	return l.getValue(containerNameLabel)
 }
 
+func (l ContainerLabels) getPodLabels() map[string]string {
+	return l.getValuesWithPrefix(podLabelPrefix)
+}
+
+func (l ContainerLabels) getPodAnnotations() map[string]string {
+	return l.getValuesWithPrefix(podAnnotationPrefix)
+}
+
+func (l ContainerLabels) getNodeSelector() map[string]string {
+	return l.getValuesWithPrefix(nodeSelectorPrefix)
+}
+
 func (l ContainerLabels) getValue(key string) string {
 	return l[buildLabelKeyFor(key)]
 }
 
+func (l ContainerLabels) getValuesWithPrefix(prefix string) map[string]string {
+	keyPrefix := buildLabelKeyFor(prefix)
+	values := map[string]string{}
+	for key, value := range l {
+		if strings.HasPrefix(key, keyPrefix) {
+			values[strings.TrimPrefix(key, keyPrefix)] = value
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return values
+}
+
 func buildLabelKeyFor(name string) string {
 	return fmt.Sprintf("%s.%s", labelPrefix, name)
 }
@@ -36,5 +67,14 @@ func NewLabels(pod model.Pod, container model.Container) ContainerLabels {
 	labels := make(map[string]string)
 	labels[buildLabelKeyFor(podNameLabel)] = pod.Metadata.Name
 	labels[buildLabelKeyFor(containerNameLabel)] = container.Name
+	for key, value := range pod.Metadata.Labels {
+		labels[buildLabelKeyFor(podLabelPrefix+key)] = value
+	}
+	for key, value := range pod.Metadata.Annotations {
+		labels[buildLabelKeyFor(podAnnotationPrefix+key)] = value
+	}
+	for key, value := range pod.Spec.NodeSelector {
+		labels[buildLabelKeyFor(nodeSelectorPrefix+key)] = value
+	}
 	return labels
 }
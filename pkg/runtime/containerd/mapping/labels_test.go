@@ -21,3 +21,24 @@ func TestNewContainerLabels(t *testing.T) {
 
 	assert.Equal(t, "my-pod", result["io.eliot.pod.name"])
 }
+
+func TestPodLabelsAndAnnotations(t *testing.T) {
+	pod := model.Pod{
+		Metadata: model.Metadata{
+			Name:        "my-pod",
+			Namespace:   "my-namespace",
+			Labels:      map[string]string{"app": "sensor", "eliot.io/location": "office"},
+			Annotations: map[string]string{"description": "Reads temperature"},
+		},
+		Spec: model.PodSpec{
+			NodeSelector: map[string]string{"camera": "true"},
+		},
+	}
+	result := NewLabels(pod, model.Container{Name: "my-container"})
+
+	assert.Equal(t, "sensor", result["io.eliot.pod.label.app"])
+	assert.Equal(t, pod.Metadata.Labels, result.getPodLabels())
+	assert.Equal(t, pod.Metadata.Annotations, result.getPodAnnotations())
+	assert.Equal(t, pod.Spec.NodeSelector, result.getNodeSelector())
+	assert.Nil(t, ContainerLabels{}.getPodLabels())
+}
@@ -2,13 +2,19 @@ package containerd
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
 	"strings"
 
+	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/containers"
 	"github.com/containerd/containerd/oci"
+	"github.com/containerd/typeurl"
 	"github.com/ernoaapa/eliot/pkg/model"
 	"github.com/ernoaapa/eliot/pkg/runtime/containerd/mapping"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
 )
 
 // WithCwd spets the container current working directory (cwd)
@@ -30,6 +36,14 @@ func WithEnv(overrides []string) oci.SpecOpts {
 	}
 }
 
+// WithProcessEnv replaces all process environment variables
+func WithProcessEnv(env []string) oci.SpecOpts {
+	return func(_ context.Context, _ oci.Client, _ *containers.Container, s *specs.Spec) error {
+		s.Process.Env = env
+		return nil
+	}
+}
+
 // replaceOrAppendEnvValues returns the defaults with the overrides either
 // replaced by env key or appended to the list
 func replaceOrAppendEnvValues(defaults, overrides []string) []string {
@@ -77,3 +91,140 @@ func WithMounts(mounts []model.Mount) oci.SpecOpts {
 		return nil
 	}
 }
+
+// WithDevices resolves given host devices and adds them to the container with device cgroup allow rules.
+// Replaces devices what have been added earlier so it can be re-applied on each start to pick up
+// devices what have been plugged in after the container were created.
+func WithDevices(devices []model.Device) oci.SpecOpts {
+	return func(_ context.Context, _ oci.Client, _ *containers.Container, s *specs.Spec) error {
+		if s.Linux == nil {
+			s.Linux = &specs.Linux{}
+		}
+		if s.Linux.Resources == nil {
+			s.Linux.Resources = &specs.LinuxResources{}
+		}
+
+		s.Linux.Devices = []specs.LinuxDevice{}
+		s.Linux.Resources.Devices = removeDeviceAllowRules(s.Linux.Resources.Devices)
+
+		for _, device := range devices {
+			resolved, err := resolveDevices(device)
+			if err != nil {
+				return err
+			}
+
+			for _, linuxDevice := range resolved {
+				major, minor := linuxDevice.Major, linuxDevice.Minor
+				s.Linux.Devices = append(s.Linux.Devices, linuxDevice)
+				s.Linux.Resources.Devices = append(s.Linux.Resources.Devices, specs.LinuxDeviceCgroup{
+					Allow:  true,
+					Type:   linuxDevice.Type,
+					Major:  &major,
+					Minor:  &minor,
+					Access: devicePermissions(device),
+				})
+			}
+		}
+		return nil
+	}
+}
+
+// WithHostDevices adds all host devices to the container, e.g. for privileged container.
+// Must be given after WithDevices, the devices what are already in the spec are kept as they are
+func WithHostDevices(_ context.Context, _ oci.Client, _ *containers.Container, s *specs.Spec) error {
+	if s.Linux == nil {
+		s.Linux = &specs.Linux{}
+	}
+
+	devices, err := resolveHostDevices("/dev")
+	if err != nil {
+		return errors.Wrap(err, "Failed to resolve host devices")
+	}
+
+	existing := map[string]bool{}
+	for _, device := range s.Linux.Devices {
+		existing[device.Path] = true
+	}
+	for _, device := range devices {
+		if !existing[device.Path] {
+			s.Linux.Devices = append(s.Linux.Devices, device)
+		}
+	}
+	return nil
+}
+
+// removeDeviceAllowRules returns the rules without the allow rules for specific devices
+func removeDeviceAllowRules(rules []specs.LinuxDeviceCgroup) (result []specs.LinuxDeviceCgroup) {
+	for _, rule := range rules {
+		if rule.Allow && rule.Major != nil {
+			continue
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+func devicePermissions(device model.Device) string {
+	if device.Permissions == "" {
+		return "rwm"
+	}
+	return device.Permissions
+}
+
+// resolveDevicePaths returns list of host and container path pairs for the device.
+// If device host path is glob pattern, it get expanded and each device keeps the host path in the container
+func resolveDevicePaths(device model.Device) (map[string]string, error) {
+	result := map[string]string{}
+	if model.IsGlobPattern(device.HostPath) {
+		matches, err := filepath.Glob(device.HostPath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid device path pattern [%s]", device.HostPath)
+		}
+		for _, match := range matches {
+			result[match] = match
+		}
+		return result, nil
+	}
+
+	if device.ContainerPath == "" {
+		result[device.HostPath] = device.HostPath
+	} else {
+		result[device.HostPath] = device.ContainerPath
+	}
+	return result, nil
+}
+
+// WithJoinNamespaces joins the container to the Linux namespaces of given process. E.g. ipc, uts, pid
+func WithJoinNamespaces(pid uint32, namespaces []string) oci.SpecOpts {
+	specOpts := []oci.SpecOpts{}
+	for _, namespace := range namespaces {
+		specOpts = append(specOpts, oci.WithLinuxNamespace(specs.LinuxNamespace{
+			Type: specs.LinuxNamespaceType(namespace),
+			Path: fmt.Sprintf("/proc/%d/ns/%s", pid, namespace),
+		}))
+	}
+	return oci.Compose(specOpts...)
+}
+
+// WithSpecUpdate is containerd.UpdateContainerOpts implementation what applies given oci.SpecOpts to the existing container spec
+func WithSpecUpdate(opts ...oci.SpecOpts) containerd.UpdateContainerOpts {
+	return func(ctx context.Context, client *containerd.Client, c *containers.Container) error {
+		var s specs.Spec
+		if err := json.Unmarshal(c.Spec.Value, &s); err != nil {
+			return errors.Wrapf(err, "Failed to read container [%s] spec", c.ID)
+		}
+
+		for _, o := range opts {
+			if err := o(ctx, client, c, &s); err != nil {
+				return err
+			}
+		}
+
+		updated, err := typeurl.MarshalAny(&s)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to marshal container [%s] spec", c.ID)
+		}
+		c.Spec = updated
+		return nil
+	}
+}
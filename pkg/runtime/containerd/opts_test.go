@@ -1,8 +1,11 @@
 package containerd
 
 import (
+	"context"
 	"testing"
 
+	"github.com/containerd/containerd/containers"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -21,3 +24,23 @@ func TestReplaceOrAppendEnvValues(t *testing.T) {
 		"OTHER=keep",
 	}, result)
 }
+
+func TestWithJoinNamespaces(t *testing.T) {
+	spec := &specs.Spec{
+		Linux: &specs.Linux{
+			Namespaces: []specs.LinuxNamespace{
+				{Type: specs.PIDNamespace},
+				{Type: specs.IPCNamespace},
+				{Type: specs.MountNamespace},
+			},
+		},
+	}
+
+	err := WithJoinNamespaces(123, []string{"ipc", "pid"})(context.Background(), nil, &containers.Container{}, spec)
+	assert.NoError(t, err)
+	assert.Equal(t, []specs.LinuxNamespace{
+		{Type: specs.PIDNamespace, Path: "/proc/123/ns/pid"},
+		{Type: specs.IPCNamespace, Path: "/proc/123/ns/ipc"},
+		{Type: specs.MountNamespace},
+	}, spec.Linux.Namespaces)
+}
@@ -0,0 +1,29 @@
+package containerd
+
+import (
+	"context"
+
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/contrib/seccomp"
+	"github.com/containerd/containerd/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// withSeccompProfile sets the seccomp profile by name
+func withSeccompProfile(profile string) oci.SpecOpts {
+	return func(ctx context.Context, client oci.Client, c *containers.Container, s *specs.Spec) error {
+		if path, isLocal := parseSeccompProfile(profile); isLocal {
+			return seccomp.WithProfile(path)(ctx, client, c, s)
+		}
+
+		switch profile {
+		case "unconfined":
+			return oci.WithSeccompUnconfined(ctx, client, c, s)
+		case "runtime/default":
+			return seccomp.WithDefaultProfile()(ctx, client, c, s)
+		default:
+			return errors.Errorf("Unknown seccomp profile [%s]", profile)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// +build !linux
+
+package containerd
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/pkg/errors"
+)
+
+// withSeccompProfile is not supported outside Linux
+func withSeccompProfile(profile string) oci.SpecOpts {
+	return func(_ context.Context, _ oci.Client, _ *containers.Container, _ *specs.Spec) error {
+		return errors.Errorf("Seccomp profiles are not supported on %s", runtime.GOOS)
+	}
+}
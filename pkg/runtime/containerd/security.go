@@ -0,0 +1,130 @@
+package containerd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/oci"
+	"github.com/ernoaapa/eliot/pkg/model"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// WithSecurityContext maps the container security context to the spec options.
+// Note: the user get resolved from the image so the container snapshot must be created before the spec
+func WithSecurityContext(securityContext model.SecurityContext) oci.SpecOpts {
+	specOpts := []oci.SpecOpts{}
+
+	if securityContext.User != "" {
+		user := securityContext.User
+		if securityContext.Group != "" {
+			user = user + ":" + securityContext.Group
+		}
+		specOpts = append(specOpts, oci.WithUser(user))
+	}
+
+	if securityContext.Privileged {
+		specOpts = append(specOpts, oci.WithPrivileged, withAllDevicesAllowed)
+	}
+
+	if securityContext.Capabilities != nil {
+		specOpts = append(specOpts, WithCapabilities(securityContext.Capabilities.Add, securityContext.Capabilities.Drop))
+	}
+
+	if securityContext.ReadOnlyRootFilesystem {
+		specOpts = append(specOpts, oci.WithRootFSReadonly())
+	}
+
+	if securityContext.NoNewPrivileges {
+		specOpts = append(specOpts, oci.WithNoNewPrivileges)
+	}
+
+	// Seccomp must be last because the default profile depends on the capabilities
+	if securityContext.SeccompProfile != "" {
+		specOpts = append(specOpts, withSeccompProfile(securityContext.SeccompProfile))
+	}
+
+	return oci.Compose(specOpts...)
+}
+
+// WithCapabilities drops and then adds capabilities to the current process capabilities.
+// Capabilities can be given in format NET_ADMIN or CAP_NET_ADMIN and 'ALL' means all capabilities.
+func WithCapabilities(add, drop []string) oci.SpecOpts {
+	return func(ctx context.Context, client oci.Client, c *containers.Container, s *specs.Spec) error {
+		if s.Process == nil {
+			s.Process = &specs.Process{}
+		}
+		if s.Process.Capabilities == nil {
+			s.Process.Capabilities = &specs.LinuxCapabilities{}
+		}
+
+		caps := s.Process.Capabilities.Bounding
+		if containsCapability(drop, "ALL") {
+			caps = []string{}
+		} else {
+			caps = removeCapabilities(caps, drop)
+		}
+
+		if containsCapability(add, "ALL") {
+			if err := oci.WithAllCapabilities(ctx, client, c, s); err != nil {
+				return err
+			}
+			caps = s.Process.Capabilities.Bounding
+		} else {
+			for _, capability := range add {
+				capability = model.NormalizeCapability(capability)
+				if !containsCapability(caps, capability) {
+					caps = append(caps, capability)
+				}
+			}
+		}
+
+		s.Process.Capabilities.Bounding = caps
+		s.Process.Capabilities.Effective = caps
+		s.Process.Capabilities.Permitted = caps
+		s.Process.Capabilities.Inheritable = caps
+		return nil
+	}
+}
+
+// withAllDevicesAllowed allows the container to access all host devices
+func withAllDevicesAllowed(_ context.Context, _ oci.Client, _ *containers.Container, s *specs.Spec) error {
+	if s.Linux == nil {
+		s.Linux = &specs.Linux{}
+	}
+	if s.Linux.Resources == nil {
+		s.Linux.Resources = &specs.LinuxResources{}
+	}
+	s.Linux.Resources.Devices = []specs.LinuxDeviceCgroup{{
+		Allow:  true,
+		Access: "rwm",
+	}}
+	return nil
+}
+
+func containsCapability(caps []string, capability string) bool {
+	for _, c := range caps {
+		if model.NormalizeCapability(c) == model.NormalizeCapability(capability) {
+			return true
+		}
+	}
+	return false
+}
+
+func removeCapabilities(caps, remove []string) (result []string) {
+	result = []string{}
+	for _, c := range caps {
+		if !containsCapability(remove, c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// parseSeccompProfile returns path to the profile file if profile is "localhost/<path>"
+func parseSeccompProfile(profile string) (path string, isLocal bool) {
+	if strings.HasPrefix(profile, "localhost/") {
+		return "/" + strings.TrimLeft(strings.TrimPrefix(profile, "localhost/"), "/"), true
+	}
+	return "", false
+}
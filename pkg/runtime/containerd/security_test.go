@@ -0,0 +1,58 @@
+package containerd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/containerd/containerd/containers"
+	"github.com/ernoaapa/eliot/pkg/model"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCapabilities(t *testing.T) {
+	spec := &specs.Spec{
+		Process: &specs.Process{
+			Capabilities: &specs.LinuxCapabilities{
+				Bounding: []string{"CAP_CHOWN", "CAP_KILL", "CAP_NET_RAW"},
+			},
+		},
+	}
+
+	err := WithCapabilities([]string{"net_admin", "CAP_KILL"}, []string{"NET_RAW"})(context.Background(), nil, &containers.Container{}, spec)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CAP_CHOWN", "CAP_KILL", "CAP_NET_ADMIN"}, spec.Process.Capabilities.Bounding)
+	assert.Equal(t, spec.Process.Capabilities.Bounding, spec.Process.Capabilities.Effective)
+}
+
+func TestWithCapabilitiesDropAll(t *testing.T) {
+	spec := &specs.Spec{
+		Process: &specs.Process{
+			Capabilities: &specs.LinuxCapabilities{
+				Bounding: []string{"CAP_CHOWN", "CAP_KILL"},
+			},
+		},
+	}
+
+	err := WithCapabilities([]string{"NET_BIND_SERVICE"}, []string{"ALL"})(context.Background(), nil, &containers.Container{}, spec)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"CAP_NET_BIND_SERVICE"}, spec.Process.Capabilities.Bounding)
+}
+
+func TestWithSecurityContext(t *testing.T) {
+	spec := &specs.Spec{
+		Process: &specs.Process{},
+		Root:    &specs.Root{},
+		Linux:   &specs.Linux{},
+	}
+
+	err := WithSecurityContext(model.SecurityContext{
+		ReadOnlyRootFilesystem: true,
+		NoNewPrivileges:        true,
+		Privileged:             true,
+	})(context.Background(), nil, &containers.Container{}, spec)
+	assert.NoError(t, err)
+	assert.True(t, spec.Root.Readonly)
+	assert.True(t, spec.Process.NoNewPrivileges)
+	assert.Equal(t, []specs.LinuxDeviceCgroup{{Allow: true, Access: "rwm"}}, spec.Linux.Resources.Devices)
+}
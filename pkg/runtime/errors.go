@@ -19,6 +19,11 @@ func IsNotFound(err error) bool {
 	return errors.Cause(err) == ErrNotFound
 }
 
+// IsAlreadyExists returns true if the error is due to already existing resource
+func IsAlreadyExists(err error) bool {
+	return errors.Cause(err) == ErrAlreadyExists
+}
+
 // ErrWithMessagef updates error message with formated message
 // I.e. errors.WithMessage(err, fmt.Sprintf(...
 // Hopefully we can change to errors.WithMessagef some day: https://github.com/pkg/errors/pull/118
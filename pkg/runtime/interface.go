@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"io"
+	"net"
 	"syscall"
 
 	"github.com/ernoaapa/eliot/pkg/model"
@@ -16,12 +17,37 @@ type Client interface {
 	CreateContainer(pod model.Pod, container model.Container) (model.ContainerStatus, error)
 	StartContainer(namespace, id string, io IOSet) (model.ContainerStatus, error)
 	StopContainer(namespace, id string) (model.ContainerStatus, error)
+	TerminateContainer(namespace, id string) (model.ContainerStatus, error)
 	GetNamespaces() ([]string, error)
 	IsContainerRunning(namespace, name string) (bool, error)
 	GetContainerTaskStatus(namespace, name string) string
-	Exec(namespace, podName, execID string, args []string, tty bool, attach AttachIO) error
+	Exec(namespace, name string, process ExecProcess, attach AttachIO) (exitCode uint32, err error)
 	Attach(namespace, podName string, attach AttachIO) error
 	Signal(namespace, name string, signal syscall.Signal) error
+	GetContainerRoot(namespace, name string) (string, error)
+	DialPod(namespace, podName string, port int) (net.Conn, error)
+	OnStart(hook StartHook)
+	WatchTaskExits(done <-chan struct{}) error
+}
+
+// StartHook gets called before the container task get started.
+// Ensures the host resources what the container uses exist, e.g. tmpfs and loop mounts don't survive over node restart.
+// Returns environment variables in format KEY=value what get added only to the task process, e.g. Secret values
+// what must not be stored to the container metadata
+type StartHook func(pod model.Pod, container model.Container) (env []string, err error)
+
+// ExecProcess defines the process to execute in the container
+type ExecProcess struct {
+	// Unique id for the process
+	ID   string
+	Args []string
+	// Additional environment variables in format KEY=value
+	Env []string
+	// User name or UID and optionally group, e.g. nobody or 1000:1000. Defaults to the container user
+	User string
+	// Defaults to the container working directory
+	WorkingDir string
+	Tty        bool
 }
 
 // AttachIO provides way to attach stdin,stdout and stderr to container
@@ -29,4 +55,14 @@ type AttachIO struct {
 	Stdin  io.Reader
 	Stdout io.Writer
 	Stderr io.Writer
+	// Resize receives the client terminal size changes, optional
+	Resize <-chan TerminalSize
+	// Detach get closed when the client detaches, optional
+	Detach <-chan struct{}
+}
+
+// TerminalSize is the client terminal width and height
+type TerminalSize struct {
+	Width  uint32
+	Height uint32
 }
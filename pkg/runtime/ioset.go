@@ -11,6 +11,9 @@ type IOSet struct {
 	Stdin  string
 	Stdout string
 	Stderr string
+
+	// stdoutPiped is true when the stdout goes to other container stdin
+	stdoutPiped bool
 }
 
 // NewIOSet creates new unique IOSet for container
@@ -33,4 +36,5 @@ func NewIOSet(id string) (*IOSet, error) {
 // PipeStdoutTo updates the IOSet stdout to another IOSet stdin
 func (s *IOSet) PipeStdoutTo(target *IOSet) {
 	s.Stdout = target.Stdin
+	s.stdoutPiped = true
 }
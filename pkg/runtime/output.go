@@ -0,0 +1,216 @@
+package runtime
+
+import (
+	"io"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultReplaySize is how many bytes of the latest output get replayed to new subscribers
+	DefaultReplaySize = 16 * 1024
+	// subscriberBufferSize is how many chunks can wait for slow subscriber before it gets dropped
+	subscriberBufferSize = 256
+)
+
+type outputChunk struct {
+	data   []byte
+	stderr bool
+}
+
+// OutputBroadcaster fans the container stdout and stderr output out to any number of subscribers.
+// It keeps the latest output in memory so new subscribers get the context
+type OutputBroadcaster struct {
+	replaySize  int
+	replay      []outputChunk
+	replayBytes int
+	subscribers map[*Subscription]struct{}
+	closed      bool
+	done        chan struct{}
+	mu          sync.Mutex
+}
+
+// Subscription receives the output until it gets closed or the broadcaster closes
+type Subscription struct {
+	broadcaster *OutputBroadcaster
+	chunks      chan outputChunk
+	done        chan struct{}
+	closeOnce   sync.Once
+}
+
+// NewOutputBroadcaster creates new OutputBroadcaster what replays up to replaySize bytes to new subscribers
+func NewOutputBroadcaster(replaySize int) *OutputBroadcaster {
+	return &OutputBroadcaster{
+		replaySize:  replaySize,
+		subscribers: map[*Subscription]struct{}{},
+		done:        make(chan struct{}),
+	}
+}
+
+// Broadcast copies the stdout and stderr to the subscribers in background and closes the broadcaster
+// when both reach the end. Nil reader get skipped
+func (b *OutputBroadcaster) Broadcast(stdout, stderr io.Reader) {
+	var wg sync.WaitGroup
+	for _, source := range []struct {
+		reader io.Reader
+		stderr bool
+	}{{stdout, false}, {stderr, true}} {
+		if source.reader == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(r io.Reader, stderr bool) {
+			defer wg.Done()
+			if _, err := io.Copy(b.Writer(stderr), r); err != nil {
+				log.Debugf("Stopped reading container output: %s", err)
+			}
+		}(source.reader, source.stderr)
+	}
+
+	go func() {
+		wg.Wait()
+		b.Close()
+	}()
+}
+
+// Writer returns io.Writer what broadcasts the written bytes as stdout or stderr output
+func (b *OutputBroadcaster) Writer(stderr bool) io.Writer {
+	return &broadcastWriter{b, stderr}
+}
+
+type broadcastWriter struct {
+	broadcaster *OutputBroadcaster
+	stderr      bool
+}
+
+func (w *broadcastWriter) Write(p []byte) (int, error) {
+	w.broadcaster.broadcast(outputChunk{
+		data:   append([]byte{}, p...),
+		stderr: w.stderr,
+	})
+	return len(p), nil
+}
+
+func (b *OutputBroadcaster) broadcast(chunk outputChunk) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+
+	b.appendReplay(chunk)
+	for s := range b.subscribers {
+		select {
+		case s.chunks <- chunk:
+		default:
+			log.Warnf("Attached client cannot keep up with the container output, dropping it")
+			delete(b.subscribers, s)
+			close(s.chunks)
+		}
+	}
+}
+
+// appendReplay stores the chunk and drops the oldest output over the replay size, caller must hold the lock
+func (b *OutputBroadcaster) appendReplay(chunk outputChunk) {
+	if b.replaySize <= 0 {
+		return
+	}
+	if len(chunk.data) > b.replaySize {
+		chunk.data = chunk.data[len(chunk.data)-b.replaySize:]
+	}
+
+	b.replay = append(b.replay, chunk)
+	b.replayBytes += len(chunk.data)
+	for b.replayBytes > b.replaySize {
+		oldest := &b.replay[0]
+		overflow := b.replayBytes - b.replaySize
+		if overflow < len(oldest.data) {
+			oldest.data = oldest.data[overflow:]
+			b.replayBytes -= overflow
+			break
+		}
+		b.replayBytes -= len(oldest.data)
+		b.replay = b.replay[1:]
+	}
+}
+
+// Subscribe writes the replay and all new output to the writers until the subscription get closed
+func (b *OutputBroadcaster) Subscribe(stdout, stderr io.Writer) *Subscription {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s := &Subscription{
+		broadcaster: b,
+		chunks:      make(chan outputChunk, len(b.replay)+subscriberBufferSize),
+		done:        make(chan struct{}),
+	}
+	for _, chunk := range b.replay {
+		s.chunks <- chunk
+	}
+	if b.closed {
+		close(s.chunks)
+	} else {
+		b.subscribers[s] = struct{}{}
+	}
+
+	go s.run(stdout, stderr)
+	return s
+}
+
+func (s *Subscription) run(stdout, stderr io.Writer) {
+	defer close(s.done)
+	failed := false
+	for chunk := range s.chunks {
+		target := stdout
+		if chunk.stderr {
+			target = stderr
+		}
+		if failed || target == nil {
+			continue
+		}
+		if _, err := target.Write(chunk.data); err != nil {
+			log.Debugf("Failed to write output to the subscriber: %s", err)
+			// Keep draining until the subscription get closed
+			failed = true
+		}
+	}
+}
+
+// Done returns channel what get closed when all output have been written to the subscriber
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Close stops the subscription
+func (s *Subscription) Close() {
+	s.closeOnce.Do(func() {
+		b := s.broadcaster
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if _, ok := b.subscribers[s]; ok {
+			delete(b.subscribers, s)
+			close(s.chunks)
+		}
+	})
+}
+
+// Close stops broadcasting and ends all subscriptions
+func (b *OutputBroadcaster) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return
+	}
+	b.closed = true
+	for s := range b.subscribers {
+		delete(b.subscribers, s)
+		close(s.chunks)
+	}
+	close(b.done)
+}
+
+// Done returns channel what get closed when the broadcaster closes
+func (b *OutputBroadcaster) Done() <-chan struct{} {
+	return b.done
+}
@@ -0,0 +1,55 @@
+package runtime
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutputBroadcasterFanOut(t *testing.T) {
+	b := NewOutputBroadcaster(DefaultReplaySize)
+
+	stdout1, stderr1 := &bytes.Buffer{}, &bytes.Buffer{}
+	stdout2, stderr2 := &bytes.Buffer{}, &bytes.Buffer{}
+	s1 := b.Subscribe(stdout1, stderr1)
+	s2 := b.Subscribe(stdout2, stderr2)
+
+	b.Broadcast(strings.NewReader("hello"), strings.NewReader("oops"))
+	<-b.Done()
+	<-s1.Done()
+	<-s2.Done()
+
+	assert.Equal(t, "hello", stdout1.String())
+	assert.Equal(t, "oops", stderr1.String())
+	assert.Equal(t, "hello", stdout2.String())
+	assert.Equal(t, "oops", stderr2.String())
+}
+
+func TestOutputBroadcasterReplay(t *testing.T) {
+	b := NewOutputBroadcaster(5)
+	b.Writer(false).Write([]byte("abc"))
+	b.Writer(true).Write([]byte("def"))
+	b.Writer(false).Write([]byte("ghi"))
+	b.Close()
+
+	stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+	<-b.Subscribe(stdout, stderr).Done()
+
+	assert.Equal(t, "ghi", stdout.String())
+	assert.Equal(t, "ef", stderr.String(), "should replay only the latest bytes")
+}
+
+func TestOutputBroadcasterSubscriptionClose(t *testing.T) {
+	b := NewOutputBroadcaster(0)
+	stdout := &bytes.Buffer{}
+	s := b.Subscribe(stdout, nil)
+	s.Close()
+	<-s.Done()
+
+	b.Writer(false).Write([]byte("after"))
+	b.Close()
+
+	assert.Empty(t, stdout.String(), "should not receive output after close")
+}
@@ -0,0 +1,57 @@
+package sync
+
+import (
+	"path"
+	"sort"
+)
+
+// Change is single change to apply to the sync target
+type Change struct {
+	// State of the file to write, or the path to delete
+	State  FileState
+	Delete bool
+}
+
+// Diff returns the changes what make the target equal to the source.
+// Deletes come first and only the topmost deleted path is included.
+// Writes are sorted so that directories get written before their content.
+func Diff(source, target map[string]FileState) (changes []Change) {
+	deletes := []string{}
+	for p := range target {
+		if _, ok := source[p]; ok {
+			continue
+		}
+		if isParentDeleted(p, source, target) {
+			continue
+		}
+		deletes = append(deletes, p)
+	}
+	sort.Strings(deletes)
+	for _, p := range deletes {
+		changes = append(changes, Change{State: target[p], Delete: true})
+	}
+
+	writes := []string{}
+	for p, state := range source {
+		if existing, ok := target[p]; ok && existing.Equal(state) {
+			continue
+		}
+		writes = append(writes, p)
+	}
+	sort.Strings(writes)
+	for _, p := range writes {
+		changes = append(changes, Change{State: source[p]})
+	}
+	return changes
+}
+
+func isParentDeleted(p string, source, target map[string]FileState) bool {
+	for dir := path.Dir(p); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		_, inSource := source[dir]
+		_, inTarget := target[dir]
+		if inTarget && !inSource {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package sync
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	source := map[string]FileState{
+		"app":         {Path: "app", Mode: os.ModeDir | 0755},
+		"app/main.go": {Path: "app/main.go", Mode: 0644, Hash: "changed"},
+		"app/util.go": {Path: "app/util.go", Mode: 0644, Hash: "same"},
+		"README.md":   {Path: "README.md", Mode: 0644, Hash: "new"},
+	}
+	target := map[string]FileState{
+		"app":          {Path: "app", Mode: os.ModeDir | 0755},
+		"app/main.go":  {Path: "app/main.go", Mode: 0644, Hash: "original"},
+		"app/util.go":  {Path: "app/util.go", Mode: 0644, Hash: "same"},
+		"old":          {Path: "old", Mode: os.ModeDir | 0755},
+		"old/file.txt": {Path: "old/file.txt", Mode: 0644, Hash: "old"},
+		"app/old.go":   {Path: "app/old.go", Mode: 0644, Hash: "old"},
+	}
+
+	changes := Diff(source, target)
+
+	paths := []string{}
+	for _, change := range changes {
+		if change.Delete {
+			paths = append(paths, "-"+change.State.Path)
+		} else {
+			paths = append(paths, "+"+change.State.Path)
+		}
+	}
+	assert.Equal(t, []string{"-app/old.go", "-old", "+README.md", "+app/main.go"}, paths)
+}
+
+func TestDiffModeChange(t *testing.T) {
+	source := map[string]FileState{
+		"run.sh": {Path: "run.sh", Mode: 0755, Hash: "same"},
+	}
+	target := map[string]FileState{
+		"run.sh": {Path: "run.sh", Mode: 0644, Hash: "same"},
+	}
+
+	changes := Diff(source, target)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, os.FileMode(0755), changes[0].State.Mode)
+}
@@ -0,0 +1,155 @@
+package sync
+
+import (
+	"bufio"
+	"bytes"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// IgnoreFileName is the name of the file in the sync source root what defines the ignored files
+const IgnoreFileName = ".eliotignore"
+
+// DefaultIgnorePatterns are always ignored unless negated with '!' pattern
+var DefaultIgnorePatterns = []string{
+	".git",
+	".eliot.yml",
+}
+
+// Ignore matches paths against gitignore style patterns
+type Ignore struct {
+	patterns []ignorePattern
+}
+
+type ignorePattern struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// NewIgnore parses the gitignore style patterns.
+// Empty lines and lines starting with # are skipped. Later patterns override the earlier ones.
+func NewIgnore(patterns []string) (*Ignore, error) {
+	ignore := &Ignore{}
+	for _, line := range patterns {
+		pattern, ok, err := parseIgnorePattern(line)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Invalid ignore pattern [%s]", line)
+		}
+		if ok {
+			ignore.patterns = append(ignore.patterns, pattern)
+		}
+	}
+	return ignore, nil
+}
+
+// ReadIgnoreFile reads the patterns from the file, returns empty list if the file doesn't exist
+func ReadIgnoreFile(path string) (result []string, err error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		result = append(result, scanner.Text())
+	}
+	return result, errors.Wrapf(scanner.Err(), "Failed to read ignore file [%s]", path)
+}
+
+// Match returns true if the slash separated path relative to the sync root is ignored.
+// It's IgnoreFunc, parent directories get checked separately
+func (i *Ignore) Match(path string, dir bool) bool {
+	ignored := false
+	for _, pattern := range i.patterns {
+		if pattern.dirOnly && !dir {
+			continue
+		}
+		if pattern.re.MatchString(path) {
+			ignored = !pattern.negate
+		}
+	}
+	return ignored
+}
+
+// MatchPath returns true if the path or any of its parent directories match
+func (i *Ignore) MatchPath(path string, dir bool) bool {
+	return isIgnored(i.Match, path, dir)
+}
+
+func parseIgnorePattern(line string) (pattern ignorePattern, ok bool, err error) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return pattern, false, nil
+	}
+
+	if strings.HasPrefix(line, "!") {
+		pattern.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\`) {
+		// Escaped leading '#' or '!'
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		pattern.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+
+	// Pattern with slash is relative to the root, otherwise matches in any directory
+	prefix := "^(.*/)?"
+	if strings.Contains(line, "/") {
+		prefix = "^"
+		line = strings.TrimPrefix(line, "/")
+	}
+	if line == "" {
+		return pattern, false, nil
+	}
+
+	pattern.re, err = regexp.Compile(prefix + globToRegexp(line) + "$")
+	return pattern, err == nil, err
+}
+
+// globToRegexp converts the gitignore glob to regular expression
+func globToRegexp(glob string) string {
+	var result bytes.Buffer
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; {
+		case strings.HasPrefix(glob[i:], "**/"):
+			result.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**") && i+2 == len(glob):
+			result.WriteString(".*")
+			i++
+		case c == '*':
+			result.WriteString("[^/]*")
+		case c == '?':
+			result.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				result.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			result.WriteString("[" + strings.Replace(class, `\`, `\\`, -1) + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(glob):
+			i++
+			result.WriteString(regexp.QuoteMeta(string(glob[i])))
+		default:
+			result.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return result.String()
+}
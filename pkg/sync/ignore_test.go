@@ -0,0 +1,98 @@
+package sync
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/archive/archivetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIgnoreMatch(t *testing.T) {
+	ignore, err := NewIgnore([]string{
+		"# build outputs",
+		"",
+		"node_modules",
+		"/bin",
+		"*.log",
+		"!important.log",
+		"build/",
+		"docs/**/*.pdf",
+		"tmp/**",
+		"data/file[0-9].csv",
+		`\#notes`,
+	})
+	assert.NoError(t, err)
+
+	for _, test := range []struct {
+		path    string
+		dir     bool
+		ignored bool
+	}{
+		{"node_modules", true, true},
+		{"web/node_modules", true, true},
+		{"bin", true, true},
+		{"cmd/bin", true, false},
+		{"app.log", false, true},
+		{"logs/app.log", false, true},
+		{"logs/important.log", false, false},
+		{"build", true, true},
+		{"build", false, false},
+		{"docs/manual.pdf", false, true},
+		{"docs/a/b/manual.pdf", false, true},
+		{"other/manual.pdf", false, false},
+		{"tmp/cache/file", false, true},
+		{"tmp", true, false},
+		{"data/file1.csv", false, true},
+		{"data/fileA.csv", false, false},
+		{"#notes", false, true},
+		{"main.go", false, false},
+	} {
+		assert.Equal(t, test.ignored, ignore.Match(test.path, test.dir), "Unexpected result for [%s]", test.path)
+	}
+}
+
+func TestIgnoreDefaultsCanBeNegated(t *testing.T) {
+	ignore, err := NewIgnore(append(DefaultIgnorePatterns, "!.eliot.yml"))
+	assert.NoError(t, err)
+
+	assert.True(t, ignore.Match(".git", true))
+	assert.False(t, ignore.Match(".eliot.yml", false))
+}
+
+func TestIgnoreWithScan(t *testing.T) {
+	root, cleanup := archivetest.TempDir(t, map[string]string{
+		".git/HEAD":                 "ref",
+		"node_modules/lib/index.js": "lib",
+		"main.go":                   "main",
+	})
+	defer cleanup()
+
+	ignore, err := NewIgnore(append(DefaultIgnorePatterns, "node_modules/"))
+	assert.NoError(t, err)
+
+	states, err := Scan(root, ignore.Match)
+	assert.NoError(t, err)
+	assert.Len(t, states, 1)
+	assert.Contains(t, states, "main.go")
+}
+
+func TestReadIgnoreFile(t *testing.T) {
+	dir, cleanup := archivetest.TempDir(t, map[string]string{
+		IgnoreFileName: "node_modules\n*.log\n",
+	})
+	defer cleanup()
+
+	patterns, err := ReadIgnoreFile(filepath.Join(dir, IgnoreFileName))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"node_modules", "*.log"}, patterns)
+
+	patterns, err = ReadIgnoreFile(filepath.Join(dir, "missing"))
+	assert.NoError(t, err)
+	assert.Empty(t, patterns)
+}
+
+func TestNewIgnoreReturnErrorIfInvalidPattern(t *testing.T) {
+	_, err := NewIgnore([]string{"[z-a]"})
+	assert.Error(t, err)
+}
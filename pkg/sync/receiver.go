@@ -0,0 +1,213 @@
+package sync
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/ernoaapa/eliot/pkg/archive"
+	"github.com/pkg/errors"
+)
+
+// chmodBits are the file mode bits what get synced
+const chmodBits = os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky
+
+// Result is the result of the applied changes
+type Result struct {
+	// Count of written files, directories and symlinks
+	Written int
+	Deleted int
+	// Count of written file content bytes
+	Bytes  int64
+	Errors []string
+}
+
+// Receiver applies the received changes to the root directory.
+// Regular files get written to temporary file first and moved in place when complete,
+// so the processes never see partially written file
+type Receiver struct {
+	root    string
+	current *receivingFile
+	result  Result
+}
+
+type receivingFile struct {
+	state  FileState
+	target string
+	temp   *os.File
+}
+
+// NewReceiver creates new Receiver what writes the changes under the root
+func NewReceiver(root string) *Receiver {
+	return &Receiver{root: root}
+}
+
+// Begin completes the previous file and starts writing new file, directory or symlink.
+// The regular file content get written with Write
+func (r *Receiver) Begin(state FileState) {
+	r.complete()
+
+	target, err := r.resolve(state.Path)
+	if err != nil {
+		r.fail(state.Path, err)
+		return
+	}
+
+	switch {
+	case state.IsDir():
+		err = writeDir(target, state.Mode)
+	case state.Mode&os.ModeSymlink != 0:
+		err = writeSymlink(target, state.Link)
+	case state.IsRegular():
+		var temp *os.File
+		if temp, err = createTemp(target); err == nil {
+			r.current = &receivingFile{state: state, target: target, temp: temp}
+			return
+		}
+	default:
+		err = fmt.Errorf("Unsupported file type %s", state.Mode.String())
+	}
+
+	if err != nil {
+		r.fail(state.Path, err)
+		return
+	}
+	r.result.Written++
+}
+
+// Write writes the chunk of content to the current file
+func (r *Receiver) Write(data []byte) {
+	if r.current == nil {
+		// Writing the file failed already
+		return
+	}
+	if _, err := r.current.temp.Write(data); err != nil {
+		r.abort(err)
+		return
+	}
+	r.result.Bytes += int64(len(data))
+}
+
+// Delete completes the current file and removes the path
+func (r *Receiver) Delete(path string) {
+	r.complete()
+
+	target, err := r.resolve(path)
+	if err == nil {
+		err = os.RemoveAll(target)
+	}
+	if err != nil {
+		r.fail(path, err)
+		return
+	}
+	r.result.Deleted++
+}
+
+// Commit completes the current file and returns the result of the changes since the previous commit
+func (r *Receiver) Commit() Result {
+	r.complete()
+	result := r.result
+	r.result = Result{}
+	return result
+}
+
+// Close drops the partially received file
+func (r *Receiver) Close() {
+	if r.current != nil {
+		r.current.temp.Close()
+		os.Remove(r.current.temp.Name())
+		r.current = nil
+	}
+}
+
+func (r *Receiver) resolve(path string) (string, error) {
+	target, err := archive.ResolveTarget(r.root, path)
+	if err != nil {
+		return "", err
+	}
+	if target == r.root {
+		return "", fmt.Errorf("Cannot replace the sync root")
+	}
+	return target, nil
+}
+
+// complete moves the current file in place
+func (r *Receiver) complete() {
+	if r.current == nil {
+		return
+	}
+	current := r.current
+	r.current = nil
+
+	if err := moveInPlace(current.temp, current.target, current.state); err != nil {
+		os.Remove(current.temp.Name())
+		r.fail(current.state.Path, err)
+		return
+	}
+	r.result.Written++
+}
+
+func (r *Receiver) abort(err error) {
+	path := r.current.state.Path
+	r.Close()
+	r.fail(path, err)
+}
+
+func (r *Receiver) fail(path string, err error) {
+	r.result.Errors = append(r.result.Errors, fmt.Sprintf("Failed to sync [%s]: %s", path, err))
+}
+
+func createTemp(target string) (*os.File, error) {
+	dir := filepath.Dir(target)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return ioutil.TempFile(dir, ".eliot-sync-")
+}
+
+func moveInPlace(temp *os.File, target string, state FileState) error {
+	if err := temp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(temp.Name(), state.Mode&chmodBits); err != nil {
+		return err
+	}
+	if err := os.Chtimes(temp.Name(), state.ModTime, state.ModTime); err != nil {
+		return err
+	}
+	if err := removeIfDir(target); err != nil {
+		return err
+	}
+	return os.Rename(temp.Name(), target)
+}
+
+func writeDir(target string, mode os.FileMode) error {
+	if info, err := os.Lstat(target); err == nil && !info.IsDir() {
+		if err := os.Remove(target); err != nil {
+			return err
+		}
+	}
+	if err := os.MkdirAll(target, 0755); err != nil {
+		return err
+	}
+	return os.Chmod(target, mode&chmodBits)
+}
+
+func writeSymlink(target, link string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(target); err != nil {
+		return err
+	}
+	return os.Symlink(link, target)
+}
+
+func removeIfDir(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil || !info.IsDir() {
+		return nil
+	}
+	return errors.Wrapf(os.RemoveAll(path), "Failed to replace directory with file")
+}
@@ -0,0 +1,82 @@
+package sync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ernoaapa/eliot/pkg/archive/archivetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReceiver(t *testing.T) {
+	root, cleanup := archivetest.TempDir(t, map[string]string{
+		"old/file.txt": "old",
+		"main.go":      "original",
+	})
+	defer cleanup()
+
+	modTime := time.Date(2018, 1, 2, 3, 4, 5, 0, time.UTC)
+	receiver := NewReceiver(root)
+	defer receiver.Close()
+
+	receiver.Delete("old")
+	receiver.Begin(FileState{Path: "app", Mode: os.ModeDir | 0755})
+	receiver.Begin(FileState{Path: "app/run.sh", Mode: 0755, ModTime: modTime})
+	receiver.Write([]byte("#!/bin/sh\n"))
+	receiver.Write([]byte("echo hello\n"))
+	receiver.Begin(FileState{Path: "main.go", Mode: 0644, ModTime: modTime})
+	receiver.Write([]byte("updated"))
+	receiver.Begin(FileState{Path: "link", Mode: os.ModeSymlink | 0777, Link: "app/run.sh"})
+	result := receiver.Commit()
+
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, 4, result.Written)
+	assert.Equal(t, 1, result.Deleted)
+	assert.Equal(t, int64(28), result.Bytes)
+
+	assert.Equal(t, "#!/bin/sh\necho hello\n", archivetest.ReadFile(t, filepath.Join(root, "app/run.sh")))
+	assert.Equal(t, "updated", archivetest.ReadFile(t, filepath.Join(root, "main.go")))
+
+	info, err := os.Stat(filepath.Join(root, "app/run.sh"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0755), info.Mode().Perm())
+	assert.True(t, modTime.Equal(info.ModTime()))
+
+	link, err := os.Readlink(filepath.Join(root, "link"))
+	assert.NoError(t, err)
+	assert.Equal(t, "app/run.sh", link)
+
+	_, err = os.Stat(filepath.Join(root, "old"))
+	assert.True(t, os.IsNotExist(err))
+
+	// Temporary files get moved in place
+	files, err := filepath.Glob(filepath.Join(root, "app", ".eliot-sync-*"))
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
+func TestReceiverCannotWriteThroughSymlink(t *testing.T) {
+	root, cleanup := archivetest.TempDir(t, nil)
+	defer cleanup()
+	outside, cleanup := archivetest.TempDir(t, nil)
+	defer cleanup()
+	assert.NoError(t, os.Symlink(outside, filepath.Join(root, "escape")))
+
+	receiver := NewReceiver(root)
+	defer receiver.Close()
+
+	receiver.Begin(FileState{Path: "escape/file.txt", Mode: 0644})
+	receiver.Write([]byte("data"))
+	receiver.Begin(FileState{Path: "../file.txt", Mode: 0644})
+	receiver.Write([]byte("data"))
+	receiver.Delete("")
+	result := receiver.Commit()
+
+	assert.Len(t, result.Errors, 2)
+	assert.Equal(t, 1, result.Written)
+	assert.Equal(t, "data", archivetest.ReadFile(t, filepath.Join(root, "file.txt")))
+	_, err := os.Stat(filepath.Join(outside, "file.txt"))
+	assert.True(t, os.IsNotExist(err))
+}
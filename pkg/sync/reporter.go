@@ -0,0 +1,67 @@
+package sync
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Reporter keeps the status file up to date with the sync events and writes them to the log
+type Reporter struct {
+	mu         sync.Mutex
+	status     Status
+	statusPath string
+	log        io.Writer
+}
+
+// NewReporter creates new Reporter what writes the pod sync status to the statusPath.
+// The log is optional
+func NewReporter(status Status, statusPath string, log io.Writer) *Reporter {
+	return &Reporter{
+		status:     status,
+		statusPath: statusPath,
+		log:        log,
+	}
+}
+
+// Run reports all events from the channel until it get closed
+func (r *Reporter) Run(events <-chan Event) {
+	for event := range events {
+		r.Report(event)
+	}
+}
+
+// Report adds the event to the status
+func (r *Reporter) Report(event Event) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.status.Update(event)
+	if err := WriteStatus(r.statusPath, r.status); err != nil {
+		log.Debugf("Failed to update sync status: %s", err)
+	}
+	if r.log != nil {
+		fmt.Fprintln(r.log, event.String())
+	}
+}
+
+// Status returns the current sync status
+func (r *Reporter) Status() Status {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.status
+}
+
+// Close removes the status file, the sync is not running anymore
+func (r *Reporter) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := os.Remove(r.statusPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
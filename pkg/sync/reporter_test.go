@@ -0,0 +1,44 @@
+package sync
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ernoaapa/eliot/pkg/archive/archivetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReporter(t *testing.T) {
+	dir, cleanup := archivetest.TempDir(t, nil)
+	defer cleanup()
+	statusPath := filepath.Join(dir, "my-pod.yml")
+	log := &bytes.Buffer{}
+
+	reporter := NewReporter(Status{Pod: "my-pod"}, statusPath, log)
+	events := make(chan Event)
+	go func() {
+		events <- Event{Time: time.Now(), Source: "/src", Result: Result{Written: 2, Bytes: 10}}
+		events <- Event{Time: time.Now(), Source: "/src", Err: fmt.Errorf("connection lost")}
+		close(events)
+	}()
+	reporter.Run(events)
+
+	assert.Equal(t, 2, reporter.Status().Written)
+	assert.Equal(t, 1, reporter.Status().ErrorCount)
+
+	status, err := ReadStatus(statusPath)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-pod", status.Pod)
+	assert.Equal(t, []string{"connection lost"}, status.Errors)
+
+	assert.Contains(t, log.String(), "/src: 2 written, 0 deleted, 10 bytes")
+	assert.Contains(t, log.String(), "/src: sync failed: connection lost")
+
+	assert.NoError(t, reporter.Close())
+	_, err = os.Stat(statusPath)
+	assert.True(t, os.IsNotExist(err), "Close should remove the status file")
+}
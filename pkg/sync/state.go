@@ -0,0 +1,152 @@
+package sync
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// FileState is the state of single file, directory or symlink in the synced directory
+type FileState struct {
+	// Path relative to the sync root, slash separated
+	Path    string
+	Mode    os.FileMode
+	ModTime time.Time
+	Size    int64
+	// SHA-256 checksum of the regular file content, hex encoded
+	Hash string
+	// Symlink target
+	Link string
+}
+
+// IsDir returns true if the state is directory
+func (s FileState) IsDir() bool {
+	return s.Mode.IsDir()
+}
+
+// IsRegular returns true if the state is regular file
+func (s FileState) IsRegular() bool {
+	return s.Mode.IsRegular()
+}
+
+// Equal returns true if the file type, permissions and content are the same
+func (s FileState) Equal(other FileState) bool {
+	return s.Mode == other.Mode && s.Hash == other.Hash && s.Link == other.Link
+}
+
+// IgnoreFunc returns true if the path relative to the sync root should not be synced
+type IgnoreFunc func(path string, dir bool) bool
+
+// Scan returns state of all files under the root, the root itself is not included
+func Scan(root string, ignore IgnoreFunc) (map[string]FileState, error) {
+	return ScanPath(root, "", ignore)
+}
+
+// ScanPath returns state of the path and all files under it, the path is relative to the root.
+// Returns empty result if the path doesn't exist
+func ScanPath(root, path string, ignore IgnoreFunc) (map[string]FileState, error) {
+	result := map[string]FileState{}
+	start := filepath.Join(root, filepath.FromSlash(path))
+	if _, err := os.Lstat(start); os.IsNotExist(err) {
+		return result, nil
+	}
+
+	err := filepath.Walk(start, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Removed while scanning
+				return nil
+			}
+			return err
+		}
+
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == "." {
+			return nil
+		}
+		if isIgnored(ignore, rel, info.IsDir()) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		state, ok, err := stat(path, rel, info)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to read [%s]", rel)
+		}
+		if ok {
+			result[rel] = state
+		}
+		return nil
+	})
+	return result, err
+}
+
+// isIgnored returns true if the path or any of its parent directories is ignored
+func isIgnored(ignore IgnoreFunc, path string, dir bool) bool {
+	if ignore == nil {
+		return false
+	}
+	parts := strings.Split(path, "/")
+	for i := 1; i < len(parts); i++ {
+		if ignore(strings.Join(parts[:i], "/"), true) {
+			return true
+		}
+	}
+	return ignore(path, dir)
+}
+
+// stat returns the file state, or false if the file type is not supported
+func stat(path, rel string, info os.FileInfo) (FileState, bool, error) {
+	state := FileState{
+		Path:    rel,
+		Mode:    info.Mode(),
+		ModTime: info.ModTime(),
+	}
+
+	switch {
+	case info.IsDir():
+	case info.Mode()&os.ModeSymlink != 0:
+		link, err := os.Readlink(path)
+		if err != nil {
+			return state, false, err
+		}
+		state.Link = link
+	case info.Mode().IsRegular():
+		hash, err := hashFile(path)
+		if err != nil {
+			return state, false, err
+		}
+		state.Size = info.Size()
+		state.Hash = hash
+	default:
+		// Devices, sockets and pipes cannot be synced
+		return state, false, nil
+	}
+	return state, true, nil
+}
+
+func hashFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,105 @@
+package sync
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// maxStatusErrors is how many latest errors the status keeps
+const maxStatusErrors = 10
+
+// Event is the result of single sync of the file changes
+type Event struct {
+	Time time.Time
+	// Local directory what got synced
+	Source string
+	Result Result
+	// Changes what were sent to the remote
+	Changes []Change
+	// Err is set if the sync failed completely
+	Err error
+}
+
+// String returns the event as single log line
+func (e Event) String() string {
+	line := fmt.Sprintf("%s %s: %d written, %d deleted, %d bytes", e.Time.Format(time.RFC3339), e.Source, e.Result.Written, e.Result.Deleted, e.Result.Bytes)
+	if e.Err != nil {
+		line += fmt.Sprintf("\n%s %s: sync failed: %s", e.Time.Format(time.RFC3339), e.Source, e.Err)
+	}
+	for _, message := range e.Result.Errors {
+		line += fmt.Sprintf("\n%s %s: %s", e.Time.Format(time.RFC3339), e.Source, message)
+	}
+	return line
+}
+
+// Status is the summary of the sync events in the session
+type Status struct {
+	Pod       string    `yaml:"pod"`
+	StartedAt time.Time `yaml:"startedAt"`
+	LastSync  time.Time `yaml:"lastSync,omitempty"`
+	Written   int       `yaml:"written"`
+	Deleted   int       `yaml:"deleted"`
+	Bytes     int64     `yaml:"bytes"`
+	// Count of all errors and the latest error messages
+	ErrorCount int      `yaml:"errorCount"`
+	Errors     []string `yaml:"errors,omitempty"`
+}
+
+// Update adds the event to the status
+func (s *Status) Update(event Event) {
+	s.LastSync = event.Time
+	s.Written += event.Result.Written
+	s.Deleted += event.Result.Deleted
+	s.Bytes += event.Result.Bytes
+
+	messages := event.Result.Errors
+	if event.Err != nil {
+		messages = append(messages, event.Err.Error())
+	}
+	s.ErrorCount += len(messages)
+	s.Errors = append(s.Errors, messages...)
+	if len(s.Errors) > maxStatusErrors {
+		s.Errors = s.Errors[len(s.Errors)-maxStatusErrors:]
+	}
+}
+
+// ReadStatus reads the status file, returns nil if the file doesn't exist
+func ReadStatus(path string) (*Status, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{}
+	if err := yaml.Unmarshal(data, status); err != nil {
+		return nil, errors.Wrapf(err, "Invalid sync status file [%s]", path)
+	}
+	return status, nil
+}
+
+// WriteStatus writes the status file
+func WriteStatus(path string, status Status) error {
+	data, err := yaml.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "Failed to create directory for sync status file [%s]", path)
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0644); err != nil {
+		return errors.Wrapf(err, "Failed to write sync status file [%s]", path)
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,55 @@
+package sync
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ernoaapa/eliot/pkg/archive/archivetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusUpdate(t *testing.T) {
+	status := Status{}
+	now := time.Now()
+
+	status.Update(Event{Time: now, Result: Result{Written: 2, Deleted: 1, Bytes: 100}})
+	status.Update(Event{Time: now.Add(time.Second), Result: Result{Written: 1, Bytes: 10, Errors: []string{"first"}}, Err: fmt.Errorf("second")})
+
+	assert.Equal(t, 3, status.Written)
+	assert.Equal(t, 1, status.Deleted)
+	assert.Equal(t, int64(110), status.Bytes)
+	assert.Equal(t, now.Add(time.Second), status.LastSync)
+	assert.Equal(t, 2, status.ErrorCount)
+	assert.Equal(t, []string{"first", "second"}, status.Errors)
+}
+
+func TestStatusKeepsLatestErrors(t *testing.T) {
+	status := Status{}
+	for i := 0; i < maxStatusErrors+5; i++ {
+		status.Update(Event{Err: fmt.Errorf("error %d", i)})
+	}
+
+	assert.Equal(t, maxStatusErrors+5, status.ErrorCount)
+	assert.Len(t, status.Errors, maxStatusErrors)
+	assert.Equal(t, fmt.Sprintf("error %d", maxStatusErrors+4), status.Errors[maxStatusErrors-1])
+}
+
+func TestWriteReadStatus(t *testing.T) {
+	dir, cleanup := archivetest.TempDir(t, nil)
+	defer cleanup()
+	path := filepath.Join(dir, "status", "my-pod.yml")
+
+	status, err := ReadStatus(path)
+	assert.NoError(t, err)
+	assert.Nil(t, status, "Should return nil if status file doesn't exist")
+
+	assert.NoError(t, WriteStatus(path, Status{Pod: "my-pod", Written: 3, Errors: []string{"failed"}}))
+
+	status, err = ReadStatus(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-pod", status.Pod)
+	assert.Equal(t, 3, status.Written)
+	assert.Equal(t, []string{"failed"}, status.Errors)
+}
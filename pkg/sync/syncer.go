@@ -0,0 +1,227 @@
+package sync
+
+import (
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// debounce is how long to wait for more file changes before syncing them
+const debounce = 100 * time.Millisecond
+
+// Remote is the sync target, e.g. volume in the node
+type Remote interface {
+	// Manifest returns the current state of the target
+	Manifest() (map[string]FileState, error)
+	// Apply applies the changes to the target, the file content get read under the root
+	Apply(root string, changes []Change) (Result, error)
+}
+
+// Syncer keeps the remote in sync with the local directory
+type Syncer struct {
+	root   string
+	remote Remote
+	ignore IgnoreFunc
+	// synced is the state what the remote have
+	synced map[string]FileState
+}
+
+// NewSyncer creates new Syncer what syncs the files under the root to the remote.
+// Ignored files don't get synced, nor deleted from the remote
+func NewSyncer(root string, remote Remote, ignore IgnoreFunc) *Syncer {
+	return &Syncer{
+		root:   root,
+		remote: remote,
+		ignore: ignore,
+		synced: map[string]FileState{},
+	}
+}
+
+// Sync sends all changed files to the remote and deletes the files what don't exist locally
+func (s *Syncer) Sync() (Result, error) {
+	local, err := Scan(s.root, s.ignore)
+	if err != nil {
+		return Result{}, errors.Wrapf(err, "Failed to read files in [%s]", s.root)
+	}
+
+	remote, err := s.remote.Manifest()
+	if err != nil {
+		return Result{}, errors.Wrapf(err, "Failed to read the sync target state")
+	}
+	for p, state := range remote {
+		if isIgnored(s.ignore, p, state.IsDir()) {
+			delete(remote, p)
+		}
+	}
+
+	result, err := s.apply(Diff(local, remote))
+	if err != nil {
+		return result, err
+	}
+	s.synced = local
+	return result, nil
+}
+
+// Watch watches the file changes and syncs them until the done channel get closed.
+// The result of each sync get sent to the events channel, or logged if the channel is nil
+func (s *Syncer) Watch(done <-chan struct{}, events chan<- Event) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrapf(err, "Failed to start watching file changes")
+	}
+	defer watcher.Close()
+
+	if err := s.watchDir(watcher, s.root); err != nil {
+		return err
+	}
+
+	var (
+		pending = map[string]struct{}{}
+		timer   <-chan time.Time
+	)
+	for {
+		select {
+		case <-done:
+			return nil
+		case event := <-watcher.Events:
+			rel, err := filepath.Rel(s.root, event.Name)
+			if err != nil || rel == "." {
+				continue
+			}
+			rel = filepath.ToSlash(rel)
+
+			if event.Op&fsnotify.Create != 0 {
+				if info, err := os.Lstat(event.Name); err == nil && info.IsDir() && !isIgnored(s.ignore, rel, true) {
+					if err := s.watchDir(watcher, event.Name); err != nil {
+						log.Warnf("Failed to watch new directory [%s]: %s", rel, err)
+					}
+				}
+			}
+			pending[rel] = struct{}{}
+			timer = time.After(debounce)
+		case err := <-watcher.Errors:
+			log.Warnf("Error while watching file changes: %s", err)
+		case <-timer:
+			paths := make([]string, 0, len(pending))
+			for p := range pending {
+				paths = append(paths, p)
+			}
+			pending = map[string]struct{}{}
+			timer = nil
+
+			result, changes, err := s.syncPaths(paths)
+			event := Event{Time: time.Now(), Source: s.root, Result: result, Changes: changes, Err: err}
+			if events == nil {
+				logEvent(event)
+				continue
+			}
+			select {
+			case events <- event:
+			case <-done:
+				return nil
+			}
+		}
+	}
+}
+
+func logEvent(event Event) {
+	if event.Err != nil {
+		log.Errorf("Failed to sync changes in [%s]: %s", event.Source, event.Err)
+	}
+	for _, message := range event.Result.Errors {
+		log.Errorf("%s", message)
+	}
+}
+
+// SyncPaths sends the changes in the paths and under them to the remote
+func (s *Syncer) SyncPaths(paths []string) (Result, error) {
+	result, _, err := s.syncPaths(paths)
+	return result, err
+}
+
+func (s *Syncer) syncPaths(paths []string) (Result, []Change, error) {
+	var (
+		local  = map[string]FileState{}
+		synced = map[string]FileState{}
+	)
+	paths = topmost(paths)
+	for _, p := range paths {
+		states, err := ScanPath(s.root, p, s.ignore)
+		if err != nil {
+			return Result{}, nil, errors.Wrapf(err, "Failed to read [%s]", p)
+		}
+		for key, state := range states {
+			local[key] = state
+		}
+		for key, state := range s.synced {
+			if isUnder(key, p) {
+				synced[key] = state
+			}
+		}
+	}
+
+	changes := Diff(local, synced)
+	result, err := s.apply(changes)
+	if err != nil {
+		return result, changes, err
+	}
+
+	for key := range synced {
+		delete(s.synced, key)
+	}
+	for key, state := range local {
+		s.synced[key] = state
+	}
+	return result, changes, nil
+}
+
+func (s *Syncer) apply(changes []Change) (Result, error) {
+	if len(changes) == 0 {
+		return Result{}, nil
+	}
+	return s.remote.Apply(s.root, changes)
+}
+
+// watchDir adds watch to the directory and all not ignored directories under it
+func (s *Syncer) watchDir(watcher *fsnotify.Watcher, dir string) error {
+	return filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+
+		if rel, err := filepath.Rel(s.root, p); err == nil && rel != "." && isIgnored(s.ignore, filepath.ToSlash(rel), true) {
+			return filepath.SkipDir
+		}
+		return watcher.Add(p)
+	})
+}
+
+// topmost drops the paths what are under some other path in the list
+func topmost(paths []string) (result []string) {
+	sort.Strings(paths)
+	for _, p := range paths {
+		if len(result) > 0 && isUnder(p, result[len(result)-1]) {
+			continue
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+// isUnder returns true if the path is the dir or inside of it
+func isUnder(p, dir string) bool {
+	return p == dir || strings.HasPrefix(p, dir+"/") || path.Clean(dir) == "."
+}
@@ -0,0 +1,136 @@
+package sync
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ernoaapa/eliot/pkg/archive/archivetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func ignoreLogs(path string, dir bool) bool {
+	return path == "logs"
+}
+
+func TestSync(t *testing.T) {
+	source, cleanup := archivetest.TempDir(t, map[string]string{
+		"main.go":       "package main",
+		"app/config.go": "package app",
+		"logs/out.log":  "local log",
+	})
+	defer cleanup()
+	target, cleanup := archivetest.TempDir(t, map[string]string{
+		"app/config.go": "package old",
+		"removed.txt":   "removed",
+		"logs/out.log":  "remote log",
+	})
+	defer cleanup()
+
+	syncer := NewSyncer(source, &dirRemote{root: target}, ignoreLogs)
+	result, err := syncer.Sync()
+	assert.NoError(t, err)
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, 2, result.Written)
+	assert.Equal(t, 1, result.Deleted)
+
+	assert.Equal(t, "package main", archivetest.ReadFile(t, filepath.Join(target, "main.go")))
+	assert.Equal(t, "package app", archivetest.ReadFile(t, filepath.Join(target, "app/config.go")))
+	// Ignored files are not synced, nor deleted
+	assert.Equal(t, "remote log", archivetest.ReadFile(t, filepath.Join(target, "logs/out.log")))
+	_, err = os.Stat(filepath.Join(target, "removed.txt"))
+	assert.True(t, os.IsNotExist(err))
+
+	result, err = syncer.Sync()
+	assert.NoError(t, err)
+	assert.Equal(t, Result{}, result, "Second sync should not have any changes")
+}
+
+func TestSyncPaths(t *testing.T) {
+	source, cleanup := archivetest.TempDir(t, map[string]string{
+		"app/a.go": "a",
+		"app/b.go": "b",
+		"main.go":  "main",
+	})
+	defer cleanup()
+	target, cleanup := archivetest.TempDir(t, nil)
+	defer cleanup()
+
+	syncer := NewSyncer(source, &dirRemote{root: target}, nil)
+	_, err := syncer.Sync()
+	assert.NoError(t, err)
+
+	assert.NoError(t, os.Remove(filepath.Join(source, "app/a.go")))
+	archivetest.WriteFiles(t, source, map[string]string{
+		"app/b.go": "updated",
+		"main.go":  "not synced",
+	})
+
+	result, err := syncer.SyncPaths([]string{"app/b.go", "app", "app/a.go"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Written)
+	assert.Equal(t, 1, result.Deleted)
+
+	assert.Equal(t, "updated", archivetest.ReadFile(t, filepath.Join(target, "app/b.go")))
+	assert.Equal(t, "main", archivetest.ReadFile(t, filepath.Join(target, "main.go")))
+	_, err = os.Stat(filepath.Join(target, "app/a.go"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWatch(t *testing.T) {
+	source, cleanup := archivetest.TempDir(t, nil)
+	defer cleanup()
+	target, cleanup := archivetest.TempDir(t, nil)
+	defer cleanup()
+
+	syncer := NewSyncer(source, &dirRemote{root: target}, ignoreLogs)
+	_, err := syncer.Sync()
+	assert.NoError(t, err)
+
+	done := make(chan struct{})
+	stopped := make(chan error)
+	events := make(chan Event, 10)
+	go func() {
+		stopped <- syncer.Watch(done, events)
+	}()
+	// Let the watcher start
+	time.Sleep(100 * time.Millisecond)
+
+	archivetest.WriteFiles(t, source, map[string]string{
+		"new/dir/file.txt": "created",
+		"logs/out.log":     "ignored",
+	})
+
+	assert.True(t, eventually(func() bool {
+		_, err := os.Stat(filepath.Join(target, "new/dir/file.txt"))
+		return err == nil
+	}), "Created file should get synced")
+	assert.Equal(t, "created", archivetest.ReadFile(t, filepath.Join(target, "new/dir/file.txt")))
+
+	event := <-events
+	assert.NoError(t, event.Err)
+	assert.Equal(t, source, event.Source)
+
+	assert.NoError(t, os.RemoveAll(filepath.Join(source, "new")))
+	assert.True(t, eventually(func() bool {
+		_, err := os.Stat(filepath.Join(target, "new"))
+		return os.IsNotExist(err)
+	}), "Removed directory should get deleted")
+
+	_, err = os.Stat(filepath.Join(target, "logs"))
+	assert.True(t, os.IsNotExist(err), "Ignored directory should not get synced")
+
+	close(done)
+	assert.NoError(t, <-stopped)
+}
+
+func eventually(condition func() bool) bool {
+	for i := 0; i < 50; i++ {
+		if condition() {
+			return true
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return false
+}
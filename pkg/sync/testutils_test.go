@@ -0,0 +1,36 @@
+package sync
+
+import (
+	"io/ioutil"
+	"path/filepath"
+)
+
+// dirRemote is Remote what applies the changes to local directory
+type dirRemote struct {
+	root string
+}
+
+func (r *dirRemote) Manifest() (map[string]FileState, error) {
+	return Scan(r.root, nil)
+}
+
+func (r *dirRemote) Apply(root string, changes []Change) (Result, error) {
+	receiver := NewReceiver(r.root)
+	defer receiver.Close()
+
+	for _, change := range changes {
+		if change.Delete {
+			receiver.Delete(change.State.Path)
+			continue
+		}
+		receiver.Begin(change.State)
+		if change.State.IsRegular() {
+			data, err := ioutil.ReadFile(filepath.Join(root, change.State.Path))
+			if err != nil {
+				return Result{}, err
+			}
+			receiver.Write(data)
+		}
+	}
+	return receiver.Commit(), nil
+}
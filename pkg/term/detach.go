@@ -0,0 +1,52 @@
+package term
+
+import (
+	"io"
+
+	"github.com/docker/docker/pkg/term"
+	"github.com/pkg/errors"
+)
+
+// DefaultDetachKeys is the default key sequence to detach from the container
+const DefaultDetachKeys = "ctrl-p,ctrl-q"
+
+// ErrDetached is returned by the input reader when the detach key sequence get typed
+var ErrDetached = errors.New("Detached")
+
+// detachReader translates the escape proxy error to ErrDetached
+type detachReader struct {
+	r io.Reader
+}
+
+// NewDetachReader wraps the reader so that it returns ErrDetached when reads the key sequence, e.g. ctrl-p,ctrl-q
+func NewDetachReader(r io.Reader, keys string) (io.Reader, error) {
+	escapeKeys, err := term.ToBytes(keys)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Invalid detach keys [%s]", keys)
+	}
+	if len(escapeKeys) == 0 {
+		return r, nil
+	}
+	return &detachReader{term.NewEscapeProxy(r, escapeKeys)}, nil
+}
+
+func (d *detachReader) Read(p []byte) (int, error) {
+	n, err := d.r.Read(p)
+	if _, ok := err.(term.EscapeError); ok {
+		return n, ErrDetached
+	}
+	return n, err
+}
+
+// IsDetached returns true if the error is caused by the detach key sequence
+func IsDetached(err error) bool {
+	return errors.Cause(err) == ErrDetached
+}
+
+// InputReader returns reader for In what detaches when DetachKeys get typed
+func (t TTY) InputReader() (io.Reader, error) {
+	if t.In == nil || t.DetachKeys == "" {
+		return t.In, nil
+	}
+	return NewDetachReader(t.In, t.DetachKeys)
+}
@@ -0,0 +1,58 @@
+package term
+
+import (
+	"io"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+// oneByteReader returns one byte per read like the terminal in raw mode
+type oneByteReader struct {
+	data []byte
+}
+
+func (r *oneByteReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+	p[0] = r.data[0]
+	r.data = r.data[1:]
+	return 1, nil
+}
+
+func readUntilError(r io.Reader) (string, error) {
+	result := []byte{}
+	for {
+		buf := make([]byte, 1024)
+		n, err := r.Read(buf)
+		result = append(result, buf[:n]...)
+		if err != nil {
+			return string(result), err
+		}
+	}
+}
+
+func TestDetachReader(t *testing.T) {
+	reader, err := NewDetachReader(&oneByteReader{data: []byte("ls\x10\x11pwd")}, DefaultDetachKeys)
+	assert.NoError(t, err)
+
+	input, err := readUntilError(reader)
+	assert.Equal(t, "ls", input)
+	assert.True(t, IsDetached(errors.Wrap(err, "Error while reading stdin")))
+}
+
+func TestDetachReaderPartialSequence(t *testing.T) {
+	reader, err := NewDetachReader(&oneByteReader{data: []byte("\x10a")}, DefaultDetachKeys)
+	assert.NoError(t, err)
+
+	input, err := readUntilError(reader)
+	assert.Equal(t, "\x10a", input, "should pass through the keys if the sequence doesn't match")
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestDetachReaderInvalidKeys(t *testing.T) {
+	_, err := NewDetachReader(&oneByteReader{}, "ctrl-foo")
+	assert.Error(t, err)
+}
@@ -38,6 +38,8 @@ type TTY struct {
 	Out io.Writer
 	// Raw is true if the terminal should be set raw.
 	Raw bool
+	// DetachKeys is optional key sequence, e.g. ctrl-p,ctrl-q, what ends reading the input with ErrDetached.
+	DetachKeys string
 	// Parent is an optional interrupt handler provided to this function - if provided
 	// it will be invoked after the terminal state is restored. If it is not provided,
 	// a signal received during the TTY will result in os.Exit(0) being invoked.
@@ -0,0 +1,50 @@
+package volume
+
+import (
+	"compress/gzip"
+	"io"
+
+	"github.com/ernoaapa/eliot/pkg/archive"
+	"github.com/ernoaapa/eliot/pkg/model"
+)
+
+// lostAndFound is created by mkfs to the size limited volumes, it's not part of the data
+const lostAndFound = "lost+found"
+
+// ExportOpts defines how the volume data get exported
+type ExportOpts struct {
+	// Compress the tar stream with gzip
+	Gzip bool
+	// Paths relative to the volume root to export, empty means everything
+	Paths []string
+}
+
+// Export writes the volume data as tar stream to the writer
+func (m *Manager) Export(namespace, name string, w io.Writer, opts ExportOpts) error {
+	if _, err := m.Get(namespace, name); err != nil {
+		return err
+	}
+
+	writeOpts := archive.WriteOpts{
+		Paths:   opts.Paths,
+		Exclude: []string{lostAndFound},
+	}
+	if opts.Gzip {
+		gz := gzip.NewWriter(w)
+		if err := archive.WriteTar(m.GetPath(namespace, name), gz, writeOpts); err != nil {
+			return err
+		}
+		return gz.Close()
+	}
+	return archive.WriteTar(m.GetPath(namespace, name), w, writeOpts)
+}
+
+// Import extracts tar stream to the volume. The stream can be gzip compressed.
+// If the volume doesn't exist, it get created without size limit.
+func (m *Manager) Import(namespace, name string, r io.Reader) (model.Volume, error) {
+	volume, err := m.Ensure(namespace, name, 0)
+	if err != nil {
+		return volume, err
+	}
+	return volume, archive.ExtractTar(m.GetPath(namespace, name), r, archive.ExtractOpts{})
+}
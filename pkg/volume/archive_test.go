@@ -0,0 +1,108 @@
+package volume
+
+import (
+	"archive/tar"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/archive/archivetest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportImport(t *testing.T) {
+	manager, _, cleanup := newTestManager(t)
+	defer cleanup()
+
+	manager.Create("eliot", "source", 0)
+	archivetest.WriteFiles(t, manager.GetPath("eliot", "source"), map[string]string{
+		"config.yml":      "foo: bar",
+		"data/sensor.csv": "1,2,3",
+	})
+	assert.NoError(t, os.Symlink("config.yml", filepath.Join(manager.GetPath("eliot", "source"), "link.yml")))
+
+	for _, gzip := range []bool{false, true} {
+		var buffer bytes.Buffer
+		assert.NoError(t, manager.Export("eliot", "source", &buffer, ExportOpts{Gzip: gzip}))
+
+		manager.Delete("eliot", "target")
+		_, err := manager.Import("eliot", "target", &buffer)
+		assert.NoError(t, err)
+
+		target := manager.GetPath("eliot", "target")
+		assert.Equal(t, "foo: bar", archivetest.ReadFile(t, filepath.Join(target, "config.yml")))
+		assert.Equal(t, "1,2,3", archivetest.ReadFile(t, filepath.Join(target, "data/sensor.csv")))
+
+		link, err := os.Readlink(filepath.Join(target, "link.yml"))
+		assert.NoError(t, err)
+		assert.Equal(t, "config.yml", link)
+	}
+}
+
+func TestExportWithPaths(t *testing.T) {
+	manager, _, cleanup := newTestManager(t)
+	defer cleanup()
+
+	manager.Create("eliot", "source", 0)
+	archivetest.WriteFiles(t, manager.GetPath("eliot", "source"), map[string]string{
+		"config.yml":        "foo: bar",
+		"data/2018/a.csv":   "1,2,3",
+		"data/2019/b.csv":   "4,5,6",
+		"logs/messages.log": "hello",
+	})
+
+	var buffer bytes.Buffer
+	assert.NoError(t, manager.Export("eliot", "source", &buffer, ExportOpts{Paths: []string{"/data/2018", "config.yml"}}))
+
+	names := []string{}
+	tr := tar.NewReader(&buffer)
+	for {
+		header, err := tr.Next()
+		if err != nil {
+			break
+		}
+		names = append(names, header.Name)
+	}
+	assert.Equal(t, []string{"config.yml", "data/2018/", "data/2018/a.csv"}, names)
+}
+
+func TestImportCannotEscapeVolume(t *testing.T) {
+	manager, _, cleanup := newTestManager(t)
+	defer cleanup()
+
+	var buffer bytes.Buffer
+	tw := tar.NewWriter(&buffer)
+	content := []byte("evil")
+	tw.WriteHeader(&tar.Header{Name: "../../evil.txt", Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+	tw.Write(content)
+	tw.Close()
+
+	_, err := manager.Import("eliot", "target", &buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, "evil", archivetest.ReadFile(t, filepath.Join(manager.GetPath("eliot", "target"), "evil.txt")))
+}
+
+func TestImportCannotExtractThroughSymlink(t *testing.T) {
+	manager, _, cleanup := newTestManager(t)
+	defer cleanup()
+
+	outside, err := ioutil.TempDir("", "outside")
+	assert.NoError(t, err)
+	defer os.RemoveAll(outside)
+
+	var buffer bytes.Buffer
+	tw := tar.NewWriter(&buffer)
+	content := []byte("evil")
+	tw.WriteHeader(&tar.Header{Name: "link", Linkname: outside, Typeflag: tar.TypeSymlink})
+	tw.WriteHeader(&tar.Header{Name: "link/evil.txt", Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg})
+	tw.Write(content)
+	tw.Close()
+
+	_, err = manager.Import("eliot", "target", &buffer)
+	assert.Error(t, err)
+
+	_, err = os.Stat(filepath.Join(outside, "evil.txt"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,433 @@
+package volume
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ernoaapa/eliot/pkg/fs"
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultRoot is directory where the volumes get stored in the node
+const DefaultRoot = "/var/lib/eliot/volumes"
+
+const (
+	dataDirName  = "_data"
+	imageName    = "volume.img"
+	metadataName = "volume.json"
+)
+
+// metadata is the volume information stored next to the data
+type metadata struct {
+	Size      uint64
+	CreatedAt time.Time
+}
+
+// Manager manages named volumes in the node.
+// Each volume is directory under the root. Volumes with size limit are backed by
+// ext4 filesystem image what get loop mounted to the volume data directory.
+type Manager struct {
+	root      string
+	mountinfo string
+	run       func(name string, args ...string) ([]byte, error)
+	mu        sync.Mutex
+}
+
+// NewManager creates new Manager what stores the volumes under the root directory
+func NewManager(root string) *Manager {
+	return &Manager{
+		root:      root,
+		mountinfo: "/proc/self/mountinfo",
+		run: func(name string, args ...string) ([]byte, error) {
+			return exec.Command(name, args...).CombinedOutput()
+		},
+	}
+}
+
+// GetPath returns path to the volume data directory
+func (m *Manager) GetPath(namespace, name string) string {
+	return filepath.Join(m.getDir(namespace, name), dataDirName)
+}
+
+// Create creates new volume with size limit in bytes (zero means no limit)
+func (m *Manager) Create(namespace, name string, size uint64) (model.Volume, error) {
+	if err := m.validate(namespace, name); err != nil {
+		return model.Volume{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.exists(namespace, name) {
+		return model.Volume{}, runtime.ErrWithMessagef(runtime.ErrAlreadyExists, "Volume [%s] in namespace [%s] already exists", name, namespace)
+	}
+	return m.create(namespace, name, size)
+}
+
+// Ensure creates the volume if it doesn't exist and makes sure it's ready to be mounted
+func (m *Manager) Ensure(namespace, name string, size uint64) (model.Volume, error) {
+	if err := m.validate(namespace, name); err != nil {
+		return model.Volume{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.exists(namespace, name) {
+		return m.create(namespace, name, size)
+	}
+
+	volume, err := m.get(namespace, name)
+	if err != nil {
+		return volume, err
+	}
+	return volume, m.ensureMounted(namespace, name, volume.Spec.Size)
+}
+
+// Get returns the volume by name
+func (m *Manager) Get(namespace, name string) (model.Volume, error) {
+	if err := m.validate(namespace, name); err != nil {
+		return model.Volume{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.exists(namespace, name) {
+		return model.Volume{}, runtime.ErrWithMessagef(runtime.ErrNotFound, "Volume [%s] in namespace [%s] not found", name, namespace)
+	}
+	return m.get(namespace, name)
+}
+
+// List returns all volumes in the namespace sorted by name
+func (m *Manager) List(namespace string) (result []model.Volume, err error) {
+	if err := m.validate(namespace, ""); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries, err := ioutil.ReadDir(filepath.Join(m.root, namespace))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, nil
+		}
+		return nil, errors.Wrapf(err, "Failed to list volumes in namespace [%s]", namespace)
+	}
+
+	names := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() && m.exists(namespace, entry.Name()) {
+			names = append(names, entry.Name())
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		volume, err := m.get(namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, volume)
+	}
+	return result, nil
+}
+
+// Delete removes the volume and all of its data
+func (m *Manager) Delete(namespace, name string) (model.Volume, error) {
+	if err := m.validate(namespace, name); err != nil {
+		return model.Volume{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.exists(namespace, name) {
+		return model.Volume{}, runtime.ErrWithMessagef(runtime.ErrNotFound, "Volume [%s] in namespace [%s] not found", name, namespace)
+	}
+
+	volume, err := m.get(namespace, name)
+	if err != nil {
+		return volume, err
+	}
+
+	dataDir := m.GetPath(namespace, name)
+	mounted, err := fs.IsMountPoint(m.mountinfo, dataDir)
+	if err != nil {
+		return volume, err
+	}
+	if mounted {
+		if out, err := m.run("umount", dataDir); err != nil {
+			return volume, errors.Wrapf(err, "Failed to unmount volume [%s]: %s", name, strings.TrimSpace(string(out)))
+		}
+	}
+
+	if err := os.RemoveAll(m.getDir(namespace, name)); err != nil {
+		return volume, errors.Wrapf(err, "Failed to remove volume [%s] data", name)
+	}
+	return volume, nil
+}
+
+// Usage returns number of bytes the volume data uses
+func (m *Manager) Usage(namespace, name string) (uint64, error) {
+	if err := m.validate(namespace, name); err != nil {
+		return 0, err
+	}
+	if !m.exists(namespace, name) {
+		return 0, runtime.ErrWithMessagef(runtime.ErrNotFound, "Volume [%s] in namespace [%s] not found", name, namespace)
+	}
+	return getDiskUsage(m.GetPath(namespace, name))
+}
+
+// Prepare ensures the pod volumes exist and resolves the container volume mounts to bind mounts.
+// Returns names of the volumes what got created, if fails the created volumes get removed
+func (m *Manager) Prepare(pod *model.Pod) (created []string, err error) {
+	defer func() {
+		if err != nil {
+			m.Remove(pod.Metadata.Namespace, created)
+			created = nil
+		}
+	}()
+
+	for _, volume := range pod.Spec.Volumes {
+		var size uint64
+		if volume.Size != "" {
+			parsed, err := model.ParseByteSize(volume.Size)
+			if err != nil {
+				return created, errors.Wrapf(err, "Invalid volume [%s] size", volume.Name)
+			}
+			size = parsed
+		}
+		exists := m.exists(pod.Metadata.Namespace, volume.Name)
+		if _, err := m.Ensure(pod.Metadata.Namespace, volume.Name, size); err != nil {
+			return created, errors.Wrapf(err, "Failed to create volume [%s]", volume.Name)
+		}
+		if !exists {
+			created = append(created, volume.Name)
+		}
+	}
+
+	for ci, container := range pod.Spec.Containers {
+		for mi, mount := range container.Mounts {
+			if mount.Type != "volume" {
+				continue
+			}
+			if !isPodVolume(pod.Spec, mount.Source) {
+				if !m.exists(pod.Metadata.Namespace, mount.Source) {
+					return created, runtime.ErrWithMessagef(runtime.ErrNotFound, "Container [%s] mounts volume [%s] what doesn't exist", container.Name, mount.Source)
+				}
+				if _, err := m.Ensure(pod.Metadata.Namespace, mount.Source, 0); err != nil {
+					return created, errors.Wrapf(err, "Failed to prepare volume [%s]", mount.Source)
+				}
+			}
+			pod.Spec.Containers[ci].Mounts[mi] = model.Mount{
+				Type:        "bind",
+				Source:      m.GetPath(pod.Metadata.Namespace, mount.Source),
+				Destination: mount.Destination,
+				Options:     append(defaultMountOptions(mount.Options), "rbind"),
+			}
+		}
+	}
+	return created, nil
+}
+
+// Remove deletes the volumes, e.g. what Prepare created for failed pod
+func (m *Manager) Remove(namespace string, names []string) {
+	for _, name := range names {
+		if _, err := m.Delete(namespace, name); err != nil {
+			log.Warnf("Failed to remove volume [%s] in namespace [%s]: %s", name, namespace, err)
+		}
+	}
+}
+
+// EnsureMounted mounts the size limited volumes what the container uses, e.g. after node restart
+func (m *Manager) EnsureMounted(namespace string, container model.Container) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, mount := range container.Mounts {
+		if mount.Type != "bind" || filepath.Base(mount.Source) != dataDirName {
+			continue
+		}
+		name := filepath.Base(filepath.Dir(mount.Source))
+		if mount.Source != m.GetPath(namespace, name) || !m.exists(namespace, name) {
+			continue
+		}
+
+		volume, err := m.get(namespace, name)
+		if err != nil {
+			return err
+		}
+		if err := m.ensureMounted(namespace, name, volume.Spec.Size); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *Manager) create(namespace, name string, size uint64) (volume model.Volume, err error) {
+	dir := m.getDir(namespace, name)
+	log.Debugf("Create volume [%s] in namespace [%s] (size limit: %d bytes)", name, namespace, size)
+
+	if err := os.MkdirAll(filepath.Join(dir, dataDirName), 0755); err != nil {
+		return volume, errors.Wrapf(err, "Failed to create volume [%s] directory", name)
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(dir)
+		}
+	}()
+
+	if size > 0 {
+		if err := m.createImage(filepath.Join(dir, imageName), size); err != nil {
+			return volume, errors.Wrapf(err, "Failed to create volume [%s] filesystem", name)
+		}
+		if err := m.ensureMounted(namespace, name, size); err != nil {
+			return volume, err
+		}
+	}
+
+	data, err := json.Marshal(metadata{
+		Size:      size,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return volume, errors.Wrapf(err, "Failed to marshal volume [%s] metadata", name)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, metadataName), data, 0644); err != nil {
+		return volume, errors.Wrapf(err, "Failed to write volume [%s] metadata", name)
+	}
+
+	return m.get(namespace, name)
+}
+
+func (m *Manager) createImage(path string, size uint64) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	err = file.Truncate(int64(size))
+	file.Close()
+	if err != nil {
+		return err
+	}
+
+	if out, err := m.run("mkfs.ext4", "-q", "-F", path); err != nil {
+		return errors.Wrapf(err, "mkfs.ext4 failed: %s", strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// ensureMounted mounts the volume filesystem image if the volume have size limit.
+// Mounts don't survive over node restart so this must be checked before the volume is used
+func (m *Manager) ensureMounted(namespace, name string, size uint64) error {
+	if size == 0 {
+		return nil
+	}
+
+	dataDir := m.GetPath(namespace, name)
+	mounted, err := fs.IsMountPoint(m.mountinfo, dataDir)
+	if err != nil || mounted {
+		return err
+	}
+
+	image := filepath.Join(m.getDir(namespace, name), imageName)
+	if out, err := m.run("mount", "-o", "loop", image, dataDir); err != nil {
+		return errors.Wrapf(err, "Failed to mount volume [%s]: %s", name, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func (m *Manager) get(namespace, name string) (model.Volume, error) {
+	volume := model.Volume{
+		Metadata: model.NewMetadata(namespace, name),
+		Status: model.VolumeStatus{
+			Path: m.GetPath(namespace, name),
+		},
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(m.getDir(namespace, name), metadataName))
+	if err != nil {
+		return volume, errors.Wrapf(err, "Failed to read volume [%s] metadata", name)
+	}
+
+	var meta metadata
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return volume, errors.Wrapf(err, "Failed to parse volume [%s] metadata", name)
+	}
+
+	volume.Spec.Size = meta.Size
+	volume.Status.CreatedAt = meta.CreatedAt
+	return volume, nil
+}
+
+func (m *Manager) exists(namespace, name string) bool {
+	_, err := os.Stat(filepath.Join(m.getDir(namespace, name), metadataName))
+	return err == nil
+}
+
+// validate checks that the namespace and volume name are valid and the volume directory is under the root.
+// Name is optional, e.g. when listing the namespace volumes
+func (m *Manager) validate(namespace, name string) error {
+	if err := model.ValidateNamespace(namespace); err != nil {
+		return errors.Wrapf(err, "Invalid namespace [%s]", namespace)
+	}
+	if name != "" {
+		if err := model.ValidateVolumeName(name); err != nil {
+			return errors.Wrapf(err, "Invalid volume name [%s]", name)
+		}
+	}
+
+	rel, err := filepath.Rel(m.root, m.getDir(namespace, name))
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return errors.Errorf("Volume [%s] in namespace [%s] is outside of the volumes root", name, namespace)
+	}
+	return nil
+}
+
+func (m *Manager) getDir(namespace, name string) string {
+	return filepath.Join(m.root, namespace, name)
+}
+
+func getDiskUsage(path string) (result uint64, err error) {
+	err = filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			result += uint64(info.Size())
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, errors.Wrapf(err, "Failed to calculate disk usage of [%s]", path)
+	}
+	return result, nil
+}
+
+func isPodVolume(spec model.PodSpec, name string) bool {
+	for _, volume := range spec.Volumes {
+		if volume.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func defaultMountOptions(options []string) []string {
+	if len(options) == 0 {
+		return []string{"rw"}
+	}
+	return append([]string{}, options...)
+}
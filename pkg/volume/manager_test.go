@@ -0,0 +1,187 @@
+package volume
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ernoaapa/eliot/pkg/model"
+	"github.com/ernoaapa/eliot/pkg/runtime"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestManager(t *testing.T) (*Manager, *[]string, func()) {
+	dir, err := ioutil.TempDir("", "volume-test")
+	assert.NoError(t, err)
+
+	mountinfo := filepath.Join(dir, "mountinfo")
+	assert.NoError(t, ioutil.WriteFile(mountinfo, []byte{}, 0644))
+
+	commands := []string{}
+	manager := NewManager(filepath.Join(dir, "volumes"))
+	manager.mountinfo = mountinfo
+	manager.run = func(name string, args ...string) ([]byte, error) {
+		commands = append(commands, strings.Join(append([]string{name}, args...), " "))
+		return nil, nil
+	}
+	return manager, &commands, func() { os.RemoveAll(dir) }
+}
+
+func TestCreateAndList(t *testing.T) {
+	manager, commands, cleanup := newTestManager(t)
+	defer cleanup()
+
+	created, err := manager.Create("eliot", "data", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "data", created.Metadata.Name)
+	assert.False(t, created.Status.CreatedAt.IsZero())
+	assert.Empty(t, *commands, "should not create filesystem image for volume without size limit")
+
+	_, err = manager.Create("eliot", "data", 0)
+	assert.Error(t, err, "should return error if volume already exist")
+
+	manager.Create("eliot", "another", 0)
+	manager.Create("other", "foo", 0)
+
+	volumes, err := manager.List("eliot")
+	assert.NoError(t, err)
+	assert.Len(t, volumes, 2)
+	assert.Equal(t, "another", volumes[0].Metadata.Name)
+	assert.Equal(t, "data", volumes[1].Metadata.Name)
+}
+
+func TestCreateWithSizeLimit(t *testing.T) {
+	manager, commands, cleanup := newTestManager(t)
+	defer cleanup()
+
+	created, err := manager.Create("eliot", "data", 1024*1024)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(1024*1024), created.Spec.Size)
+
+	image := filepath.Join(manager.root, "eliot", "data", imageName)
+	info, err := os.Stat(image)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1024*1024), info.Size())
+
+	assert.Equal(t, []string{
+		"mkfs.ext4 -q -F " + image,
+		"mount -o loop " + image + " " + manager.GetPath("eliot", "data"),
+	}, *commands)
+}
+
+func TestEnsureMounted(t *testing.T) {
+	manager, commands, cleanup := newTestManager(t)
+	defer cleanup()
+
+	manager.Create("eliot", "limited", 1024*1024)
+	manager.Create("eliot", "unlimited", 0)
+	*commands = []string{}
+
+	container := model.Container{
+		Name: "foo",
+		Mounts: []model.Mount{
+			{Type: "bind", Source: manager.GetPath("eliot", "limited"), Destination: "/data"},
+			{Type: "bind", Source: manager.GetPath("eliot", "unlimited"), Destination: "/cache"},
+			{Type: "bind", Source: "/tmp", Destination: "/tmp"},
+		},
+	}
+	assert.NoError(t, manager.EnsureMounted("eliot", container))
+
+	image := filepath.Join(manager.root, "eliot", "limited", imageName)
+	assert.Equal(t, []string{
+		"mount -o loop " + image + " " + manager.GetPath("eliot", "limited"),
+	}, *commands)
+}
+
+func TestRejectPathOutsideRoot(t *testing.T) {
+	manager, _, cleanup := newTestManager(t)
+	defer cleanup()
+
+	_, err := manager.Create("../..", "data", 0)
+	assert.Error(t, err)
+	_, err = manager.Create("eliot", "..", 0)
+	assert.Error(t, err)
+	_, err = manager.Delete("../../etc", "data")
+	assert.Error(t, err)
+	_, err = manager.Usage("", "data")
+	assert.Error(t, err)
+	_, err = manager.List("..")
+	assert.Error(t, err)
+}
+
+func TestDeleteAndUsage(t *testing.T) {
+	manager, _, cleanup := newTestManager(t)
+	defer cleanup()
+
+	manager.Create("eliot", "data", 0)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(manager.GetPath("eliot", "data"), "foo"), []byte("12345"), 0644))
+
+	usage, err := manager.Usage("eliot", "data")
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(5), usage)
+
+	_, err = manager.Delete("eliot", "data")
+	assert.NoError(t, err)
+
+	_, err = manager.Get("eliot", "data")
+	assert.True(t, runtime.IsNotFound(err))
+}
+
+func TestPrepare(t *testing.T) {
+	manager, _, cleanup := newTestManager(t)
+	defer cleanup()
+
+	manager.Create("eliot", "existing", 0)
+
+	pod := model.Pod{
+		Metadata: model.NewMetadata("eliot", "my-pod"),
+		Spec: model.PodSpec{
+			Volumes: []model.PodVolume{{Name: "data"}},
+			Containers: []model.Container{
+				{
+					Name: "foo",
+					Mounts: []model.Mount{
+						{Type: "volume", Source: "data", Destination: "/data"},
+						{Type: "volume", Source: "existing", Destination: "/existing", Options: []string{"ro"}},
+						{Type: "bind", Source: "/tmp", Destination: "/tmp"},
+					},
+				},
+			},
+		},
+	}
+
+	created, err := manager.Prepare(&pod)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"data"}, created)
+
+	mounts := pod.Spec.Containers[0].Mounts
+	assert.Equal(t, model.Mount{Type: "bind", Source: manager.GetPath("eliot", "data"), Destination: "/data", Options: []string{"rw", "rbind"}}, mounts[0])
+	assert.Equal(t, model.Mount{Type: "bind", Source: manager.GetPath("eliot", "existing"), Destination: "/existing", Options: []string{"ro", "rbind"}}, mounts[1])
+	assert.Equal(t, "/tmp", mounts[2].Source)
+
+	_, err = manager.Get("eliot", "data")
+	assert.NoError(t, err, "should create pod volume")
+}
+
+func TestPrepareReturnErrorIfVolumeNotFound(t *testing.T) {
+	manager, _, cleanup := newTestManager(t)
+	defer cleanup()
+
+	pod := model.Pod{
+		Metadata: model.NewMetadata("eliot", "my-pod"),
+		Spec: model.PodSpec{
+			Volumes: []model.PodVolume{{Name: "data"}},
+			Containers: []model.Container{
+				{Name: "foo", Mounts: []model.Mount{{Type: "volume", Source: "missing", Destination: "/data"}}},
+			},
+		},
+	}
+
+	_, err := manager.Prepare(&pod)
+	assert.Error(t, err)
+
+	_, err = manager.Get("eliot", "data")
+	assert.Error(t, err, "should remove the created pod volume")
+}